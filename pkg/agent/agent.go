@@ -5,20 +5,33 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/rancher/opni-monitoring/pkg/bootstrap"
 	"github.com/rancher/opni-monitoring/pkg/clients"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/health"
 	"github.com/rancher/opni-monitoring/pkg/ident"
 	"github.com/rancher/opni-monitoring/pkg/keyring"
 	"github.com/rancher/opni-monitoring/pkg/logger"
+	"github.com/rancher/opni-monitoring/pkg/machinery"
+	"github.com/rancher/opni-monitoring/pkg/relabel"
+	"github.com/rancher/opni-monitoring/pkg/rules"
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/storage/bolt"
 	"github.com/rancher/opni-monitoring/pkg/storage/crds"
 	"github.com/rancher/opni-monitoring/pkg/storage/etcd"
+	"github.com/rancher/opni-monitoring/pkg/storage/file"
+	"github.com/rancher/opni-monitoring/pkg/storage/sql"
+	"github.com/rancher/opni-monitoring/pkg/wal"
 	"github.com/valyala/fasthttp"
+	"github.com/valyala/fasthttp/fasthttpadaptor"
 	"go.uber.org/zap"
 )
 
@@ -31,12 +44,45 @@ type Agent struct {
 	tenantID         string
 	identityProvider ident.Provider
 	keyringStore     storage.KeyringStore
-	gatewayClient    clients.GatewayHTTPClient
 	shutdownLock     sync.Mutex
+
+	// Held while the keyring is being updated after it is loaded, so that
+	// concurrent updates are not lost.
+	keyringMu sync.Mutex
+
+	gatewayClientMu sync.RWMutex
+	gatewayClient   clients.GatewayHTTPClient
+
+	// Set once rule discovery has been configured
+	ruleFinderMu sync.Mutex
+	ruleFinder   rules.RuleFinder
+
+	startTime time.Time
+	// Time of the last successful remote-write push (time.Time)
+	lastPush atomic.Value
+
+	ruleSyncMu sync.Mutex
+	ruleSync   *core.RuleSyncStatus
+
+	metrics *prometheus.Registry
+
+	// Set if remote-write requests are buffered on disk
+	wal        *wal.WAL
+	walMetrics walMetrics
+
+	// Set if write relabel rules are configured
+	relabeler *relabel.Relabeler
 }
 
 type AgentOptions struct {
-	bootstrapper bootstrap.Bootstrapper
+	bootstrapper             bootstrap.Bootstrapper
+	keyRotationCheckInterval time.Duration
+	pinSyncInterval          time.Duration
+	controlStreamMinBackoff  time.Duration
+	controlStreamMaxBackoff  time.Duration
+	heartbeatInterval        time.Duration
+	walMinBackoff            time.Duration
+	walMaxBackoff            time.Duration
 }
 
 type AgentOption func(*AgentOptions)
@@ -53,13 +99,63 @@ func WithBootstrapper(bootstrapper bootstrap.Bootstrapper) AgentOption {
 	}
 }
 
+// WithKeyRotationCheckInterval sets how often the agent checks whether the
+// gateway has requested that its shared keys be rotated.
+func WithKeyRotationCheckInterval(interval time.Duration) AgentOption {
+	return func(o *AgentOptions) {
+		o.keyRotationCheckInterval = interval
+	}
+}
+
+// WithPinSyncInterval sets how often the agent fetches the pins announced by
+// the gateway and updates the pinned keys in its keyring.
+func WithPinSyncInterval(interval time.Duration) AgentOption {
+	return func(o *AgentOptions) {
+		o.pinSyncInterval = interval
+	}
+}
+
+// WithControlStreamBackoff sets the minimum and maximum delay between
+// attempts to reconnect the control stream to the gateway.
+func WithControlStreamBackoff(min, max time.Duration) AgentOption {
+	return func(o *AgentOptions) {
+		o.controlStreamMinBackoff = min
+		o.controlStreamMaxBackoff = max
+	}
+}
+
+// WithHeartbeatInterval sets how often the agent reports its status to the
+// gateway.
+func WithHeartbeatInterval(interval time.Duration) AgentOption {
+	return func(o *AgentOptions) {
+		o.heartbeatInterval = interval
+	}
+}
+
+// WithWALBackoff sets the minimum and maximum delay between attempts to
+// forward a request buffered in the WAL to the gateway.
+func WithWALBackoff(min, max time.Duration) AgentOption {
+	return func(o *AgentOptions) {
+		o.walMinBackoff = min
+		o.walMaxBackoff = max
+	}
+}
+
 func default404Handler(c *fiber.Ctx) error {
 	return c.SendStatus(fiber.StatusNotFound)
 }
 
 func New(ctx context.Context, conf *v1beta1.AgentConfig, opts ...AgentOption) (*Agent, error) {
 	lg := logger.New().Named("agent")
-	options := AgentOptions{}
+	options := AgentOptions{
+		keyRotationCheckInterval: 5 * time.Minute,
+		pinSyncInterval:          5 * time.Minute,
+		controlStreamMinBackoff:  1 * time.Second,
+		controlStreamMaxBackoff:  1 * time.Minute,
+		heartbeatInterval:        health.DefaultHeartbeatInterval,
+		walMinBackoff:            1 * time.Second,
+		walMaxBackoff:            1 * time.Minute,
+	}
 	options.Apply(opts...)
 
 	app := fiber.New(fiber.Config{
@@ -91,15 +187,60 @@ func New(ctx context.Context, conf *v1beta1.AgentConfig, opts ...AgentOption) (*
 		logger:           lg,
 		tenantID:         id,
 		identityProvider: ip,
+		startTime:        time.Now(),
+		ruleSync: &core.RuleSyncStatus{
+			Enabled: conf.Spec.Rules != nil,
+		},
+		metrics: prometheus.NewRegistry(),
+	}
+	agent.metrics.MustRegister(
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewBuildInfoCollector(),
+		collectors.NewGoCollector(),
+	)
+	if len(conf.Spec.WriteRelabelConfigs) > 0 {
+		agent.relabeler, err = relabel.New(conf.Spec.WriteRelabelConfigs)
+		if err != nil {
+			return nil, fmt.Errorf("configuration error: %w", err)
+		}
+		agent.metrics.MustRegister(agent.relabeler)
 	}
 	agent.shutdownLock.Lock()
 
+	vt, err := machinery.ConfigureValueTransformer(agent.Storage.Encryption)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring storage encryption: %w", err)
+	}
 	var keyringStoreBroker storage.KeyringStoreBroker
 	switch agent.Storage.Type {
 	case v1beta1.StorageTypeEtcd:
-		keyringStoreBroker = etcd.NewEtcdStore(ctx, agent.Storage.Etcd)
+		keyringStoreBroker = etcd.NewEtcdStore(ctx, agent.Storage.Etcd, etcd.WithValueTransformer(vt))
 	case v1beta1.StorageTypeCRDs:
-		keyringStoreBroker = crds.NewCRDStore()
+		keyringStoreBroker = crds.NewCRDStore(crds.WithValueTransformer(vt))
+	case v1beta1.StorageTypeBolt:
+		if agent.Storage.Bolt == nil {
+			return nil, errors.New("bolt storage options are not set")
+		}
+		keyringStoreBroker, err = bolt.NewBoltStore(agent.Storage.Bolt, bolt.WithValueTransformer(vt))
+		if err != nil {
+			return nil, fmt.Errorf("error creating bolt store: %w", err)
+		}
+	case v1beta1.StorageTypeSQL:
+		if agent.Storage.SQL == nil {
+			return nil, errors.New("sql storage options are not set")
+		}
+		keyringStoreBroker, err = sql.NewSQLStore(ctx, agent.Storage.SQL, sql.WithValueTransformer(vt))
+		if err != nil {
+			return nil, fmt.Errorf("error creating sql store: %w", err)
+		}
+	case v1beta1.StorageTypeFile:
+		if agent.Storage.File == nil {
+			return nil, errors.New("file storage options are not set")
+		}
+		keyringStoreBroker, err = file.NewFileStore(agent.Storage.File, file.WithValueTransformer(vt))
+		if err != nil {
+			return nil, fmt.Errorf("error creating file store: %w", err)
+		}
 	default:
 		return nil, fmt.Errorf("unknown storage type: %s", agent.Storage.Type)
 	}
@@ -129,29 +270,33 @@ func New(ctx context.Context, conf *v1beta1.AgentConfig, opts ...AgentOption) (*
 	if err != nil {
 		return nil, fmt.Errorf("error configuring gateway client: %w", err)
 	}
+	if conf.Spec.WAL != nil {
+		if err := agent.openWAL(conf.Spec.WAL); err != nil {
+			return nil, fmt.Errorf("error opening WAL: %w", err)
+		}
+		go agent.replayWAL(ctx)
+	}
 	go agent.streamRulesToGateway(ctx)
+	go agent.rotateKeysWhenRequired(ctx)
+	go agent.syncPinsPeriodically(ctx)
+	go agent.sendHeartbeats(ctx)
+	if conf.Spec.GatewayGRPCAddress != "" {
+		go agent.runControlStream(ctx)
+	}
 
 	app.Post("/api/agent/push", agent.handlePushRequest)
+	metricsHandler := fasthttpadaptor.NewFastHTTPHandler(promhttp.HandlerFor(agent.metrics, promhttp.HandlerOpts{
+		Registry: agent.metrics,
+	}))
+	app.Get("/metrics", func(c *fiber.Ctx) error {
+		metricsHandler(c.Context())
+		return nil
+	})
 	app.Use(default404Handler)
 
 	return agent, nil
 }
 
-func (a *Agent) handlePushRequest(c *fiber.Ctx) error {
-	code, body, err := a.gatewayClient.Post(context.Background(), "/api/agent/push").
-		Body(c.Body()).
-		Set(fiber.HeaderContentType, c.Get(fiber.HeaderContentType)).
-		Set(fiber.HeaderContentLength, c.Get(fiber.HeaderContentLength)).
-		Set(fiber.HeaderContentEncoding, c.Get(fiber.HeaderContentEncoding)).
-		Set("X-Prometheus-Remote-Write-Version", c.Get("X-Prometheus-Remote-Write-Version")).
-		Do()
-	if err != nil {
-		a.logger.Error(err)
-		return err
-	}
-	return c.Status(code).Send(body)
-}
-
 func (a *Agent) ListenAndServe() error {
 	a.shutdownLock.Unlock()
 	return a.app.Listen(a.ListenAddress)
@@ -209,3 +354,115 @@ func (a *Agent) loadKeyring(ctx context.Context) (keyring.Keyring, error) {
 	lg.Info("keyring loaded successfully")
 	return kr, nil
 }
+
+func (a *Agent) client() clients.GatewayHTTPClient {
+	a.gatewayClientMu.RLock()
+	defer a.gatewayClientMu.RUnlock()
+	return a.gatewayClient
+}
+
+// rotateKeysWhenRequired periodically checks whether the gateway has
+// scheduled the agent's shared keys for rotation, and if so, performs a new
+// key exchange.
+func (a *Agent) rotateKeysWhenRequired(ctx context.Context) {
+	lg := a.logger
+	ticker := time.NewTicker(a.keyRotationCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		required, err := bootstrap.KeyRotationRequired(ctx, a.client())
+		if err != nil {
+			lg.With(
+				zap.Error(err),
+			).Warn("failed to check if key rotation is required")
+			continue
+		}
+		if !required {
+			continue
+		}
+		lg.Info("rotating shared keys")
+		if err := a.rotateKeys(ctx); err != nil {
+			lg.With(
+				zap.Error(err),
+			).Error("failed to rotate shared keys")
+			continue
+		}
+		lg.Info("shared keys rotated successfully")
+	}
+}
+
+func (a *Agent) rotateKeys(ctx context.Context) error {
+	a.keyringMu.Lock()
+	defer a.keyringMu.Unlock()
+	kr, err := a.keyringStore.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("error loading keyring: %w", err)
+	}
+	kr, err = bootstrap.Rekey(ctx, a.client(), kr)
+	if err != nil {
+		return err
+	}
+	// If the new keyring cannot be persisted, the previous keys can still be
+	// used until they expire, and the exchange will be retried.
+	return a.updateKeyring(ctx, kr)
+}
+
+// syncPinsPeriodically keeps the pinned keys in the agent's keyring in sync
+// with the pins announced by the gateway, so that the gateway's serving
+// certificate can be replaced without bootstrapping the agent again.
+func (a *Agent) syncPinsPeriodically(ctx context.Context) {
+	lg := a.logger
+	ticker := time.NewTicker(a.pinSyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		changed, err := a.syncPins(ctx)
+		if err != nil {
+			lg.With(
+				zap.Error(err),
+			).Warn("failed to sync pinned keys")
+			continue
+		}
+		if changed {
+			lg.Info("pinned keys updated")
+		}
+	}
+}
+
+func (a *Agent) syncPins(ctx context.Context) (bool, error) {
+	a.keyringMu.Lock()
+	defer a.keyringMu.Unlock()
+	kr, err := a.keyringStore.Get(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error loading keyring: %w", err)
+	}
+	kr, changed, err := bootstrap.SyncPins(ctx, a.client(), kr)
+	if err != nil || !changed {
+		return false, err
+	}
+	return true, a.updateKeyring(ctx, kr)
+}
+
+// updateKeyring persists the keyring and replaces the gateway client with one
+// which uses the new keys.
+func (a *Agent) updateKeyring(ctx context.Context, kr keyring.Keyring) error {
+	if err := a.keyringStore.Put(ctx, kr); err != nil {
+		return fmt.Errorf("error storing keyring: %w", err)
+	}
+	client, err := clients.NewGatewayHTTPClient(a.GatewayAddress, a.identityProvider, kr)
+	if err != nil {
+		return fmt.Errorf("error configuring gateway client: %w", err)
+	}
+	a.gatewayClientMu.Lock()
+	a.gatewayClient = client
+	a.gatewayClientMu.Unlock()
+	return nil
+}
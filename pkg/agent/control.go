@@ -0,0 +1,198 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/backoff/v2"
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// runControlStream keeps a control stream open to the gateway, reconnecting
+// with exponential backoff whenever the stream is closed. The backoff is
+// reset once the gateway accepts a stream.
+func (a *Agent) runControlStream(ctx context.Context) {
+	lg := a.logger.Named("control")
+	p := backoff.Exponential(
+		backoff.WithMinInterval(a.controlStreamMinBackoff),
+		backoff.WithMaxInterval(a.controlStreamMaxBackoff),
+		backoff.WithMultiplier(2),
+		backoff.WithJitterFactor(0.05),
+		backoff.WithMaxRetries(0),
+	)
+	for {
+		bctx, ca := context.WithCancel(ctx)
+		b := p.Start(bctx)
+		for backoff.Continue(b) {
+			connected, err := a.connectControlStream(ctx, lg)
+			if ctx.Err() != nil {
+				break
+			}
+			lg.With(
+				zap.Error(err),
+			).Warn("control stream closed, reconnecting")
+			if connected {
+				break
+			}
+		}
+		ca()
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// connectControlStream opens a control stream and handles commands until the
+// stream is closed. The returned bool reports whether the gateway accepted
+// the stream.
+func (a *Agent) connectControlStream(ctx context.Context, lg *zap.SugaredLogger) (bool, error) {
+	kr, err := a.keyringStore.Get(ctx)
+	if err != nil {
+		return false, fmt.Errorf("error loading keyring: %w", err)
+	}
+	cc, err := clients.NewGatewayGRPCConn(ctx, a.GatewayGRPCAddress, a.identityProvider, kr,
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)
+	if err != nil {
+		return false, fmt.Errorf("error connecting to gateway: %w", err)
+	}
+	defer cc.Close()
+
+	streamCtx, ca := context.WithCancel(ctx)
+	defer ca()
+	stream, err := controlv1.NewAgentControlClient(cc).Connect(streamCtx)
+	if err != nil {
+		return false, err
+	}
+	// The gateway sends headers as soon as the stream is accepted
+	if _, err := stream.Header(); err != nil {
+		return false, err
+	}
+	lg.Info("control stream connected")
+
+	var sendMu sync.Mutex
+	for {
+		cmd, err := stream.Recv()
+		if err != nil {
+			return true, err
+		}
+		go func() {
+			result := a.handleCommand(streamCtx, lg, cmd)
+			sendMu.Lock()
+			defer sendMu.Unlock()
+			if err := stream.Send(result); err != nil {
+				lg.With(
+					zap.Error(err),
+					zap.String("id", cmd.GetId()),
+				).Warn("failed to send command result")
+			}
+		}()
+	}
+}
+
+func (a *Agent) handleCommand(
+	ctx context.Context,
+	lg *zap.SugaredLogger,
+	cmd *controlv1.Command,
+) *controlv1.CommandResult {
+	lg = lg.With(
+		zap.String("id", cmd.GetId()),
+		zap.String("type", cmd.GetType().String()),
+	)
+	lg.Info("received command from gateway")
+	result := &controlv1.CommandResult{
+		Id: cmd.GetId(),
+	}
+	var err error
+	switch cmd.GetType() {
+	case controlv1.CommandType_SyncRules:
+		result.Data, err = a.resyncRules(ctx)
+	case controlv1.CommandType_Diagnostics:
+		result.Data, err = a.diagnostics(ctx)
+	case controlv1.CommandType_RotateKeys:
+		err = a.rotateKeys(ctx)
+	default:
+		err = fmt.Errorf("unknown command type: %s", cmd.GetType())
+	}
+	if err != nil {
+		lg.With(
+			zap.Error(err),
+		).Warn("command failed")
+		result.Error = err.Error()
+	}
+	return result
+}
+
+// resyncRules runs rule discovery and sends all rule groups to the gateway,
+// without waiting for the next discovery interval.
+func (a *Agent) resyncRules(ctx context.Context) (map[string]string, error) {
+	a.ruleFinderMu.Lock()
+	finder := a.ruleFinder
+	a.ruleFinderMu.Unlock()
+	if finder == nil {
+		return nil, errors.New("rule discovery is not configured")
+	}
+	groups, err := finder.FindGroups(ctx)
+	if err != nil {
+		err = fmt.Errorf("rule discovery failed: %w", err)
+		a.recordRuleSync(0, err)
+		return nil, err
+	}
+	docs := a.marshalRuleGroups(groups)
+	for _, doc := range docs {
+		if err := a.sendRuleGroup(ctx, doc); err != nil {
+			a.recordRuleSync(0, err)
+			return nil, err
+		}
+	}
+	a.recordRuleSync(len(docs), nil)
+	return map[string]string{
+		"groups": strconv.Itoa(len(docs)),
+	}, nil
+}
+
+func (a *Agent) diagnostics(ctx context.Context) (map[string]string, error) {
+	kr, err := a.keyringStore.Get(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading keyring: %w", err)
+	}
+	var sharedKeys, pinnedKeys int
+	kr.Try(
+		func(*keyring.SharedKeys) {
+			sharedKeys++
+		},
+		func(pk *keyring.PKPKey) {
+			pinnedKeys += len(pk.PinnedKeys)
+		},
+	)
+	a.ruleFinderMu.Lock()
+	ruleDiscovery := a.ruleFinder != nil
+	a.ruleFinderMu.Unlock()
+	return map[string]string{
+		"id":                 a.tenantID,
+		"version":            agentVersion(),
+		"uptime":             time.Since(a.startTime).Round(time.Second).String(),
+		"goVersion":          runtime.Version(),
+		"gatewayAddress":     a.GatewayAddress,
+		"gatewayGrpcAddress": a.GatewayGRPCAddress,
+		"identityProvider":   a.IdentityProvider,
+		"storageType":        string(a.Storage.Type),
+		"sharedKeys":         strconv.Itoa(sharedKeys),
+		"pinnedKeys":         strconv.Itoa(pinnedKeys),
+		"ruleDiscovery":      strconv.FormatBool(ruleDiscovery),
+	}, nil
+}
@@ -0,0 +1,81 @@
+package agent
+
+import (
+	"context"
+	"runtime/debug"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/health"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// sendHeartbeats periodically reports the agent's status to the gateway,
+// starting immediately.
+func (a *Agent) sendHeartbeats(ctx context.Context) {
+	lg := a.logger
+	ticker := time.NewTicker(a.heartbeatInterval)
+	defer ticker.Stop()
+	for {
+		reqCtx, ca := context.WithTimeout(ctx, a.heartbeatInterval)
+		err := health.SendHeartbeat(reqCtx, a.client(), a.status())
+		ca()
+		if err != nil {
+			lg.With(
+				zap.Error(err),
+			).Warn("failed to send heartbeat")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (a *Agent) status() *core.ClusterStatus {
+	status := &core.ClusterStatus{
+		AgentVersion:      agentVersion(),
+		Uptime:            durationpb.New(time.Since(a.startTime)),
+		HeartbeatInterval: durationpb.New(a.heartbeatInterval),
+	}
+	if lastPush, ok := a.lastPush.Load().(time.Time); ok {
+		status.LastPushTimestamp = timestamppb.New(lastPush)
+	}
+	a.ruleSyncMu.Lock()
+	status.RuleSync = proto.Clone(a.ruleSync).(*core.RuleSyncStatus)
+	a.ruleSyncMu.Unlock()
+	return status
+}
+
+// recordRuleSync updates the rule sync state reported in heartbeats.
+func (a *Agent) recordRuleSync(groups int, err error) {
+	a.ruleSyncMu.Lock()
+	defer a.ruleSyncMu.Unlock()
+	if err != nil {
+		a.ruleSync.LastError = err.Error()
+		return
+	}
+	a.ruleSync.LastSyncTimestamp = timestamppb.Now()
+	a.ruleSync.Groups = int32(groups)
+	a.ruleSync.LastError = ""
+}
+
+func agentVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	if info.Main.Version != "(devel)" && info.Main.Version != "" {
+		return info.Main.Version
+	}
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" {
+			return setting.Value
+		}
+	}
+	return info.Main.Version
+}
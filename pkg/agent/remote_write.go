@@ -0,0 +1,313 @@
+package agent
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/snappy"
+	"github.com/lestrrat-go/backoff/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/wal"
+	"go.uber.org/zap"
+)
+
+const remoteWriteVersionHeader = "X-Prometheus-Remote-Write-Version"
+
+// Headers of remote-write requests which are forwarded to the gateway
+var forwardedHeaders = []string{
+	fiber.HeaderContentType,
+	fiber.HeaderContentEncoding,
+	remoteWriteVersionHeader,
+}
+
+// Reasons for which buffered samples are dropped
+const (
+	dropReasonFull     = "full"
+	dropReasonRejected = "rejected"
+	dropReasonInvalid  = "invalid"
+)
+
+type walMetrics struct {
+	droppedSamples *prometheus.CounterVec
+}
+
+func (a *Agent) handlePushRequest(c *fiber.Ctx) error {
+	headers := map[string]string{}
+	for _, key := range forwardedHeaders {
+		headers[key] = c.Get(key)
+	}
+	body := c.Body()
+	if a.relabeler != nil {
+		var err error
+		body, err = a.relabelPushRequest(body)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		}
+		if body == nil {
+			// All series were dropped, there is nothing to forward
+			return c.SendStatus(fiber.StatusOK)
+		}
+	}
+	if a.wal != nil {
+		return a.bufferPushRequest(c, headers, body)
+	}
+	code, respBody, err := a.forwardPushRequest(context.Background(), headers, body)
+	if err != nil {
+		a.logger.Error(err)
+		return err
+	}
+	return c.Status(code).Send(respBody)
+}
+
+// relabelPushRequest applies the agent's write relabel rules to a remote-write
+// request body, and returns the re-encoded body, or nil if all series were
+// dropped.
+func (a *Agent) relabelPushRequest(body []byte) ([]byte, error) {
+	wr, err := decodeWriteRequest(body)
+	if err != nil {
+		return nil, err
+	}
+	a.relabeler.Process(wr)
+	if len(wr.Timeseries) == 0 && len(wr.Metadata) == 0 {
+		return nil, nil
+	}
+	data, err := wr.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+	return snappy.Encode(nil, data), nil
+}
+
+func (a *Agent) forwardPushRequest(
+	ctx context.Context,
+	headers map[string]string,
+	body []byte,
+) (int, []byte, error) {
+	req := a.client().Post(ctx, "/api/agent/push").Body(body)
+	for key, value := range headers {
+		req = req.Set(key, value)
+	}
+	code, respBody, err := req.Do()
+	if err != nil {
+		return 0, nil, err
+	}
+	if code/100 == 2 {
+		a.lastPush.Store(time.Now())
+	}
+	return code, respBody, nil
+}
+
+// bufferPushRequest stores a push request in the WAL, to be forwarded to the
+// gateway by replayWAL. The request is acknowledged once it is on disk.
+func (a *Agent) bufferPushRequest(c *fiber.Ctx, headers map[string]string, body []byte) error {
+	rec, err := encodePushRecord(headers, body)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	}
+	dropped, err := a.wal.Append(rec)
+	for _, d := range dropped {
+		a.dropRecord(d.Data, dropReasonFull)
+	}
+	if len(dropped) > 0 {
+		a.logger.With(
+			zap.Int("requests", len(dropped)),
+		).Warn("WAL is full, dropped oldest requests")
+	}
+	if err != nil {
+		if errors.Is(err, wal.ErrRecordTooLarge) {
+			return c.Status(fiber.StatusRequestEntityTooLarge).SendString(err.Error())
+		}
+		a.logger.With(
+			zap.Error(err),
+		).Error("failed to write request to WAL")
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// replayWAL forwards buffered push requests to the gateway in the order in
+// which they were received. Requests which fail with a retryable error are
+// retried with exponential backoff, which is reset for each request.
+func (a *Agent) replayWAL(ctx context.Context) {
+	lg := a.logger.Named("wal")
+	p := backoff.Exponential(
+		backoff.WithMinInterval(a.walMinBackoff),
+		backoff.WithMaxInterval(a.walMaxBackoff),
+		backoff.WithMultiplier(2),
+		backoff.WithJitterFactor(0.05),
+		backoff.WithMaxRetries(0),
+	)
+	for {
+		for a.wal.Len() == 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-a.wal.Notify():
+			}
+		}
+		bctx, ca := context.WithCancel(ctx)
+		b := p.Start(bctx)
+		for backoff.Continue(b) {
+			if a.replayNext(ctx, lg) {
+				break
+			}
+		}
+		ca()
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+// replayNext forwards the oldest buffered request. It returns false if the
+// request should be retried.
+func (a *Agent) replayNext(ctx context.Context, lg *zap.SugaredLogger) bool {
+	rec, ok, err := a.wal.Front()
+	if err != nil {
+		lg.With(
+			zap.Error(err),
+		).Error("failed to read request from WAL")
+		return false
+	}
+	if !ok {
+		return true
+	}
+	headers, body, err := decodePushRecord(rec.Data)
+	if err != nil {
+		lg.With(
+			zap.Error(err),
+			zap.Uint64("seq", rec.Seq),
+		).Error("dropping invalid request in WAL")
+		a.dropRecord(rec.Data, dropReasonInvalid)
+		a.removeRecord(lg, rec.Seq)
+		return true
+	}
+	code, respBody, err := a.forwardPushRequest(ctx, headers, body)
+	switch {
+	case err != nil:
+		lg.With(
+			zap.Error(err),
+		).Warn("failed to forward buffered request, will retry")
+		return false
+	case code/100 == 2:
+	case code/100 == 4 && code != fiber.StatusTooManyRequests:
+		// Retrying will not help
+		lg.With(
+			zap.Int("code", code),
+			zap.String("response", string(respBody)),
+		).Warn("gateway rejected buffered request, dropping it")
+		a.dropRecord(rec.Data, dropReasonRejected)
+	default:
+		lg.With(
+			zap.Int("code", code),
+		).Warn("failed to forward buffered request, will retry")
+		return false
+	}
+	a.removeRecord(lg, rec.Seq)
+	return true
+}
+
+func (a *Agent) removeRecord(lg *zap.SugaredLogger, seq uint64) {
+	if err := a.wal.Remove(seq); err != nil {
+		lg.With(
+			zap.Error(err),
+			zap.Uint64("seq", seq),
+		).Error("failed to remove request from WAL")
+	}
+}
+
+func (a *Agent) dropRecord(rec []byte, reason string) {
+	var samples int
+	if _, body, err := decodePushRecord(rec); err == nil {
+		if wr, err := decodeWriteRequest(body); err == nil {
+			for _, ts := range wr.Timeseries {
+				samples += len(ts.Samples)
+			}
+		}
+	}
+	a.walMetrics.droppedSamples.WithLabelValues(reason).Add(float64(samples))
+}
+
+// openWAL opens the WAL described by the agent config and registers its
+// metrics.
+func (a *Agent) openWAL(spec *v1beta1.WALSpec) error {
+	if spec.Dir == "" {
+		return errors.New("WAL directory is not set")
+	}
+	w, err := wal.Open(spec.Dir, wal.WithMaxSize(spec.MaxSizeBytes))
+	if err != nil {
+		return err
+	}
+	a.wal = w
+	a.walMetrics = walMetrics{
+		droppedSamples: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "opni_agent_wal_dropped_samples_total",
+			Help: "Number of buffered samples which were dropped before being forwarded to the gateway",
+		}, []string{"reason"}),
+	}
+	a.metrics.MustRegister(
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "opni_agent_wal_queue_depth",
+			Help: "Number of remote-write requests waiting in the WAL to be forwarded to the gateway",
+		}, func() float64 {
+			return float64(w.Len())
+		}),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Name: "opni_agent_wal_queue_size_bytes",
+			Help: "Total size of the remote-write requests waiting in the WAL",
+		}, func() float64 {
+			return float64(w.Size())
+		}),
+		a.walMetrics.droppedSamples,
+	)
+	return nil
+}
+
+// encodePushRecord encodes a push request as a WAL record: the length of the
+// JSON-encoded headers as a 4-byte big-endian integer, followed by the
+// headers and the request body.
+func encodePushRecord(headers map[string]string, body []byte) ([]byte, error) {
+	h, err := json.Marshal(headers)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode headers: %w", err)
+	}
+	rec := make([]byte, 4, 4+len(h)+len(body))
+	binary.BigEndian.PutUint32(rec, uint32(len(h)))
+	rec = append(rec, h...)
+	return append(rec, body...), nil
+}
+
+func decodePushRecord(rec []byte) (map[string]string, []byte, error) {
+	if len(rec) < 4 {
+		return nil, nil, errors.New("record is too short")
+	}
+	n := binary.BigEndian.Uint32(rec)
+	if uint64(n) > uint64(len(rec)-4) {
+		return nil, nil, errors.New("record is too short")
+	}
+	headers := map[string]string{}
+	if err := json.Unmarshal(rec[4:4+n], &headers); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode headers: %w", err)
+	}
+	return headers, rec[4+n:], nil
+}
+
+// decodeWriteRequest decodes a snappy-compressed remote-write request body.
+func decodeWriteRequest(body []byte) (*prompb.WriteRequest, error) {
+	data, err := snappy.Decode(nil, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress request: %w", err)
+	}
+	wr := &prompb.WriteRequest{}
+	if err := wr.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+	}
+	return wr, nil
+}
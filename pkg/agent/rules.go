@@ -39,6 +39,9 @@ func (a *Agent) streamRuleGroupUpdates(ctx context.Context) (<-chan [][]byte, er
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure rule discovery: %w", err)
 	}
+	a.ruleFinderMu.Lock()
+	a.ruleFinder = finder
+	a.ruleFinderMu.Unlock()
 	searchInterval := time.Minute * 15
 	if interval := a.Rules.Discovery.Interval; interval != "" {
 		duration, err := time.ParseDuration(interval)
@@ -92,6 +95,9 @@ func (a *Agent) streamRulesToGateway(ctx context.Context) error {
 		a.logger.With(
 			zap.Error(err),
 		).Error("failed to configure rule discovery")
+		if a.Rules != nil {
+			a.recordRuleSync(0, err)
+		}
 		return err
 	}
 	pending := make(chan [][]byte, 1)
@@ -109,17 +115,11 @@ func (a *Agent) streamRulesToGateway(ctx context.Context) error {
 			lg.Debug("sending alert rules to gateway")
 			for {
 				for _, doc := range docs {
-					reqCtx, ca := context.WithTimeout(ctx, time.Second*2)
-					defer ca()
-					code, _, err := a.gatewayClient.Post(reqCtx, "/api/agent/sync_rules").
-						Set("Content-Type", "application/yaml").
-						Body(doc).
-						Do()
-					if err != nil || code != http.StatusAccepted {
+					if err := a.sendRuleGroup(ctx, doc); err != nil {
+						a.recordRuleSync(0, err)
 						// retry, unless another update is received from the channel
 						lg.With(
 							zap.Error(err),
-							zap.Int("code", code),
 						).Error("failed to send alert rules to gateway (retry in 5 seconds)")
 						select {
 						case docs = <-pending:
@@ -133,6 +133,7 @@ func (a *Agent) streamRulesToGateway(ctx context.Context) error {
 						}
 					}
 				}
+				a.recordRuleSync(len(docs), nil)
 				lg.Infof("successfully sent %d alert rules to gateway", len(docs))
 				break
 			}
@@ -151,3 +152,19 @@ func (a *Agent) streamRulesToGateway(ctx context.Context) error {
 		}
 	}
 }
+
+func (a *Agent) sendRuleGroup(ctx context.Context, doc []byte) error {
+	reqCtx, ca := context.WithTimeout(ctx, time.Second*2)
+	defer ca()
+	code, _, err := a.client().Post(reqCtx, "/api/agent/sync_rules").
+		Set("Content-Type", "application/yaml").
+		Body(doc).
+		Do()
+	if err != nil {
+		return err
+	}
+	if code != http.StatusAccepted {
+		return fmt.Errorf("unexpected response code from gateway: %d", code)
+	}
+	return nil
+}
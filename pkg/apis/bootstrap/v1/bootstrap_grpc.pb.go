@@ -0,0 +1,141 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - ragù               v0.2.3
+// source: pkg/apis/bootstrap/v1/bootstrap.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BootstrapClient is the client API for Bootstrap service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BootstrapClient interface {
+	Join(ctx context.Context, in *BootstrapJoinRequest, opts ...grpc.CallOption) (*BootstrapJoinResponse, error)
+	Auth(ctx context.Context, in *BootstrapAuthRequest, opts ...grpc.CallOption) (*BootstrapAuthResponse, error)
+}
+
+type bootstrapClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBootstrapClient(cc grpc.ClientConnInterface) BootstrapClient {
+	return &bootstrapClient{cc}
+}
+
+func (c *bootstrapClient) Join(ctx context.Context, in *BootstrapJoinRequest, opts ...grpc.CallOption) (*BootstrapJoinResponse, error) {
+	out := new(BootstrapJoinResponse)
+	err := c.cc.Invoke(ctx, "/bootstrap.v1.Bootstrap/Join", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapClient) Auth(ctx context.Context, in *BootstrapAuthRequest, opts ...grpc.CallOption) (*BootstrapAuthResponse, error) {
+	out := new(BootstrapAuthResponse)
+	err := c.cc.Invoke(ctx, "/bootstrap.v1.Bootstrap/Auth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BootstrapServer is the server API for Bootstrap service.
+// All implementations must embed UnimplementedBootstrapServer
+// for forward compatibility
+type BootstrapServer interface {
+	Join(context.Context, *BootstrapJoinRequest) (*BootstrapJoinResponse, error)
+	Auth(context.Context, *BootstrapAuthRequest) (*BootstrapAuthResponse, error)
+	mustEmbedUnimplementedBootstrapServer()
+}
+
+// UnimplementedBootstrapServer must be embedded to have forward compatible implementations.
+type UnimplementedBootstrapServer struct {
+}
+
+func (UnimplementedBootstrapServer) Join(context.Context, *BootstrapJoinRequest) (*BootstrapJoinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Join not implemented")
+}
+func (UnimplementedBootstrapServer) Auth(context.Context, *BootstrapAuthRequest) (*BootstrapAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auth not implemented")
+}
+func (UnimplementedBootstrapServer) mustEmbedUnimplementedBootstrapServer() {}
+
+// UnsafeBootstrapServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BootstrapServer will
+// result in compilation errors.
+type UnsafeBootstrapServer interface {
+	mustEmbedUnimplementedBootstrapServer()
+}
+
+func RegisterBootstrapServer(s grpc.ServiceRegistrar, srv BootstrapServer) {
+	s.RegisterService(&Bootstrap_ServiceDesc, srv)
+}
+
+func _Bootstrap_Join_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapJoinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServer).Join(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bootstrap.v1.Bootstrap/Join",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServer).Join(ctx, req.(*BootstrapJoinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bootstrap_Auth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServer).Auth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/bootstrap.v1.Bootstrap/Auth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServer).Auth(ctx, req.(*BootstrapAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Bootstrap_ServiceDesc is the grpc.ServiceDesc for Bootstrap service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Bootstrap_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "bootstrap.v1.Bootstrap",
+	HandlerType: (*BootstrapServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Join",
+			Handler:    _Bootstrap_Join_Handler,
+		},
+		{
+			MethodName: "Auth",
+			Handler:    _Bootstrap_Auth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/apis/bootstrap/v1/bootstrap.proto",
+}
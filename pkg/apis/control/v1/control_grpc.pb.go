@@ -0,0 +1,137 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - ragù               v0.2.3
+// source: pkg/apis/control/v1/control.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AgentControlClient is the client API for AgentControl service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AgentControlClient interface {
+	Connect(ctx context.Context, opts ...grpc.CallOption) (AgentControl_ConnectClient, error)
+}
+
+type agentControlClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentControlClient(cc grpc.ClientConnInterface) AgentControlClient {
+	return &agentControlClient{cc}
+}
+
+func (c *agentControlClient) Connect(ctx context.Context, opts ...grpc.CallOption) (AgentControl_ConnectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AgentControl_ServiceDesc.Streams[0], "/control.v1.AgentControl/Connect", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentControlConnectClient{stream}
+	return x, nil
+}
+
+type AgentControl_ConnectClient interface {
+	Send(*CommandResult) error
+	Recv() (*Command, error)
+	grpc.ClientStream
+}
+
+type agentControlConnectClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentControlConnectClient) Send(m *CommandResult) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentControlConnectClient) Recv() (*Command, error) {
+	m := new(Command)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AgentControlServer is the server API for AgentControl service.
+// All implementations must embed UnimplementedAgentControlServer
+// for forward compatibility
+type AgentControlServer interface {
+	Connect(AgentControl_ConnectServer) error
+	mustEmbedUnimplementedAgentControlServer()
+}
+
+// UnimplementedAgentControlServer must be embedded to have forward compatible implementations.
+type UnimplementedAgentControlServer struct {
+}
+
+func (UnimplementedAgentControlServer) Connect(AgentControl_ConnectServer) error {
+	return status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedAgentControlServer) mustEmbedUnimplementedAgentControlServer() {}
+
+// UnsafeAgentControlServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentControlServer will
+// result in compilation errors.
+type UnsafeAgentControlServer interface {
+	mustEmbedUnimplementedAgentControlServer()
+}
+
+func RegisterAgentControlServer(s grpc.ServiceRegistrar, srv AgentControlServer) {
+	s.RegisterService(&AgentControl_ServiceDesc, srv)
+}
+
+func _AgentControl_Connect_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentControlServer).Connect(&agentControlConnectServer{stream})
+}
+
+type AgentControl_ConnectServer interface {
+	Send(*Command) error
+	Recv() (*CommandResult, error)
+	grpc.ServerStream
+}
+
+type agentControlConnectServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentControlConnectServer) Send(m *Command) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentControlConnectServer) Recv() (*CommandResult, error) {
+	m := new(CommandResult)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AgentControl_ServiceDesc is the grpc.ServiceDesc for AgentControl service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AgentControl_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "control.v1.AgentControl",
+	HandlerType: (*AgentControlServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Connect",
+			Handler:       _AgentControl_Connect_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/apis/control/v1/control.proto",
+}
@@ -0,0 +1,201 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/logger"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Namespace is the key-value store namespace in which audit events are
+// stored.
+const Namespace = "audit"
+
+// Log records audit events in a key-value store. Each event is stored under
+// its ID, which begins with the zero-padded unix timestamp of the event, so
+// that keys sort in the order in which events were recorded. Events older
+// than the retention period are periodically deleted by Run.
+type Log struct {
+	LogOptions
+	store  storage.KeyValueStore
+	logger *zap.SugaredLogger
+}
+
+type LogOptions struct {
+	retention     time.Duration
+	pruneInterval time.Duration
+}
+
+type LogOption func(*LogOptions)
+
+func (o *LogOptions) Apply(opts ...LogOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithRetention sets how long events are kept for. Defaults to 90 days.
+func WithRetention(retention time.Duration) LogOption {
+	return func(o *LogOptions) {
+		o.retention = retention
+	}
+}
+
+// WithPruneInterval sets how often expired events are deleted. Defaults to
+// one hour.
+func WithPruneInterval(interval time.Duration) LogOption {
+	return func(o *LogOptions) {
+		o.pruneInterval = interval
+	}
+}
+
+func NewLog(store storage.KeyValueStore, opts ...LogOption) *Log {
+	options := LogOptions{
+		retention:     90 * 24 * time.Hour,
+		pruneInterval: time.Hour,
+	}
+	options.Apply(opts...)
+	return &Log{
+		LogOptions: options,
+		store:      store,
+		logger:     logger.New().Named("audit"),
+	}
+}
+
+// Record stores an event. If the event's timestamp is not set, it is set to
+// the current time. The event's ID is always assigned by the log.
+func (l *Log) Record(ctx context.Context, event *core.AuditEvent) error {
+	if event.Timestamp == nil {
+		event.Timestamp = timestamppb.Now()
+	}
+	event.Id = newID(event.Timestamp.AsTime())
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return l.store.Put(ctx, event.Id, data)
+}
+
+// List returns recorded events which were recorded within the given time
+// range. A zero since or until time leaves that end of the range unbounded.
+func (l *Log) List(
+	ctx context.Context,
+	since, until time.Time,
+	opts ...storage.ListOption,
+) (*core.AuditEventList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	keys, err := l.store.ListKeys(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		ts, err := parseID(key)
+		if err != nil {
+			l.logger.With(
+				"key", key,
+			).Warn("ignoring malformed audit event key")
+			continue
+		}
+		if !since.IsZero() && ts.Before(since) {
+			continue
+		}
+		if !until.IsZero() && !ts.Before(until) {
+			continue
+		}
+		ids = append(ids, key)
+	}
+	page, next, err := storage.Paginate(ids, func(id string) string { return id }, options)
+	if err != nil {
+		return nil, err
+	}
+	list := &core.AuditEventList{
+		Items:         make([]*core.AuditEvent, 0, len(page)),
+		NextPageToken: next,
+	}
+	for _, id := range page {
+		data, err := l.store.Get(ctx, id)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				// pruned since the keys were listed
+				continue
+			}
+			return nil, err
+		}
+		event := &core.AuditEvent{}
+		if err := proto.Unmarshal(data, event); err != nil {
+			return nil, fmt.Errorf("malformed audit event %s: %w", id, err)
+		}
+		list.Items = append(list.Items, event)
+	}
+	return list, nil
+}
+
+// Prune deletes events which are older than the retention period, and returns
+// the number of events deleted.
+func (l *Log) Prune(ctx context.Context) (int, error) {
+	keys, err := l.store.ListKeys(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	cutoff := time.Now().Add(-l.retention)
+	deleted := 0
+	for _, key := range keys {
+		ts, err := parseID(key)
+		if err != nil || !ts.Before(cutoff) {
+			continue
+		}
+		if err := l.store.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return deleted, err
+		}
+		deleted++
+	}
+	return deleted, nil
+}
+
+// Run periodically prunes expired events until the context is canceled.
+func (l *Log) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.pruneInterval)
+	defer ticker.Stop()
+	for {
+		if n, err := l.Prune(ctx); err != nil {
+			l.logger.With(
+				zap.Error(err),
+			).Warn("failed to prune audit events")
+		} else if n > 0 {
+			l.logger.Debugf("pruned %d audit events", n)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func newID(ts time.Time) string {
+	return fmt.Sprintf("%019d-%s", ts.UnixNano(), uuid.NewString())
+}
+
+func parseID(id string) (time.Time, error) {
+	prefix, _, ok := strings.Cut(id, "-")
+	if !ok {
+		return time.Time{}, fmt.Errorf("malformed audit event id: %s", id)
+	}
+	nanos, err := strconv.ParseInt(prefix, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("malformed audit event id: %s", id)
+	}
+	return time.Unix(0, nanos), nil
+}
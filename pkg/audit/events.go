@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const redacted = "<redacted>"
+
+// Names of string fields whose values are replaced when encoding requests.
+var sensitiveFields = map[protoreflect.Name]struct{}{
+	"passphrase": {},
+	"password":   {},
+	"secret":     {},
+	"token":      {},
+	"pin":        {},
+}
+
+// EncodeRequest encodes a request as JSON for an audit event. Bytes fields
+// are removed, since they may contain large or sensitive data such as
+// archives or config documents, and the values of sensitive string fields
+// are redacted.
+func EncodeRequest(req proto.Message) string {
+	if req == nil {
+		return ""
+	}
+	msg := proto.Clone(req)
+	redact(msg.ProtoReflect())
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+func redact(msg protoreflect.Message) {
+	// Fields are modified after ranging over them, since the message must not
+	// be modified during iteration.
+	var cleared, replaced []protoreflect.FieldDescriptor
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		_, sensitive := sensitiveFields[fd.Name()]
+		switch {
+		case fd.Kind() == protoreflect.BytesKind:
+			cleared = append(cleared, fd)
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.MessageKind {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					redact(mv.Message())
+					return true
+				})
+			}
+		case fd.IsList():
+			if fd.Kind() == protoreflect.MessageKind {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					redact(list.Get(i).Message())
+				}
+			} else if sensitive && fd.Kind() == protoreflect.StringKind {
+				list := v.List()
+				for i := 0; i < list.Len(); i++ {
+					list.Set(i, protoreflect.ValueOfString(redacted))
+				}
+			}
+		case fd.Kind() == protoreflect.MessageKind:
+			redact(v.Message())
+		case fd.Kind() == protoreflect.StringKind && sensitive:
+			replaced = append(replaced, fd)
+		}
+		return true
+	})
+	for _, fd := range cleared {
+		msg.Clear(fd)
+	}
+	for _, fd := range replaced {
+		msg.Set(fd, protoreflect.ValueOfString(redacted))
+	}
+}
+
+// NewResult returns the result of an operation which returned the given
+// error, which may be nil.
+func NewResult(err error) *core.AuditResult {
+	stat := status.Convert(err)
+	return &core.AuditResult{
+		Code:    stat.Code().String(),
+		Message: stat.Message(),
+	}
+}
+
+// CallerFromContext returns information about the caller of a gRPC request.
+func CallerFromContext(ctx context.Context) *core.AuditCaller {
+	caller := &core.AuditCaller{}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		caller.Address = p.Addr.String()
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		// Requests made through the HTTP gateway carry the original user agent
+		// in a separate header.
+		if ua := md.Get("grpcgateway-user-agent"); len(ua) > 0 {
+			caller.UserAgent = ua[0]
+		} else if ua := md.Get("user-agent"); len(ua) > 0 {
+			caller.UserAgent = ua[0]
+		}
+		if fwd := md.Get("x-forwarded-for"); len(fwd) > 0 {
+			caller.ForwardedFor = strings.Join(fwd, ", ")
+		}
+	}
+	return caller
+}
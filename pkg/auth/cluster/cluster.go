@@ -3,11 +3,13 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
-	"github.com/google/uuid"
 	"github.com/rancher/opni-monitoring/pkg/auth"
 	"github.com/rancher/opni-monitoring/pkg/b2mac"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/ecdh"
 	"github.com/rancher/opni-monitoring/pkg/keyring"
@@ -22,24 +24,87 @@ const (
 )
 
 type ClusterMiddleware struct {
+	ClusterMiddlewareOptions
 	keyringStoreBroker storage.KeyringStoreBroker
 	fakeKeyringStore   storage.KeyringStore
 	headerKey          string
+	nonces             *nonceCache
 	logger             *zap.SugaredLogger
 }
 
 var _ auth.Middleware = (*ClusterMiddleware)(nil)
 
-func New(keyringStore storage.KeyringStoreBroker, headerKey string) (*ClusterMiddleware, error) {
+type ClusterMiddlewareOptions struct {
+	maxClockSkew     time.Duration
+	minHeaderVersion int
+	clusterStore     storage.ClusterStore
+}
+
+type ClusterMiddlewareOption func(*ClusterMiddlewareOptions)
+
+func (o *ClusterMiddlewareOptions) Apply(opts ...ClusterMiddlewareOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithMaxClockSkew sets the maximum difference between the timestamp of a
+// request and the current time. Requests outside of this window are
+// rejected. Defaults to 5 minutes.
+func WithMaxClockSkew(skew time.Duration) ClusterMiddlewareOption {
+	return func(o *ClusterMiddlewareOptions) {
+		o.maxClockSkew = skew
+	}
+}
+
+// WithMinimumHeaderVersion rejects requests with authorization headers older
+// than the given version (see b2mac.Version0, etc.). By default, all header
+// versions are accepted for compatibility with older agents.
+func WithMinimumHeaderVersion(version int) ClusterMiddlewareOption {
+	return func(o *ClusterMiddlewareOptions) {
+		o.minHeaderVersion = version
+	}
+}
+
+// WithClusterStore enables rejection of requests from clusters which have not
+// been approved. Requests from unknown clusters are also rejected.
+func WithClusterStore(store storage.ClusterStore) ClusterMiddlewareOption {
+	return func(o *ClusterMiddlewareOptions) {
+		o.clusterStore = store
+	}
+}
+
+// ConfigOptions returns the middleware options corresponding to the gateway's
+// cluster auth configuration.
+func ConfigOptions(spec v1beta1.ClusterAuthSpec) []ClusterMiddlewareOption {
+	var opts []ClusterMiddlewareOption
+	if spec.RequireV2MAC {
+		opts = append(opts, WithMinimumHeaderVersion(b2mac.Version2))
+	}
+	return opts
+}
+
+func New(
+	keyringStore storage.KeyringStoreBroker,
+	headerKey string,
+	opts ...ClusterMiddlewareOption,
+) (*ClusterMiddleware, error) {
+	options := ClusterMiddlewareOptions{
+		maxClockSkew: 5 * time.Minute,
+	}
+	options.Apply(opts...)
+
 	fakeKeyringStore, err := initFakeKeyring(keyringStore)
 	if err != nil {
 		return nil, fmt.Errorf("failed to set up keyring store: %w", err)
 	}
 
 	return &ClusterMiddleware{
-		keyringStoreBroker: keyringStore,
-		fakeKeyringStore:   fakeKeyringStore,
-		headerKey:          headerKey,
+		ClusterMiddlewareOptions: options,
+		keyringStoreBroker:       keyringStore,
+		fakeKeyringStore:         fakeKeyringStore,
+		headerKey:                headerKey,
+		nonces:                   newNonceCache(options.maxClockSkew),
 		logger: logger.New(
 			logger.WithSampling(&zap.SamplingConfig{
 				Initial:    1,
@@ -73,29 +138,86 @@ func initFakeKeyring(broker storage.KeyringStoreBroker) (storage.KeyringStore, e
 	return store, nil
 }
 
-func (m *ClusterMiddleware) doFakeKeyringVerify(mac []byte, id []byte, nonce uuid.UUID, payload []byte) {
+func (m *ClusterMiddleware) doFakeKeyringVerify(header b2mac.AuthHeader, req b2mac.RequestInfo, payload []byte) {
 	fakeKeyring, err := m.fakeKeyringStore.Get(context.Background())
 	if err != nil {
 		m.logger.Errorf("failed to get fake keyring: %v", err)
 		return
 	}
 	fakeKeyring.Try(func(shared *keyring.SharedKeys) {
-		b2mac.Verify(mac, id, nonce, payload, shared.ClientKey)
+		header.Verify(req, payload, shared.ClientKey)
 	})
 }
 
+// authError is returned when a request cannot be authenticated. The code is
+// the HTTP status code which should be sent to the client.
+type authError struct {
+	code    int
+	message string
+}
+
+func (e *authError) Error() string {
+	if e.message == "" {
+		return http.StatusText(e.code)
+	}
+	return e.message
+}
+
 func (m *ClusterMiddleware) Handle(c *fiber.Ctx) error {
+	req := b2mac.RequestInfo{
+		Method: c.Method(),
+		Path:   string(c.Request().URI().Path()),
+		Query:  string(c.Request().URI().QueryString()),
+	}
+	clusterID, sharedKeys, err := m.authenticate(c.Get("Authorization"), req, c.Body(), m.minHeaderVersion)
+	if err != nil {
+		if err.message == "" {
+			return c.SendStatus(err.code)
+		}
+		return c.Status(err.code).SendString(err.message)
+	}
+	c.Request().Header.Add(m.headerKey, clusterID)
+	c.Locals(SharedKeysKey, sharedKeys)
+	c.Locals(ClusterIDKey, clusterID)
+	return c.Next()
+}
+
+// authenticate verifies the MAC in the authorization header for the given
+// request, and returns the ID of the cluster and the shared keys with which
+// the request was signed. Headers older than minVersion are rejected.
+func (m *ClusterMiddleware) authenticate(
+	authHeader string,
+	req b2mac.RequestInfo,
+	body []byte,
+	minVersion int,
+) (string, *keyring.SharedKeys, *authError) {
 	lg := m.logger
-	authHeader := c.Get("Authorization")
 	if authHeader == "" {
 		lg.Debug("unauthorized: authorization header required")
-		return c.Status(fiber.StatusBadRequest).SendString("authorization header required")
+		return "", nil, &authError{fiber.StatusBadRequest, "authorization header required"}
 	}
 
-	clusterID, nonce, mac, err := b2mac.DecodeAuthHeader(authHeader)
+	header, err := b2mac.ParseAuthHeader(authHeader)
 	if err != nil {
 		lg.Debug("unauthorized: malformed MAC in auth header: " + authHeader)
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		return "", nil, &authError{fiber.StatusBadRequest, err.Error()}
+	}
+	clusterID := header.ID
+	if header.Version < minVersion {
+		lg.Debugf("unauthorized: cluster %s sent a version %d auth header", clusterID, header.Version)
+		return "", nil, &authError{fiber.StatusUnauthorized, "unsupported authorization header version"}
+	}
+
+	// Version 0 headers have no timestamp. They are still accepted for
+	// compatibility with older agents, but can only be checked against
+	// recently used nonces.
+	nonceExpiration := time.Now().Add(2 * m.maxClockSkew)
+	if header.Version >= b2mac.Version1 {
+		if skew := time.Since(header.Timestamp); skew > m.maxClockSkew || skew < -m.maxClockSkew {
+			lg.Debugf("unauthorized: request timestamp for cluster %s is outside of the allowed window", clusterID)
+			return "", nil, &authError{fiber.StatusUnauthorized, "request timestamp is outside of the allowed window"}
+		}
+		nonceExpiration = header.Timestamp.Add(m.maxClockSkew)
 	}
 
 	ks, err := m.keyringStoreBroker.KeyringStore(context.Background(), "gateway", &core.Reference{
@@ -103,36 +225,53 @@ func (m *ClusterMiddleware) Handle(c *fiber.Ctx) error {
 	})
 	if err != nil {
 		lg.Debugf("unauthorized: error looking up keyring store for cluster %s: %v", clusterID, err)
-		m.doFakeKeyringVerify(mac, clusterID, nonce, c.Body())
-		return c.SendStatus(fiber.StatusUnauthorized)
+		m.doFakeKeyringVerify(header, req, body)
+		return "", nil, &authError{code: fiber.StatusUnauthorized}
 	}
 
 	kr, err := ks.Get(context.Background())
 	if err != nil {
 		lg.Debugf("unauthorized: error looking up keyring for cluster %s: %v", clusterID, err)
-		m.doFakeKeyringVerify(mac, clusterID, nonce, c.Body())
-		return c.SendStatus(fiber.StatusUnauthorized)
+		m.doFakeKeyringVerify(header, req, body)
+		return "", nil, &authError{code: fiber.StatusUnauthorized}
 	}
 
 	authorized := false
 	var sharedKeys *keyring.SharedKeys
 	if ok := kr.Try(func(shared *keyring.SharedKeys) {
-		if err := b2mac.Verify(mac, clusterID, nonce, c.Body(), shared.ClientKey); err == nil {
+		if shared.Expired() {
+			return
+		}
+		if err := header.Verify(req, body, shared.ClientKey); err == nil {
 			authorized = true
 			sharedKeys = shared
 		}
 	}); !ok {
-		lg.Errorf("unauthorized: invalid or corrupted keyring for cluster %s: %v", clusterID, err)
-		return c.Status(fiber.StatusInternalServerError).SendString("invalid or corrupted keyring")
+		lg.Errorf("unauthorized: invalid or corrupted keyring for cluster %s: no shared keys found", clusterID)
+		return "", nil, &authError{fiber.StatusInternalServerError, "invalid or corrupted keyring"}
 	}
 	if !authorized {
 		lg.Debugf("unauthorized: invalid mac for cluster %s", clusterID)
-		return c.SendStatus(fiber.StatusUnauthorized)
+		return "", nil, &authError{code: fiber.StatusUnauthorized}
 	}
-	c.Request().Header.Add(m.headerKey, string(clusterID))
-	c.Locals(SharedKeysKey, sharedKeys)
-	c.Locals(ClusterIDKey, string(clusterID))
-	return c.Next()
+	if !m.nonces.Add(string(clusterID), header.Nonce, nonceExpiration) {
+		lg.Debugf("unauthorized: nonce reused for cluster %s", clusterID)
+		return "", nil, &authError{code: fiber.StatusUnauthorized}
+	}
+	if m.clusterStore != nil {
+		cluster, err := m.clusterStore.GetCluster(context.Background(), &core.Reference{
+			Id: string(clusterID),
+		})
+		if err != nil {
+			lg.Debugf("unauthorized: error looking up cluster %s: %v", clusterID, err)
+			return "", nil, &authError{code: fiber.StatusUnauthorized}
+		}
+		if cluster.GetMetadata().GetApproval().GetState() != core.ApprovalState_ClusterApproved {
+			lg.Debugf("forbidden: cluster %s has not been approved", clusterID)
+			return "", nil, &authError{fiber.StatusForbidden, "cluster has not been approved"}
+		}
+	}
+	return string(clusterID), sharedKeys, nil
 }
 
 func AuthorizedKeys(c *fiber.Ctx) *keyring.SharedKeys {
@@ -3,6 +3,7 @@ package cluster_test
 import (
 	"crypto/ed25519"
 	"testing"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	. "github.com/onsi/ginkgo/v2"
@@ -61,3 +62,36 @@ func invalidAuthHeader[T, U string | []byte](id T, payload U) string {
 	}
 	return str
 }
+
+func timestampedAuthHeader[T, U string | []byte](id T, payload U, ts time.Time) string {
+	nonce, mac, err := b2mac.New512Timestamped([]byte(id), ts, []byte(payload), testClientKey)
+	if err != nil {
+		panic(err)
+	}
+	str, err := b2mac.AuthHeader{
+		Version:   b2mac.Version1,
+		ID:        []byte(id),
+		Nonce:     nonce,
+		Timestamp: ts,
+		MAC:       mac,
+	}.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return str
+}
+
+func requestAuthHeader[T, U string | []byte](id T, method, path string, payload U) string {
+	header, err := b2mac.NewAuthHeader([]byte(id), b2mac.RequestInfo{
+		Method: method,
+		Path:   path,
+	}, []byte(payload), testClientKey)
+	if err != nil {
+		panic(err)
+	}
+	str, err := header.Encode()
+	if err != nil {
+		panic(err)
+	}
+	return str
+}
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gmeasure"
 	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/b2mac"
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/keyring"
 	"github.com/rancher/opni-monitoring/pkg/storage"
@@ -224,6 +225,28 @@ var _ = Describe("Cluster Auth", Ordered, test.EnableInCI[FlakeAttempts](5), Lab
 						Expect(bodyStr(resp.Body)).To(Equal(http.StatusText(http.StatusUnauthorized)))
 					})
 				})
+				When("the shared keys have expired", func() {
+					BeforeEach(func() {
+						store := test.NewTestKeyringStore(ctrl, "", &core.Reference{
+							Id: "cluster-1",
+						})
+						sk := keyring.NewSharedKeys(testSharedSecret)
+						expiresAt := time.Now().Add(-time.Second)
+						sk.ExpiresAt = &expiresAt
+						store.Put(context.Background(), keyring.New(sk))
+						handler = func(_ context.Context, prefix string, ref *core.Reference) (storage.KeyringStore, error) {
+							return store, nil
+						}
+					})
+					It("should return http 401", func() {
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", validAuthHeader("cluster-1", "payload"))
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						defer resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
 			})
 
 			Context("request timing", func() {
@@ -328,12 +351,13 @@ var _ = Describe("Cluster Auth", Ordered, test.EnableInCI[FlakeAttempts](5), Lab
 			})
 		})
 		Context("valid requests", func() {
+			var options []cluster.ClusterMiddlewareOption
 			JustBeforeEach(func() {
 				app = fiber.New(fiber.Config{
 					DisableStartupMessage: true,
 				})
 				broker := test.NewTestKeyringStoreBroker(ctrl, handler)
-				cm, err := cluster.New(broker, "X-Test")
+				cm, err := cluster.New(broker, "X-Test", options...)
 				Expect(err).NotTo(HaveOccurred())
 				app.Use(cm.Handle)
 				app.Post("/", func(c *fiber.Ctx) error {
@@ -359,7 +383,10 @@ var _ = Describe("Cluster Auth", Ordered, test.EnableInCI[FlakeAttempts](5), Lab
 					}
 					time.Sleep(10 * time.Millisecond)
 				}
-				DeferCleanup(app.Shutdown)
+				DeferCleanup(func() {
+					app.Shutdown()
+					options = nil
+				})
 			})
 
 			When("the request MAC matches the request body", func() {
@@ -383,6 +410,143 @@ var _ = Describe("Cluster Auth", Ordered, test.EnableInCI[FlakeAttempts](5), Lab
 					defer resp.Body.Close()
 					Expect(resp.StatusCode).To(Equal(http.StatusOK))
 				})
+				It("should accept timestamped requests", func() {
+					req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+					req.Header.Set("Authorization", timestampedAuthHeader("cluster-1", "payload", time.Now()))
+					resp, err := client.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					defer resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				})
+				It("should reject requests with a timestamp outside of the allowed window", func() {
+					for _, ts := range []time.Time{
+						time.Now().Add(-10 * time.Minute),
+						time.Now().Add(10 * time.Minute),
+					} {
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", timestampedAuthHeader("cluster-1", "payload", ts))
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						defer resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+						Expect(bodyStr(resp.Body)).To(ContainSubstring("outside of the allowed window"))
+					}
+				})
+				It("should reject replayed requests", func() {
+					for _, header := range []string{
+						validAuthHeader("cluster-1", "payload"),
+						timestampedAuthHeader("cluster-1", "payload", time.Now()),
+					} {
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", header)
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+						req = newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", header)
+						resp, err = client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+					}
+				})
+				It("should accept requests bound to the request method and path", func() {
+					req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+					req.Header.Set("Authorization", requestAuthHeader("cluster-1", http.MethodPost, "/", "payload"))
+					resp, err := client.Do(req)
+					Expect(err).NotTo(HaveOccurred())
+					defer resp.Body.Close()
+					Expect(resp.StatusCode).To(Equal(http.StatusOK))
+				})
+				It("should reject requests signed for a different method or path", func() {
+					for _, header := range []string{
+						requestAuthHeader("cluster-1", http.MethodPut, "/", "payload"),
+						requestAuthHeader("cluster-1", http.MethodPost, "/foo", "payload"),
+					} {
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", header)
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+					}
+				})
+				When("a cluster store is configured", func() {
+					var clusterStore storage.ClusterStore
+					BeforeEach(func() {
+						clusterStore = test.NewTestClusterStore(ctrl)
+						options = []cluster.ClusterMiddlewareOption{
+							cluster.WithClusterStore(clusterStore),
+						}
+					})
+					It("should reject requests from clusters which have not been approved", func() {
+						Expect(clusterStore.CreateCluster(context.Background(), &core.Cluster{
+							Id: "cluster-1",
+							Metadata: &core.ClusterMetadata{
+								Approval: &core.ClusterApproval{
+									State: core.ApprovalState_ClusterPendingApproval,
+								},
+							},
+						})).To(Succeed())
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", validAuthHeader("cluster-1", "payload"))
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						defer resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(bodyStr(resp.Body)).To(ContainSubstring("not been approved"))
+					})
+					It("should accept requests from approved clusters", func() {
+						Expect(clusterStore.CreateCluster(context.Background(), &core.Cluster{
+							Id: "cluster-1",
+						})).To(Succeed())
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", validAuthHeader("cluster-1", "payload"))
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						defer resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusOK))
+					})
+					It("should reject requests from unknown clusters", func() {
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", validAuthHeader("cluster-1", "payload"))
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						defer resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+					})
+				})
+				When("a minimum header version is required", func() {
+					BeforeEach(func() {
+						options = []cluster.ClusterMiddlewareOption{
+							cluster.WithMinimumHeaderVersion(b2mac.Version2),
+						}
+					})
+					It("should reject requests using older header versions", func() {
+						for _, header := range []string{
+							validAuthHeader("cluster-1", "payload"),
+							timestampedAuthHeader("cluster-1", "payload", time.Now()),
+						} {
+							req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+							req.Header.Set("Authorization", header)
+							resp, err := client.Do(req)
+							Expect(err).NotTo(HaveOccurred())
+							defer resp.Body.Close()
+							Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+							Expect(bodyStr(resp.Body)).To(ContainSubstring("unsupported authorization header version"))
+						}
+					})
+					It("should accept requests using the minimum header version", func() {
+						req := newRequest(http.MethodPost, "/", strings.NewReader("payload"))
+						req.Header.Set("Authorization", requestAuthHeader("cluster-1", http.MethodPost, "/", "payload"))
+						resp, err := client.Do(req)
+						Expect(err).NotTo(HaveOccurred())
+						defer resp.Body.Close()
+						Expect(resp.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
 			})
 		})
 	})
@@ -0,0 +1,92 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rancher/opni-monitoring/pkg/b2mac"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type clusterIDContextKey struct{}
+type sharedKeysContextKey struct{}
+
+// StreamServerInterceptor returns a gRPC stream interceptor which
+// authenticates streams using the MAC header in the "authorization" metadata
+// key. Since the messages on a stream are not known in advance, the MAC is
+// computed over the method's full name and an empty body (see
+// b2mac.GRPCRequestInfo). Only version 2 and later headers are accepted,
+// since older headers do not cover the method and a header signed for any
+// other request with an empty body would also be valid here.
+func (m *ClusterMiddleware) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		var authHeader string
+		if md, ok := metadata.FromIncomingContext(ss.Context()); ok {
+			if values := md.Get("authorization"); len(values) > 0 {
+				authHeader = values[0]
+			}
+		}
+		if authHeader == "" {
+			return status.Error(codes.Unauthenticated, "authorization header required")
+		}
+		minVersion := m.minHeaderVersion
+		if minVersion < b2mac.Version2 {
+			minVersion = b2mac.Version2
+		}
+		clusterID, sharedKeys, err := m.authenticate(authHeader, b2mac.GRPCRequestInfo(info.FullMethod), nil, minVersion)
+		if err != nil {
+			return status.Error(grpcCode(err.code), err.Error())
+		}
+		ctx := context.WithValue(ss.Context(), clusterIDContextKey{}, clusterID)
+		ctx = context.WithValue(ctx, sharedKeysContextKey{}, sharedKeys)
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+	}
+}
+
+// AuthorizedIDFromContext returns the ID of the cluster which opened a stream
+// authenticated by StreamServerInterceptor.
+func AuthorizedIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(clusterIDContextKey{}).(string)
+	return id, ok
+}
+
+// AuthorizedKeysFromContext returns the shared keys used to authenticate a
+// stream authenticated by StreamServerInterceptor.
+func AuthorizedKeysFromContext(ctx context.Context) (*keyring.SharedKeys, bool) {
+	keys, ok := ctx.Value(sharedKeysContextKey{}).(*keyring.SharedKeys)
+	return keys, ok
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
+
+func grpcCode(httpCode int) codes.Code {
+	switch httpCode {
+	case fiber.StatusBadRequest:
+		return codes.InvalidArgument
+	case fiber.StatusUnauthorized:
+		return codes.Unauthenticated
+	case fiber.StatusForbidden:
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
@@ -0,0 +1,48 @@
+package cluster
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// nonceCache remembers nonces from authorized requests until they expire, so
+// that replayed requests can be rejected.
+type nonceCache struct {
+	mu        sync.Mutex
+	nonces    map[string]time.Time
+	lastPrune time.Time
+	// How often expired nonces are removed from the cache
+	pruneInterval time.Duration
+}
+
+func newNonceCache(pruneInterval time.Duration) *nonceCache {
+	return &nonceCache{
+		nonces:        map[string]time.Time{},
+		lastPrune:     time.Now(),
+		pruneInterval: pruneInterval,
+	}
+}
+
+// Add records the nonce for the given cluster until the expiration time. It
+// returns false if the nonce has already been used.
+func (c *nonceCache) Add(clusterID string, nonce uuid.UUID, expiresAt time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if now.Sub(c.lastPrune) >= c.pruneInterval {
+		for key, exp := range c.nonces {
+			if !now.Before(exp) {
+				delete(c.nonces, key)
+			}
+		}
+		c.lastPrune = now
+	}
+	key := clusterID + "/" + nonce.String()
+	if exp, ok := c.nonces[key]; ok && now.Before(exp) {
+		return false
+	}
+	c.nonces[key] = expiresAt
+	return true
+}
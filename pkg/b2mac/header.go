@@ -5,7 +5,9 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -24,11 +26,100 @@ func EncodeAuthHeader(id []byte, nonce uuid.UUID, mac []byte) (string, error) {
 	return fmt.Sprintf(`MAC id="%s",nonce="%s",mac="%s"`, idEncoded, nonce.String(), macEncoded), nil
 }
 
-// Decodes the value of the "Authorization" header into its constituent parts.
+// Header format versions. Headers without a version field are version 0.
+const (
+	// The MAC covers the ID, nonce, and payload.
+	Version0 = 0
+	// The MAC additionally covers a timestamp, which is sent in the header.
+	Version1 = 1
+	// The MAC additionally covers the request method, path, and query.
+	Version2 = 2
+
+	LatestVersion = Version2
+)
+
+// AuthHeader contains the values sent in the "Authorization" header.
+type AuthHeader struct {
+	Version int
+	// The tenant ID.
+	ID []byte
+	// A v4 UUID.
+	Nonce uuid.UUID
+	// The time at which the MAC was computed, with second precision. Only
+	// set for version 1 and later.
+	Timestamp time.Time
+	// The unencoded MAC.
+	MAC []byte
+}
+
+// Encode generates the value of the "Authorization" header.
+func (h AuthHeader) Encode() (string, error) {
+	if h.Version == Version0 {
+		return EncodeAuthHeader(h.ID, h.Nonce, h.MAC)
+	}
+	if h.Version > LatestVersion || h.Version < 0 {
+		return "", fmt.Errorf("unknown header version: %d", h.Version)
+	}
+	if h.Nonce.Version() != 4 || h.Nonce.Variant() != uuid.RFC4122 {
+		return "", errors.New("nonce is not a v4 UUID")
+	}
+	if h.Timestamp.IsZero() {
+		return "", errors.New("timestamp is required")
+	}
+	macEncoded := base64.RawURLEncoding.EncodeToString(h.MAC)
+	idEncoded := base64.RawURLEncoding.EncodeToString(h.ID)
+	return fmt.Sprintf(`MAC v="%d",id="%s",nonce="%s",ts="%d",mac="%s"`,
+		h.Version, idEncoded, h.Nonce.String(), h.Timestamp.Unix(), macEncoded), nil
+}
+
+// Verify checks that the MAC in the header was computed for the given request
+// and payload using the provided key. The request is ignored for headers
+// older than version 2.
+func (h AuthHeader) Verify(req RequestInfo, payload []byte, key ed25519.PrivateKey) error {
+	switch h.Version {
+	case Version0:
+		return Verify(h.MAC, h.ID, h.Nonce, payload, key)
+	case Version1:
+		return VerifyTimestamped(h.MAC, h.ID, h.Nonce, h.Timestamp, payload, key)
+	default:
+		return VerifyForRequest(h.MAC, h.ID, h.Nonce, h.Timestamp, req, payload, key)
+	}
+}
+
+// NewAuthHeader computes a MAC for the given tenant ID, request, and payload
+// using the provided key, and returns a header of the latest version.
+func NewAuthHeader(id []byte, req RequestInfo, payload []byte, key ed25519.PrivateKey) (AuthHeader, error) {
+	ts := time.Now().Truncate(time.Second)
+	nonce, mac, err := New512ForRequest(id, ts, req, payload, key)
+	if err != nil {
+		return AuthHeader{}, err
+	}
+	return AuthHeader{
+		Version:   LatestVersion,
+		ID:        id,
+		Nonce:     nonce,
+		Timestamp: ts,
+		MAC:       mac,
+	}, nil
+}
+
+// Decodes the value of a version 0 "Authorization" header into its constituent parts.
 // It returns an ID (the tenant ID), a nonce (a v4 UUID), and an unencoded MAC.
+// Use ParseAuthHeader to decode headers of any version.
 func DecodeAuthHeader(header string) (id []byte, nonce uuid.UUID, mac []byte, err error) {
+	h, err := parseAuthHeader(header, false)
+	return h.ID, h.Nonce, h.MAC, err
+}
+
+// ParseAuthHeader decodes the value of an "Authorization" header of any
+// supported version.
+func ParseAuthHeader(header string) (AuthHeader, error) {
+	return parseAuthHeader(header, true)
+}
+
+func parseAuthHeader(header string, versioned bool) (h AuthHeader, err error) {
 	if !strings.HasPrefix(header, "MAC ") {
-		return nil, uuid.Nil, nil, errors.New("incorrect authorization type")
+		return AuthHeader{}, errors.New("incorrect authorization type")
 	}
 	trimmed := strings.TrimSpace(strings.TrimPrefix(header, "MAC"))
 	kvPairs := strings.Split(trimmed, ",")
@@ -36,48 +127,71 @@ func DecodeAuthHeader(header string) (id []byte, nonce uuid.UUID, mac []byte, er
 	for _, pair := range kvPairs {
 		kv := strings.Split(pair, "=")
 		if len(kv) != 2 {
-			return nil, uuid.Nil, nil, errors.New("malformed key-value pair")
+			return AuthHeader{}, errors.New("malformed key-value pair")
 		}
 		if len(kv[1]) < 2 || kv[1][0] != '"' || kv[1][len(kv[1])-1] != '"' {
-			return nil, uuid.Nil, nil, errors.New("expected quoted string")
+			return AuthHeader{}, errors.New("expected quoted string")
 		} else {
 			kv[1] = kv[1][1 : len(kv[1])-1]
 		}
 		key := strings.TrimSpace(kv[0])
 		if _, ok := foundKeys[key]; ok {
-			return nil, uuid.Nil, nil, errors.New("duplicate key: " + key)
+			return AuthHeader{}, errors.New("duplicate key: " + key)
 		}
 		foundKeys[key] = struct{}{}
 		switch key {
 		case "id":
-			id, err = base64.RawURLEncoding.DecodeString(kv[1])
+			h.ID, err = base64.RawURLEncoding.DecodeString(kv[1])
 			if err != nil {
-				return nil, uuid.Nil, nil, errors.New("malformed id")
+				return AuthHeader{}, errors.New("malformed id")
 			}
 		case "nonce":
-			nonce, err = uuid.Parse(kv[1])
+			h.Nonce, err = uuid.Parse(kv[1])
 			if err != nil {
-				return nil, uuid.Nil, nil, err
+				return AuthHeader{}, err
 			}
-			if nonce.Version() != 4 || nonce.Variant() != uuid.RFC4122 {
-				return nil, uuid.Nil, nil, errors.New("nonce is not a v4 UUID")
+			if h.Nonce.Version() != 4 || h.Nonce.Variant() != uuid.RFC4122 {
+				return AuthHeader{}, errors.New("nonce is not a v4 UUID")
 			}
 		case "mac":
-			mac, err = base64.RawURLEncoding.DecodeString(kv[1])
+			h.MAC, err = base64.RawURLEncoding.DecodeString(kv[1])
 			if err != nil {
-				return nil, uuid.Nil, nil, err
+				return AuthHeader{}, err
+			}
+		case "v":
+			if !versioned {
+				return AuthHeader{}, errors.New("unknown key")
+			}
+			h.Version, err = strconv.Atoi(kv[1])
+			if err != nil || h.Version <= Version0 || h.Version > LatestVersion {
+				return AuthHeader{}, errors.New("unknown header version")
+			}
+		case "ts":
+			if !versioned {
+				return AuthHeader{}, errors.New("unknown key")
 			}
+			ts, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil || ts <= 0 {
+				return AuthHeader{}, errors.New("malformed timestamp")
+			}
+			h.Timestamp = time.Unix(ts, 0)
 		default:
-			return nil, uuid.Nil, nil, errors.New("unknown key")
+			return AuthHeader{}, errors.New("unknown key")
 		}
 	}
-	if len(id) == 0 {
+	if h.Version == Version0 && !h.Timestamp.IsZero() {
+		return AuthHeader{}, errors.New("timestamp requires a header version")
+	}
+	if len(h.ID) == 0 {
 		err = fmt.Errorf("Header is missing id")
 	}
-	if nonce == uuid.Nil {
+	if h.Nonce == uuid.Nil {
 		err = fmt.Errorf("Header is missing nonce")
 	}
-	if len(mac) == 0 {
+	if h.Version >= Version1 && h.Timestamp.IsZero() {
+		err = fmt.Errorf("Header is missing timestamp")
+	}
+	if len(h.MAC) == 0 {
 		err = fmt.Errorf("Header is missing signature")
 	}
 	return
@@ -3,6 +3,7 @@ package b2mac_test
 import (
 	"encoding/base64"
 	"regexp"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -57,4 +58,63 @@ var _ = Describe("Headers", func() {
 		Entry(nil, `MAC id="dGVzdA",nonce="00000000-0000-0000-0000-000000000000",mac="dGVzdA"`, []byte(""), uuid.Nil, []byte(""), "nonce is not a v4 UUID"),
 		Entry(nil, `MAC id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",mac="$$$$"`, []byte(""), uuid.Nil, []byte(""), corruptInputErr),
 	)
+	DescribeTable("Parse Auth Headers",
+		func(header string, expected b2mac.AuthHeader, matchErr interface{}) {
+			h, err := b2mac.ParseAuthHeader(header)
+			if matchErr == nil {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(h).To(Equal(expected))
+			} else {
+				Expect(err).To(MatchError(matchErr))
+			}
+		},
+		Entry(nil, `MAC id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",mac="dGVzdA"`, b2mac.AuthHeader{
+			Version: b2mac.Version0,
+			ID:      []byte("test"),
+			Nonce:   uuid.MustParse("5b8c6876-0c5f-4ee4-862f-0dd1fb29f771"),
+			MAC:     []byte("test"),
+		}, nil),
+		Entry(nil, `MAC v="1",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="1650000000",mac="dGVzdA"`, b2mac.AuthHeader{
+			Version:   b2mac.Version1,
+			ID:        []byte("test"),
+			Nonce:     uuid.MustParse("5b8c6876-0c5f-4ee4-862f-0dd1fb29f771"),
+			Timestamp: time.Unix(1650000000, 0),
+			MAC:       []byte("test"),
+		}, nil),
+		Entry(nil, `MAC v="2",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="1650000000",mac="dGVzdA"`, b2mac.AuthHeader{
+			Version:   b2mac.Version2,
+			ID:        []byte("test"),
+			Nonce:     uuid.MustParse("5b8c6876-0c5f-4ee4-862f-0dd1fb29f771"),
+			Timestamp: time.Unix(1650000000, 0),
+			MAC:       []byte("test"),
+		}, nil),
+		Entry(nil, `MAC v="1",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",mac="dGVzdA"`, nil, "Header is missing timestamp"),
+		Entry(nil, `MAC id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="1650000000",mac="dGVzdA"`, nil, "timestamp requires a header version"),
+		Entry(nil, `MAC v="0",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",mac="dGVzdA"`, nil, "unknown header version"),
+		Entry(nil, `MAC v="999",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="1650000000",mac="dGVzdA"`, nil, "unknown header version"),
+		Entry(nil, `MAC v="1",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="-1",mac="dGVzdA"`, nil, "malformed timestamp"),
+		Entry(nil, `MAC v="1",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="yesterday",mac="dGVzdA"`, nil, "malformed timestamp"),
+	)
+	It("should not decode versioned headers as version 0 headers", func() {
+		_, _, _, err := b2mac.DecodeAuthHeader(`MAC v="1",id="dGVzdA",nonce="5b8c6876-0c5f-4ee4-862f-0dd1fb29f771",ts="1650000000",mac="dGVzdA"`)
+		Expect(err).To(MatchError("unknown key"))
+	})
+	It("should encode and parse versioned headers", func() {
+		h := b2mac.AuthHeader{
+			Version:   b2mac.Version1,
+			ID:        []byte("test"),
+			Nonce:     uuid.New(),
+			Timestamp: time.Unix(1650000000, 0),
+			MAC:       []byte("test"),
+		}
+		str, err := h.Encode()
+		Expect(err).NotTo(HaveOccurred())
+		h2, err := b2mac.ParseAuthHeader(str)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h2).To(Equal(h))
+
+		h.Timestamp = time.Time{}
+		_, err = h.Encode()
+		Expect(err).To(MatchError("timestamp is required"))
+	})
 })
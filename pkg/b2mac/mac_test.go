@@ -2,6 +2,8 @@ package b2mac_test
 
 import (
 	"crypto/ed25519"
+	"encoding/binary"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -44,4 +46,108 @@ var _ = Describe("MAC", Label(test.Unit), func() {
 		err = b2mac.Verify(mac, tenantID, uuid, payload, wrongKey)
 		Expect(err).To(MatchError("verification failed"))
 	})
+	It("should verify timestamped MACs", func() {
+		_, key, err := ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+		tenantID := []byte(uuid.NewString())
+		payload := []byte("test")
+		ts := time.Now().Truncate(time.Second)
+		nonce, mac, err := b2mac.New512Timestamped(tenantID, ts, payload, key)
+		Expect(err).NotTo(HaveOccurred())
+		h := b2mac.AuthHeader{
+			Version:   b2mac.Version1,
+			ID:        tenantID,
+			Nonce:     nonce,
+			Timestamp: ts,
+			MAC:       mac,
+		}
+		Expect(h.Verify(b2mac.RequestInfo{}, payload, key)).To(Succeed())
+
+		By("ensuring the MAC covers the timestamp")
+		h.Timestamp = h.Timestamp.Add(time.Second)
+		Expect(h.Verify(b2mac.RequestInfo{}, payload, key)).To(MatchError("verification failed"))
+
+		By("ensuring the MAC is not valid as a version 0 MAC")
+		h.Timestamp = h.Timestamp.Add(-time.Second)
+		h.Version = b2mac.Version0
+		Expect(h.Verify(b2mac.RequestInfo{}, payload, key)).To(MatchError("verification failed"))
+	})
+	It("should verify request MACs", func() {
+		_, key, err := ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+		tenantID := []byte(uuid.NewString())
+		payload := []byte("test")
+		req := b2mac.RequestInfo{
+			Method: "POST",
+			Path:   "/api/agent/push",
+			Query:  "b=2&a=1",
+		}
+		h, err := b2mac.NewAuthHeader(tenantID, req, payload, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(h.Version).To(Equal(b2mac.LatestVersion))
+		Expect(h.Timestamp).To(BeTemporally("~", time.Now(), time.Second))
+		Expect(h.Verify(req, payload, key)).To(Succeed())
+
+		By("ensuring equivalent requests are accepted")
+		Expect(h.Verify(b2mac.RequestInfo{
+			Method: "post",
+			Path:   "/api//agent/./push",
+			Query:  "a=1&b=2",
+		}, payload, key)).To(Succeed())
+
+		By("ensuring the MAC covers the method, path, and query")
+		for _, other := range []b2mac.RequestInfo{
+			{Method: "GET", Path: req.Path, Query: req.Query},
+			{Method: req.Method, Path: "/api/agent/sync_rules", Query: req.Query},
+			{Method: req.Method, Path: req.Path, Query: "a=1"},
+			{Method: req.Method, Path: req.Path + "/a=1", Query: ""},
+		} {
+			Expect(h.Verify(other, payload, key)).To(MatchError("verification failed"))
+		}
+
+		By("ensuring the MAC is not valid as a version 1 MAC")
+		h.Version = b2mac.Version1
+		Expect(h.Verify(req, payload, key)).To(MatchError("verification failed"))
+	})
+	It("should not verify MACs as a different version", func() {
+		_, key, err := ed25519.GenerateKey(nil)
+		Expect(err).NotTo(HaveOccurred())
+		tenantID := []byte(uuid.NewString())
+		payload := []byte("test")
+		req := b2mac.RequestInfo{
+			Method: "POST",
+			Path:   "/api/agent/push",
+		}
+		h, err := b2mac.NewAuthHeader(tenantID, req, payload, key)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Without domain separation, the fields covered by a newer MAC
+		// version could be moved into the payload of an older version.
+		var ts [8]byte
+		binary.BigEndian.PutUint64(ts[:], uint64(h.Timestamp.Unix()))
+		var reqFields []byte
+		canonical := req.Canonical()
+		for _, field := range []string{canonical.Method, canonical.Path, canonical.Query} {
+			var length [4]byte
+			binary.BigEndian.PutUint32(length[:], uint32(len(field)))
+			reqFields = append(reqFields, length[:]...)
+			reqFields = append(reqFields, field...)
+		}
+
+		By("ensuring a version 2 MAC is not valid as a version 1 MAC")
+		v1Payload := append(append([]byte{}, reqFields...), payload...)
+		Expect(b2mac.VerifyTimestamped(h.MAC, tenantID, h.Nonce, h.Timestamp, v1Payload, key)).
+			To(MatchError("verification failed"))
+
+		By("ensuring a version 2 MAC is not valid as a version 0 MAC")
+		v0Payload := append(ts[:], v1Payload...)
+		Expect(b2mac.Verify(h.MAC, tenantID, h.Nonce, v0Payload, key)).
+			To(MatchError("verification failed"))
+
+		By("ensuring a version 1 MAC is not valid as a version 0 MAC")
+		nonce, mac, err := b2mac.New512Timestamped(tenantID, h.Timestamp, payload, key)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(b2mac.Verify(mac, tenantID, nonce, append(ts[:], payload...), key)).
+			To(MatchError("verification failed"))
+	})
 })
@@ -0,0 +1,44 @@
+package b2mac
+
+import (
+	"net/url"
+	"path"
+	"strings"
+)
+
+// RequestInfo identifies the HTTP request for which a MAC was computed. It is
+// only covered by version 2 and later MACs.
+type RequestInfo struct {
+	Method string
+	// The decoded request path.
+	Path string
+	// The raw query string, without the leading '?'.
+	Query string
+}
+
+// Canonical returns a copy of the request info in canonical form, such that
+// equivalent requests have identical request info on both the client and
+// the server:
+//   - The method is upper case.
+//   - The path is cleaned, and always begins with '/'.
+//   - Query parameters are sorted by key, and re-encoded.
+func (r RequestInfo) Canonical() RequestInfo {
+	canonical := RequestInfo{
+		Method: strings.ToUpper(r.Method),
+		Path:   path.Clean("/" + r.Path),
+		Query:  r.Query,
+	}
+	if values, err := url.ParseQuery(r.Query); err == nil {
+		canonical.Query = values.Encode()
+	}
+	return canonical
+}
+
+// GRPCRequestInfo returns the request info used to compute MACs for gRPC
+// calls, identified by the method's full name (e.g. "/package.Service/Method").
+func GRPCRequestInfo(fullMethod string) RequestInfo {
+	return RequestInfo{
+		Method: "POST",
+		Path:   fullMethod,
+	}
+}
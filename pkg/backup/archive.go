@@ -0,0 +1,144 @@
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+	"google.golang.org/protobuf/proto"
+)
+
+// FormatVersion is the archive format version written by Marshal. Archives
+// with a newer version than this cannot be read.
+const FormatVersion = 1
+
+const (
+	algorithmArgon2XChaCha20Poly1305 = "argon2id-xchacha20poly1305"
+
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+	saltSize      = 16
+)
+
+var (
+	ErrPassphraseRequired   = errors.New("archive is encrypted, but no passphrase was provided")
+	ErrDecryptionFailed     = errors.New("failed to decrypt archive (wrong passphrase?)")
+	ErrUnsupportedVersion   = errors.New("unsupported archive version")
+	ErrUnsupportedAlgorithm = errors.New("unsupported encryption algorithm")
+)
+
+// Marshal serializes the backup contents into an archive. If passphrase is
+// not empty, the archive payload will be encrypted with a key derived from it.
+func Marshal(contents *Contents, passphrase []byte) ([]byte, error) {
+	data, err := proto.Marshal(contents)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal backup contents: %w", err)
+	}
+	buf := new(bytes.Buffer)
+	gw := gzip.NewWriter(buf)
+	if _, err := gw.Write(data); err != nil {
+		return nil, fmt.Errorf("failed to compress backup contents: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress backup contents: %w", err)
+	}
+
+	archive := &Archive{
+		Version: FormatVersion,
+		Payload: buf.Bytes(),
+	}
+	if len(passphrase) > 0 {
+		salt := make([]byte, saltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		aead, err := newAEAD(passphrase, salt)
+		if err != nil {
+			return nil, err
+		}
+		nonce := make([]byte, aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		archive.Encryption = &Encryption{
+			Algorithm: algorithmArgon2XChaCha20Poly1305,
+			Salt:      salt,
+			Nonce:     nonce,
+		}
+		archive.Payload = aead.Seal(nil, nonce, archive.Payload, additionalData(archive))
+	}
+	return proto.Marshal(archive)
+}
+
+// Unmarshal decodes an archive created by Marshal. If the archive is
+// encrypted, the passphrase used to create it must be provided.
+func Unmarshal(data []byte, passphrase []byte) (*Contents, error) {
+	archive := &Archive{}
+	if err := proto.Unmarshal(data, archive); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal archive: %w", err)
+	}
+	if archive.Version == 0 || archive.Version > FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, archive.Version)
+	}
+	payload := archive.Payload
+	if enc := archive.Encryption; enc != nil {
+		if enc.Algorithm != algorithmArgon2XChaCha20Poly1305 {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, enc.Algorithm)
+		}
+		if len(passphrase) == 0 {
+			return nil, ErrPassphraseRequired
+		}
+		aead, err := newAEAD(passphrase, enc.Salt)
+		if err != nil {
+			return nil, err
+		}
+		if len(enc.Nonce) != aead.NonceSize() {
+			return nil, ErrDecryptionFailed
+		}
+		payload, err = aead.Open(nil, enc.Nonce, payload, additionalData(archive))
+		if err != nil {
+			return nil, ErrDecryptionFailed
+		}
+	}
+
+	gr, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress archive: %w", err)
+	}
+	decompressed, err := io.ReadAll(gr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress archive: %w", err)
+	}
+	contents := &Contents{}
+	if err := proto.Unmarshal(decompressed, contents); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal backup contents: %w", err)
+	}
+	return contents, nil
+}
+
+func newAEAD(passphrase, salt []byte) (cipher.AEAD, error) {
+	key := argon2.IDKey(passphrase, salt, argon2Time, argon2Memory, argon2Threads, chacha20poly1305.KeySize)
+	return chacha20poly1305.NewX(key)
+}
+
+// additionalData binds the archive header to the encrypted payload, so that
+// the version and encryption parameters cannot be modified independently.
+func additionalData(archive *Archive) []byte {
+	header := &Archive{
+		Version: archive.Version,
+		Encryption: &Encryption{
+			Algorithm: archive.Encryption.GetAlgorithm(),
+			Salt:      archive.Encryption.GetSalt(),
+			Nonce:     archive.Encryption.GetNonce(),
+		},
+	}
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(header)
+	return data
+}
@@ -0,0 +1,71 @@
+package backup_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/backup"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Archive", Label(test.Unit), func() {
+	contents := &backup.Contents{
+		Timestamp: timestamppb.Now(),
+		Clusters: []*core.Cluster{
+			{
+				Id: "foo",
+			},
+		},
+		KeyValues: []*backup.KeyValueEntry{
+			{
+				Namespace: "ns",
+				Key:       "key",
+				Value:     []byte("value"),
+			},
+		},
+	}
+	It("should round-trip unencrypted archives", func() {
+		data, err := backup.Marshal(contents, nil)
+		Expect(err).NotTo(HaveOccurred())
+		decoded, err := backup.Unmarshal(data, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proto.Equal(contents, decoded)).To(BeTrue())
+	})
+	It("should round-trip encrypted archives", func() {
+		data, err := backup.Marshal(contents, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).NotTo(ContainSubstring("value"))
+		decoded, err := backup.Unmarshal(data, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(proto.Equal(contents, decoded)).To(BeTrue())
+	})
+	It("should require the correct passphrase for encrypted archives", func() {
+		data, err := backup.Marshal(contents, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = backup.Unmarshal(data, nil)
+		Expect(err).To(MatchError(backup.ErrPassphraseRequired))
+		_, err = backup.Unmarshal(data, []byte("wrong"))
+		Expect(err).To(MatchError(backup.ErrDecryptionFailed))
+	})
+	It("should detect tampering with the archive header", func() {
+		data, err := backup.Marshal(contents, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		archive := &backup.Archive{}
+		Expect(proto.Unmarshal(data, archive)).To(Succeed())
+		archive.Encryption.Salt[0] ^= 0xFF
+		data, err = proto.Marshal(archive)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = backup.Unmarshal(data, []byte("passphrase"))
+		Expect(err).To(MatchError(backup.ErrDecryptionFailed))
+	})
+	It("should reject unsupported archive versions", func() {
+		data, err := proto.Marshal(&backup.Archive{
+			Version: backup.FormatVersion + 1,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = backup.Unmarshal(data, nil)
+		Expect(err).To(MatchError(backup.ErrUnsupportedVersion))
+	})
+})
@@ -0,0 +1,294 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/tokens"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultKeyringNamespaces contains the keyring namespaces which are backed up
+// for each cluster if no namespaces are explicitly configured.
+var DefaultKeyringNamespaces = []string{"gateway"}
+
+type BackupOptions struct {
+	keyringNamespaces  []string
+	keyValueNamespaces []string
+}
+
+type BackupOption func(*BackupOptions)
+
+func (o *BackupOptions) Apply(opts ...BackupOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithKeyringNamespaces sets the keyring namespaces to back up. Keyrings in
+// each namespace are looked up by cluster ID.
+func WithKeyringNamespaces(namespaces ...string) BackupOption {
+	return func(o *BackupOptions) {
+		o.keyringNamespaces = namespaces
+	}
+}
+
+// WithKeyValueNamespaces sets the key-value store namespaces to back up.
+// Since the storage backend interfaces cannot enumerate namespaces, any
+// namespaces not listed here will not be included in the backup.
+func WithKeyValueNamespaces(namespaces ...string) BackupOption {
+	return func(o *BackupOptions) {
+		o.keyValueNamespaces = namespaces
+	}
+}
+
+// Create reads all objects from the storage backend and returns them as
+// backup contents which can be serialized using Marshal.
+func Create(ctx context.Context, backend storage.Backend, opts ...BackupOption) (*Contents, error) {
+	options := BackupOptions{
+		keyringNamespaces: DefaultKeyringNamespaces,
+	}
+	options.Apply(opts...)
+
+	contents := &Contents{
+		Timestamp: timestamppb.Now(),
+	}
+
+	tokenList, err := backend.ListTokens(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	contents.Tokens = tokenList.Items
+
+	clusterList, err := backend.ListClusters(ctx, nil, core.MatchOptions_Default)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	contents.Clusters = clusterList.Items
+
+	roleList, err := backend.ListRoles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	contents.Roles = roleList.Items
+
+	roleBindingList, err := backend.ListRoleBindings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	for _, rb := range roleBindingList.Items {
+		// Taints are computed when role bindings are read, and are not stored
+		rb.Taints = nil
+		contents.RoleBindings = append(contents.RoleBindings, rb)
+	}
+
+	for _, cluster := range contents.Clusters {
+		for _, namespace := range options.keyringNamespaces {
+			ks, err := backend.KeyringStore(ctx, namespace, cluster.Reference())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get keyring store: %w", err)
+			}
+			kr, err := ks.Get(ctx)
+			if err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get keyring for cluster %s: %w", cluster.Id, err)
+			}
+			data, err := kr.Marshal()
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal keyring: %w", err)
+			}
+			contents.Keyrings = append(contents.Keyrings, &KeyringEntry{
+				Namespace: namespace,
+				Id:        cluster.Id,
+				Data:      data,
+			})
+		}
+	}
+
+	for _, namespace := range options.keyValueNamespaces {
+		kv, err := backend.KeyValueStore(namespace)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get key-value store: %w", err)
+		}
+		keys, err := kv.ListKeys(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to list keys in namespace %s: %w", namespace, err)
+		}
+		for _, key := range keys {
+			value, err := kv.Get(ctx, key)
+			if err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+				return nil, fmt.Errorf("failed to get key %s in namespace %s: %w", key, namespace, err)
+			}
+			contents.KeyValues = append(contents.KeyValues, &KeyValueEntry{
+				Namespace: namespace,
+				Key:       key,
+				Value:     value,
+			})
+		}
+	}
+	return contents, nil
+}
+
+// Restore writes the backup contents into the storage backend. Objects which
+// already exist in the backend are replaced. Tokens retain the ttl they had
+// at the time the backup was created, minus the time elapsed since then;
+// tokens which would have expired in the meantime are not restored.
+func Restore(ctx context.Context, backend storage.Backend, contents *Contents) error {
+	elapsed := time.Since(contents.GetTimestamp().AsTime())
+	for _, bt := range contents.Tokens {
+		if err := restoreToken(ctx, backend, bt, elapsed); err != nil {
+			return err
+		}
+	}
+
+	for _, cluster := range contents.Clusters {
+		if err := restoreCluster(ctx, backend, cluster); err != nil {
+			return err
+		}
+	}
+
+	for _, role := range contents.Roles {
+		if err := restoreRole(ctx, backend, role); err != nil {
+			return err
+		}
+	}
+
+	for _, rb := range contents.RoleBindings {
+		if err := restoreRoleBinding(ctx, backend, rb); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range contents.Keyrings {
+		if err := restoreKeyring(ctx, backend, entry); err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range contents.KeyValues {
+		if err := restoreKeyValue(ctx, backend, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreToken(
+	ctx context.Context,
+	backend storage.Backend,
+	bt *core.BootstrapToken,
+	elapsed time.Duration,
+) error {
+	ttl := time.Duration(bt.GetMetadata().GetTtl())*time.Second - elapsed
+	if ttl <= 0 {
+		return nil
+	}
+	token, err := tokens.FromBootstrapToken(bt)
+	if err != nil {
+		return fmt.Errorf("failed to decode token %s: %w", bt.TokenID, err)
+	}
+	if err := backend.DeleteToken(ctx, bt.Reference()); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("failed to replace token %s: %w", bt.TokenID, err)
+	}
+	if _, err := backend.CreateToken(ctx, ttl,
+		storage.WithToken(token),
+		storage.WithLabels(bt.GetMetadata().GetLabels()),
+		storage.WithCapabilities(bt.GetMetadata().GetCapabilities()),
+		storage.WithLimits(bt.GetMetadata().GetLimits()),
+	); err != nil {
+		return fmt.Errorf("failed to restore token %s: %w", bt.TokenID, err)
+	}
+	if usageCount := bt.GetMetadata().GetUsageCount(); usageCount > 0 {
+		if _, err := backend.UpdateToken(ctx, bt.Reference(), func(t *core.BootstrapToken) {
+			t.Metadata.UsageCount = usageCount
+		}); err != nil {
+			return fmt.Errorf("failed to restore token %s: %w", bt.TokenID, err)
+		}
+	}
+	return nil
+}
+
+func restoreCluster(ctx context.Context, backend storage.Backend, cluster *core.Cluster) error {
+	_, err := backend.GetCluster(ctx, cluster.Reference())
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			return fmt.Errorf("failed to get cluster %s: %w", cluster.Id, err)
+		}
+		if err := backend.CreateCluster(ctx, cluster); err != nil {
+			return fmt.Errorf("failed to restore cluster %s: %w", cluster.Id, err)
+		}
+		return nil
+	}
+	_, err = backend.UpdateCluster(ctx, cluster.Reference(), func(c *core.Cluster) {
+		md := proto.Clone(cluster.GetMetadata()).(*core.ClusterMetadata)
+		// The resource version is assigned by the backend, and the backed up
+		// version would otherwise be treated as a precondition.
+		md.ResourceVersion = ""
+		c.Metadata = md
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore cluster %s: %w", cluster.Id, err)
+	}
+	return nil
+}
+
+func restoreRole(ctx context.Context, backend storage.Backend, role *core.Role) error {
+	if err := backend.DeleteRole(ctx, role.Reference()); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("failed to replace role %s: %w", role.Id, err)
+	}
+	if err := backend.CreateRole(ctx, role); err != nil {
+		return fmt.Errorf("failed to restore role %s: %w", role.Id, err)
+	}
+	return nil
+}
+
+func restoreRoleBinding(ctx context.Context, backend storage.Backend, rb *core.RoleBinding) error {
+	rb = proto.Clone(rb).(*core.RoleBinding)
+	rb.Taints = nil
+	if err := backend.DeleteRoleBinding(ctx, rb.Reference()); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("failed to replace role binding %s: %w", rb.Id, err)
+	}
+	if err := backend.CreateRoleBinding(ctx, rb); err != nil {
+		return fmt.Errorf("failed to restore role binding %s: %w", rb.Id, err)
+	}
+	return nil
+}
+
+func restoreKeyring(ctx context.Context, backend storage.Backend, entry *KeyringEntry) error {
+	kr, err := keyring.Unmarshal(entry.Data)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal keyring %s/%s: %w", entry.Namespace, entry.Id, err)
+	}
+	ks, err := backend.KeyringStore(ctx, entry.Namespace, &core.Reference{
+		Id: entry.Id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get keyring store: %w", err)
+	}
+	if err := ks.Put(ctx, kr); err != nil {
+		return fmt.Errorf("failed to restore keyring %s/%s: %w", entry.Namespace, entry.Id, err)
+	}
+	return nil
+}
+
+func restoreKeyValue(ctx context.Context, backend storage.Backend, entry *KeyValueEntry) error {
+	kv, err := backend.KeyValueStore(entry.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to get key-value store: %w", err)
+	}
+	if err := kv.Put(ctx, entry.Key, entry.Value); err != nil {
+		return fmt.Errorf("failed to restore key %s in namespace %s: %w", entry.Key, entry.Namespace, err)
+	}
+	return nil
+}
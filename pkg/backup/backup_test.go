@@ -0,0 +1,163 @@
+package backup_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/backup"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/storage/bolt"
+	"github.com/rancher/opni-monitoring/pkg/storage/sql"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Backup", Ordered, Label(test.Unit), func() {
+	var source, dest storage.Backend
+	var token *core.BootstrapToken
+	var archive []byte
+	ctx := context.Background()
+
+	BeforeAll(func() {
+		boltStore, err := bolt.NewBoltStore(&v1beta1.BoltStorageSpec{
+			Path: filepath.Join(GinkgoT().TempDir(), "opni.db"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(boltStore.Close)
+		source = boltStore
+
+		sqlStore, err := sql.NewSQLStore(ctx, &v1beta1.SQLStorageSpec{
+			Driver: v1beta1.SQLDriverSQLite,
+			DSN:    filepath.Join(GinkgoT().TempDir(), "opni.sqlite"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(sqlStore.Close)
+		dest = sqlStore
+	})
+
+	It("should back up all objects", func() {
+		var err error
+		token, err = source.CreateToken(ctx, time.Hour,
+			storage.WithLabels(map[string]string{"foo": "bar"}),
+			storage.WithCapabilities([]*core.TokenCapability{
+				{
+					Type: "join_existing_cluster",
+					Reference: &core.Reference{
+						Id: "cluster-1",
+					},
+				},
+			}),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = source.UpdateToken(ctx, token.Reference(), func(t *core.BootstrapToken) {
+			t.Metadata.UsageCount = 3
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(source.CreateCluster(ctx, &core.Cluster{
+			Id: "cluster-1",
+			Metadata: &core.ClusterMetadata{
+				Labels: map[string]string{"foo": "bar"},
+			},
+		})).To(Succeed())
+		Expect(source.CreateRole(ctx, &core.Role{
+			Id:         "role-1",
+			ClusterIDs: []string{"cluster-1"},
+		})).To(Succeed())
+		Expect(source.CreateRoleBinding(ctx, &core.RoleBinding{
+			Id:       "rb-1",
+			RoleId:   "role-1",
+			Subjects: []string{"user-1"},
+		})).To(Succeed())
+
+		ks, err := source.KeyringStore(ctx, "gateway", &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(ctx, keyring.New(keyring.NewSharedKeys(make([]byte, 64))))).To(Succeed())
+
+		kv, err := source.KeyValueStore("plugin")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Put(ctx, "key", []byte("value"))).To(Succeed())
+
+		contents, err := backup.Create(ctx, source, backup.WithKeyValueNamespaces("plugin"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contents.Tokens).To(HaveLen(1))
+		Expect(contents.Clusters).To(HaveLen(1))
+		Expect(contents.Roles).To(HaveLen(1))
+		Expect(contents.RoleBindings).To(HaveLen(1))
+		Expect(contents.Keyrings).To(HaveLen(1))
+		Expect(contents.KeyValues).To(HaveLen(1))
+
+		archive, err = backup.Marshal(contents, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should restore all objects into a different backend", func() {
+		contents, err := backup.Unmarshal(archive, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Restore(ctx, dest, contents)).To(Succeed())
+
+		restoredToken, err := dest.GetToken(ctx, token.Reference())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(restoredToken.Secret).To(Equal(token.Secret))
+		Expect(restoredToken.Metadata.UsageCount).To(BeEquivalentTo(3))
+		Expect(restoredToken.Metadata.Labels).To(Equal(map[string]string{"foo": "bar"}))
+		Expect(restoredToken.Metadata.Capabilities).To(HaveLen(1))
+		Expect(restoredToken.Metadata.Ttl).To(BeNumerically("~", time.Hour.Seconds(), 5))
+
+		cluster, err := dest.GetCluster(ctx, &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetMetadata().GetLabels()).To(Equal(map[string]string{"foo": "bar"}))
+
+		_, err = dest.GetRole(ctx, &core.Reference{Id: "role-1"})
+		Expect(err).NotTo(HaveOccurred())
+		rb, err := dest.GetRoleBinding(ctx, &core.Reference{Id: "rb-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(rb.Subjects).To(ConsistOf("user-1"))
+		Expect(rb.Taints).To(BeEmpty())
+
+		ks, err := dest.KeyringStore(ctx, "gateway", &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		kr, err := ks.Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kr.Try(func(*keyring.SharedKeys) {})).To(BeTrue())
+
+		kv, err := dest.KeyValueStore("plugin")
+		Expect(err).NotTo(HaveOccurred())
+		value, err := kv.Get(ctx, "key")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal([]byte("value")))
+	})
+
+	It("should replace existing objects when restoring", func() {
+		_, err := dest.UpdateCluster(ctx, &core.Reference{Id: "cluster-1"}, func(c *core.Cluster) {
+			c.Metadata.Labels = map[string]string{"foo": "baz"}
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents, err := backup.Unmarshal(archive, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(backup.Restore(ctx, dest, contents)).To(Succeed())
+
+		cluster, err := dest.GetCluster(ctx, &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetMetadata().GetLabels()).To(Equal(map[string]string{"foo": "bar"}))
+		tokens, err := dest.ListTokens(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens.Items).To(HaveLen(1))
+	})
+
+	It("should not restore tokens which have since expired", func() {
+		contents, err := backup.Unmarshal(archive, []byte("passphrase"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dest.DeleteToken(ctx, token.Reference())).To(Succeed())
+		contents.Timestamp.Seconds -= int64(2 * time.Hour.Seconds())
+		Expect(backup.Restore(ctx, dest, contents)).To(Succeed())
+		_, err = dest.GetToken(ctx, token.Reference())
+		Expect(err).To(MatchError(storage.ErrNotFound))
+	})
+})
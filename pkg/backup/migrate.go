@@ -0,0 +1,376 @@
+package backup
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/logger"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultMigrationKeyringNamespaces contains the keyring namespaces which are
+// copied for each cluster during a migration if no namespaces are explicitly
+// configured. Unlike backups, this includes the agent namespace, since agents
+// may share a storage backend with the gateway.
+var DefaultMigrationKeyringNamespaces = []string{"gateway", "agent"}
+
+const migrationStateVersion = 1
+
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+type MigrateOptions struct {
+	backupOptions []BackupOption
+	stateFile     string
+	logger        *zap.SugaredLogger
+}
+
+type MigrateOption func(*MigrateOptions)
+
+func (o *MigrateOptions) Apply(opts ...MigrateOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithBackupOptions configures which objects are read from the source
+// backend. See WithKeyringNamespaces and WithKeyValueNamespaces.
+func WithBackupOptions(opts ...BackupOption) MigrateOption {
+	return func(o *MigrateOptions) {
+		o.backupOptions = append(o.backupOptions, opts...)
+	}
+}
+
+// WithStateFile sets the path to a file in which migration progress is
+// recorded. If the file exists when a migration starts, objects which were
+// already copied and have not changed since will be skipped.
+func WithStateFile(path string) MigrateOption {
+	return func(o *MigrateOptions) {
+		o.stateFile = path
+	}
+}
+
+func WithLogger(lg *zap.SugaredLogger) MigrateOption {
+	return func(o *MigrateOptions) {
+		o.logger = lg
+	}
+}
+
+type MigrationResult struct {
+	// Number of objects copied to the destination backend
+	Copied int
+	// Number of objects skipped because they were copied by a previous run
+	Skipped int
+}
+
+// migrationState is persisted to the state file, and maps object keys
+// to the checksum of the object at the time it was copied and verified.
+type migrationState struct {
+	Version int               `json:"version"`
+	Objects map[string]string `json:"objects"`
+}
+
+type migrationObject struct {
+	key      string
+	checksum string
+	write    func(ctx context.Context) error
+	// read computes the checksum of the object in the destination backend
+	read func(ctx context.Context) (string, error)
+}
+
+// Migrate copies all objects from the source backend into the destination
+// backend. Each object is read back from the destination after it is written,
+// and its checksum compared against the source object. Objects which exist in
+// the destination but not in the source are left untouched.
+func Migrate(
+	ctx context.Context,
+	source, dest storage.Backend,
+	opts ...MigrateOption,
+) (*MigrationResult, error) {
+	options := MigrateOptions{
+		backupOptions: []BackupOption{
+			WithKeyringNamespaces(DefaultMigrationKeyringNamespaces...),
+		},
+		logger: logger.New().Named("migrate"),
+	}
+	options.Apply(opts...)
+	lg := options.logger
+
+	state, err := loadMigrationState(options.stateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := Create(ctx, source, options.backupOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source backend: %w", err)
+	}
+	objects, err := migrationObjects(contents, dest)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MigrationResult{}
+	for _, obj := range objects {
+		lg := lg.With("object", obj.key)
+		if state.Objects[obj.key] == obj.checksum {
+			// Ensure the object was not modified in the destination since
+			// it was copied
+			if sum, err := obj.read(ctx); err == nil && sum == obj.checksum {
+				lg.Debug("skipping object (already migrated)")
+				result.Skipped++
+				continue
+			}
+		}
+		if err := obj.write(ctx); err != nil {
+			return result, err
+		}
+		sum, err := obj.read(ctx)
+		if err != nil {
+			return result, fmt.Errorf("failed to verify %s: %w", obj.key, err)
+		}
+		if sum != obj.checksum {
+			return result, fmt.Errorf("failed to verify %s: %w (expected %s, got %s)",
+				obj.key, ErrChecksumMismatch, obj.checksum, sum)
+		}
+		state.Objects[obj.key] = obj.checksum
+		if err := saveMigrationState(options.stateFile, state); err != nil {
+			return result, err
+		}
+		lg.Debug("migrated object")
+		result.Copied++
+	}
+	return result, nil
+}
+
+func migrationObjects(contents *Contents, dest storage.Backend) ([]migrationObject, error) {
+	var objects []migrationObject
+	elapsed := time.Since(contents.GetTimestamp().AsTime())
+	for _, token := range contents.Tokens {
+		token := token
+		sum, err := tokenChecksum(token)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, migrationObject{
+			key:      path.Join("tokens", token.TokenID),
+			checksum: sum,
+			write: func(ctx context.Context) error {
+				return restoreToken(ctx, dest, token, elapsed)
+			},
+			read: func(ctx context.Context) (string, error) {
+				t, err := dest.GetToken(ctx, token.Reference())
+				if err != nil {
+					return "", err
+				}
+				return tokenChecksum(t)
+			},
+		})
+	}
+	for _, cluster := range contents.Clusters {
+		cluster := cluster
+		sum, err := objectChecksum(cluster)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, migrationObject{
+			key:      path.Join("clusters", cluster.Id),
+			checksum: sum,
+			write: func(ctx context.Context) error {
+				return restoreCluster(ctx, dest, cluster)
+			},
+			read: func(ctx context.Context) (string, error) {
+				c, err := dest.GetCluster(ctx, cluster.Reference())
+				if err != nil {
+					return "", err
+				}
+				return objectChecksum(c)
+			},
+		})
+	}
+	for _, role := range contents.Roles {
+		role := role
+		sum, err := objectChecksum(role)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, migrationObject{
+			key:      path.Join("roles", role.Id),
+			checksum: sum,
+			write: func(ctx context.Context) error {
+				return restoreRole(ctx, dest, role)
+			},
+			read: func(ctx context.Context) (string, error) {
+				r, err := dest.GetRole(ctx, role.Reference())
+				if err != nil {
+					return "", err
+				}
+				return objectChecksum(r)
+			},
+		})
+	}
+	for _, rb := range contents.RoleBindings {
+		rb := rb
+		sum, err := objectChecksum(rb)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, migrationObject{
+			key:      path.Join("rolebindings", rb.Id),
+			checksum: sum,
+			write: func(ctx context.Context) error {
+				return restoreRoleBinding(ctx, dest, rb)
+			},
+			read: func(ctx context.Context) (string, error) {
+				r, err := dest.GetRoleBinding(ctx, rb.Reference())
+				if err != nil {
+					return "", err
+				}
+				r.Taints = nil
+				return objectChecksum(r)
+			},
+		})
+	}
+	for _, entry := range contents.Keyrings {
+		entry := entry
+		objects = append(objects, migrationObject{
+			key:      path.Join("keyrings", entry.Namespace, entry.Id),
+			checksum: checksum(entry.Data),
+			write: func(ctx context.Context) error {
+				return restoreKeyring(ctx, dest, entry)
+			},
+			read: func(ctx context.Context) (string, error) {
+				ks, err := dest.KeyringStore(ctx, entry.Namespace, &core.Reference{
+					Id: entry.Id,
+				})
+				if err != nil {
+					return "", err
+				}
+				kr, err := ks.Get(ctx)
+				if err != nil {
+					return "", err
+				}
+				data, err := kr.Marshal()
+				if err != nil {
+					return "", err
+				}
+				return checksum(data), nil
+			},
+		})
+	}
+	for _, entry := range contents.KeyValues {
+		entry := entry
+		objects = append(objects, migrationObject{
+			key:      path.Join("kv", entry.Namespace, entry.Key),
+			checksum: checksum(entry.Value),
+			write: func(ctx context.Context) error {
+				return restoreKeyValue(ctx, dest, entry)
+			},
+			read: func(ctx context.Context) (string, error) {
+				kv, err := dest.KeyValueStore(entry.Namespace)
+				if err != nil {
+					return "", err
+				}
+				value, err := kv.Get(ctx, entry.Key)
+				if err != nil {
+					return "", err
+				}
+				return checksum(value), nil
+			},
+		})
+	}
+	return objects, nil
+}
+
+// tokenChecksum computes a checksum of a token, excluding its ttl and lease
+// ID, which are expected to differ between backends.
+func tokenChecksum(token *core.BootstrapToken) (string, error) {
+	token = proto.Clone(token).(*core.BootstrapToken)
+	if token.Metadata != nil {
+		token.Metadata.Ttl = 0
+		token.Metadata.LeaseID = 0
+	}
+	return messageChecksum(token)
+}
+
+// objectChecksum computes a checksum of a versioned object, excluding its
+// resource version and timestamps, which are managed by the backend.
+func objectChecksum[T interface {
+	proto.Message
+	core.VersionedObject
+}](obj T) (string, error) {
+	obj = proto.Clone(obj).(T)
+	obj.SetResourceVersion("")
+	obj.SetCreationTimestamp(nil)
+	obj.SetLastModified(nil)
+	return messageChecksum(obj)
+}
+
+func messageChecksum(msg proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return checksum(data), nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func loadMigrationState(stateFile string) (*migrationState, error) {
+	state := &migrationState{
+		Version: migrationStateVersion,
+		Objects: map[string]string{},
+	}
+	if stateFile == "" {
+		return state, nil
+	}
+	data, err := os.ReadFile(stateFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, nil
+		}
+		return nil, fmt.Errorf("failed to read migration state: %w", err)
+	}
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to read migration state: %w", err)
+	}
+	if state.Version != migrationStateVersion {
+		return nil, fmt.Errorf("unsupported migration state version: %d", state.Version)
+	}
+	if state.Objects == nil {
+		state.Objects = map[string]string{}
+	}
+	return state, nil
+}
+
+func saveMigrationState(stateFile string, state *migrationState) error {
+	if stateFile == "" {
+		return nil
+	}
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so that the state file is never left
+	// partially written if the migration is interrupted.
+	tmp := stateFile + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return fmt.Errorf("failed to write migration state: %w", err)
+	}
+	if err := os.Rename(tmp, stateFile); err != nil {
+		return fmt.Errorf("failed to write migration state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package backup_test
+
+import (
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/audit"
+	"github.com/rancher/opni-monitoring/pkg/backup"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/machinery"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/storage/crds"
+	"github.com/rancher/opni-monitoring/pkg/storage/etcd"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Migrate from CRDs", Ordered, Label(test.Integration, test.Slow), func() {
+	var source, dest storage.Backend
+	ctx := context.Background()
+
+	BeforeAll(func() {
+		env := test.Environment{
+			TestBin: "../../testbin/bin",
+			CRDDirectoryPaths: []string{
+				"../sdk/crd",
+			},
+		}
+		restConfig, err := env.StartK8s()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(env.Start(test.WithEnableCortex(false), test.WithEnableGateway(false))).To(Succeed())
+		DeferCleanup(env.Stop)
+
+		source = crds.NewCRDStore(crds.WithRestConfig(restConfig))
+		dest = etcd.NewEtcdStore(ctx, env.EtcdConfig(), etcd.WithPrefix("gateway"))
+
+		Expect(source.CreateCluster(ctx, &core.Cluster{
+			Id: "cluster-1",
+		})).To(Succeed())
+		for _, ns := range []string{"plugin", audit.Namespace} {
+			kv, err := source.KeyValueStore(ns)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kv.Put(ctx, "key", []byte(ns))).To(Succeed())
+		}
+	})
+
+	It("should copy key-value data", func() {
+		result, err := backup.Migrate(ctx, source, dest,
+			backup.WithBackupOptions(
+				backup.WithKeyringNamespaces(backup.DefaultMigrationKeyringNamespaces...),
+				backup.WithKeyValueNamespaces(append(machinery.KeyValueNamespaces(nil), "plugin")...),
+			),
+			backup.WithStateFile(filepath.Join(GinkgoT().TempDir(), "state.json")),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		// 1 cluster, 2 keys
+		Expect(result.Copied).To(Equal(3))
+
+		for _, ns := range []string{"plugin", audit.Namespace} {
+			kv, err := dest.KeyValueStore(ns)
+			Expect(err).NotTo(HaveOccurred())
+			value, err := kv.Get(ctx, "key")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal([]byte(ns)))
+		}
+	})
+})
@@ -0,0 +1,122 @@
+package backup_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/backup"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/storage/bolt"
+	"github.com/rancher/opni-monitoring/pkg/storage/sql"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Migrate", Ordered, Label(test.Unit), func() {
+	var source, dest storage.Backend
+	var stateFile string
+	ctx := context.Background()
+	opts := func() []backup.MigrateOption {
+		return []backup.MigrateOption{
+			backup.WithBackupOptions(
+				backup.WithKeyringNamespaces(backup.DefaultMigrationKeyringNamespaces...),
+				backup.WithKeyValueNamespaces("plugin"),
+			),
+			backup.WithStateFile(stateFile),
+		}
+	}
+
+	BeforeAll(func() {
+		boltStore, err := bolt.NewBoltStore(&v1beta1.BoltStorageSpec{
+			Path: filepath.Join(GinkgoT().TempDir(), "opni.db"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(boltStore.Close)
+		source = boltStore
+
+		sqlStore, err := sql.NewSQLStore(ctx, &v1beta1.SQLStorageSpec{
+			Driver: v1beta1.SQLDriverSQLite,
+			DSN:    filepath.Join(GinkgoT().TempDir(), "opni.sqlite"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(sqlStore.Close)
+		dest = sqlStore
+
+		stateFile = filepath.Join(GinkgoT().TempDir(), "state.json")
+
+		_, err = source.CreateToken(ctx, time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		for _, id := range []string{"cluster-1", "cluster-2"} {
+			Expect(source.CreateCluster(ctx, &core.Cluster{
+				Id: id,
+			})).To(Succeed())
+			for _, ns := range []string{"gateway", "agent"} {
+				ks, err := source.KeyringStore(ctx, ns, &core.Reference{Id: id})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ks.Put(ctx, keyring.New(keyring.NewSharedKeys(make([]byte, 64))))).To(Succeed())
+			}
+		}
+		Expect(source.CreateRole(ctx, &core.Role{
+			Id: "role-1",
+		})).To(Succeed())
+		Expect(source.CreateRoleBinding(ctx, &core.RoleBinding{
+			Id:     "rb-1",
+			RoleId: "role-1",
+		})).To(Succeed())
+		kv, err := source.KeyValueStore("plugin")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Put(ctx, "key", []byte("value"))).To(Succeed())
+	})
+
+	It("should copy all objects", func() {
+		result, err := backup.Migrate(ctx, source, dest, opts()...)
+		Expect(err).NotTo(HaveOccurred())
+		// 1 token, 2 clusters, 4 keyrings, 1 role, 1 role binding, 1 key
+		Expect(result.Copied).To(Equal(10))
+		Expect(result.Skipped).To(Equal(0))
+
+		clusters, err := dest.ListClusters(ctx, nil, 0)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clusters.Items).To(HaveLen(2))
+		ks, err := dest.KeyringStore(ctx, "agent", &core.Reference{Id: "cluster-2"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ks.Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should skip objects which were already copied", func() {
+		result, err := backup.Migrate(ctx, source, dest, opts()...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Copied).To(Equal(0))
+		Expect(result.Skipped).To(Equal(10))
+	})
+
+	It("should copy objects which changed since the last run", func() {
+		_, err := source.UpdateCluster(ctx, &core.Reference{Id: "cluster-1"}, func(c *core.Cluster) {
+			c.Metadata = &core.ClusterMetadata{
+				Labels: map[string]string{"foo": "bar"},
+			}
+		})
+		Expect(err).NotTo(HaveOccurred())
+		kv, err := dest.KeyValueStore("plugin")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Put(ctx, "key", []byte("modified"))).To(Succeed())
+
+		result, err := backup.Migrate(ctx, source, dest, opts()...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Copied).To(Equal(2))
+		Expect(result.Skipped).To(Equal(8))
+
+		cluster, err := dest.GetCluster(ctx, &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetMetadata().GetLabels()).To(HaveKeyWithValue("foo", "bar"))
+		value, err := kv.Get(ctx, "key")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal([]byte("value")))
+	})
+})
@@ -19,6 +19,8 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/keyring"
 	"github.com/rancher/opni-monitoring/pkg/pkp"
 	"github.com/rancher/opni-monitoring/pkg/tokens"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"k8s.io/client-go/rest"
 )
 
@@ -34,12 +36,28 @@ var (
 )
 
 type ClientConfig struct {
-	Capability   string
-	Token        *tokens.Token
-	Pins         []*pkp.PublicKeyPin
-	Endpoint     string
+	Capability string
+	Token      *tokens.Token
+	Pins       []*pkp.PublicKeyPin
+	Endpoint   string
+	// Optional address ("host:port") of the gateway's bootstrap gRPC service.
+	// If set, it is preferred over the HTTP endpoints, which are only used if
+	// the gRPC service is unavailable.
+	GRPCEndpoint string
 	K8sConfig    *rest.Config
 	K8sNamespace string
+	// If set, Finalize erases the bootstrap token from this local config file
+	// instead of the agent-config secret. Used by agents which are not running
+	// in a Kubernetes cluster.
+	ConfigFile string
+}
+
+// clientTransport sends bootstrap requests to the server.
+type clientTransport interface {
+	// Join returns the server's token signatures and its leaf certificate.
+	Join(ctx context.Context) (*BootstrapJoinResponse, *x509.Certificate, error)
+	Auth(ctx context.Context, completeJws []byte, req BootstrapAuthRequest) (*BootstrapAuthResponse, error)
+	Close() error
 }
 
 func (c *ClientConfig) Bootstrap(
@@ -49,10 +67,11 @@ func (c *ClientConfig) Bootstrap(
 	if c.Token == nil {
 		return nil, ErrNoToken
 	}
-	response, serverLeafCert, err := c.bootstrapJoin()
+	transport, response, serverLeafCert, err := c.join(ctx)
 	if err != nil {
 		return nil, err
 	}
+	defer transport.Close()
 
 	completeJws, err := c.findValidSignature(
 		response.Signatures, serverLeafCert.PublicKey)
@@ -60,26 +79,12 @@ func (c *ClientConfig) Bootstrap(
 		return nil, err
 	}
 
-	// error already checked in bootstrapJoin
-	tlsConfig, _ := pkp.TLSConfig(c.Pins)
-
-	client := http.Client{
-		Transport: &http.Transport{
-			Dial: (&net.Dialer{
-				Timeout: 5 * time.Second,
-			}).Dial,
-			TLSHandshakeTimeout: 5 * time.Second,
-			TLSClientConfig:     tlsConfig,
-		},
-		Timeout: 10 * time.Second,
-	}
-
 	ekp := ecdh.NewEphemeralKeyPair()
 	id, err := ident.UniqueIdentifier(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to obtain unique identifier: %w", err)
 	}
-	authReq, err := json.Marshal(BootstrapAuthRequest{
+	authResp, err := transport.Auth(ctx, completeJws, BootstrapAuthRequest{
 		ClientID:     id,
 		ClientPubKey: ekp.PublicKey,
 		Capability:   c.Capability,
@@ -88,31 +93,6 @@ func (c *ClientConfig) Bootstrap(
 		return nil, err
 	}
 
-	// error already checked in bootstrapJoin
-	url, _ := c.bootstrapAuthURL()
-
-	req, err := http.NewRequest(http.MethodPost, url.String(),
-		bytes.NewReader(authReq))
-	if err != nil {
-		return nil, err
-	}
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Request", "application/json")
-	req.Header.Add("Authorization", "Bearer "+string(completeJws))
-	resp, err := client.Do(req)
-	if err != nil {
-		return nil, err
-	}
-	if resp.StatusCode != 200 {
-		return nil, fmt.Errorf("%w: %s", ErrBootstrapFailed, resp.Status)
-	}
-	defer resp.Body.Close()
-
-	var authResp BootstrapAuthResponse
-	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
-		return nil, err
-	}
-
 	sharedSecret, err := ecdh.DeriveSharedSecret(ekp, ecdh.PeerPublicKey{
 		PublicKey: authResp.ServerPubKey,
 		PeerType:  ecdh.PeerTypeServer,
@@ -126,7 +106,45 @@ func (c *ClientConfig) Bootstrap(
 	), nil
 }
 
+// join sends a join request using the gRPC service if it is configured and
+// available, otherwise using HTTP. The transport used is returned so that
+// the auth request can be sent the same way.
+func (c *ClientConfig) join(ctx context.Context) (
+	clientTransport, *BootstrapJoinResponse, *x509.Certificate, error,
+) {
+	if c.GRPCEndpoint != "" {
+		transport, err := newGRPCTransport(c.GRPCEndpoint, c.Pins)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		response, cert, err := transport.Join(ctx)
+		if err == nil {
+			return transport, response, cert, nil
+		}
+		transport.Close()
+		switch status.Code(err) {
+		case codes.Unavailable, codes.Unimplemented:
+			// The gateway does not serve the bootstrap gRPC service, or it
+			// could not be reached. Try the HTTP endpoints instead.
+		default:
+			return nil, nil, nil, err
+		}
+	}
+	transport := &httpTransport{
+		endpoint: c.Endpoint,
+		pins:     c.Pins,
+	}
+	response, cert, err := transport.Join(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return transport, response, cert, nil
+}
+
 func (c *ClientConfig) Finalize(ctx context.Context) error {
+	if c.ConfigFile != "" {
+		return eraseBootstrapTokensFromFile(c.ConfigFile)
+	}
 	ns := c.K8sNamespace
 	if ns == "" {
 		if nsEnv, ok := os.LookupEnv("POD_NAMESPACE"); ok {
@@ -138,8 +156,17 @@ func (c *ClientConfig) Finalize(ctx context.Context) error {
 	return eraseBootstrapTokensFromConfig(ctx, c.K8sConfig, ns)
 }
 
-func (c *ClientConfig) bootstrapJoinURL() (*url.URL, error) {
-	u, err := url.Parse(c.Endpoint)
+type httpTransport struct {
+	endpoint string
+	pins     []*pkp.PublicKeyPin
+}
+
+func (t *httpTransport) Close() error {
+	return nil
+}
+
+func (t *httpTransport) bootstrapJoinURL() (*url.URL, error) {
+	u, err := url.Parse(t.endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -148,8 +175,8 @@ func (c *ClientConfig) bootstrapJoinURL() (*url.URL, error) {
 	return u, nil
 }
 
-func (c *ClientConfig) bootstrapAuthURL() (*url.URL, error) {
-	u, err := url.Parse(c.Endpoint)
+func (t *httpTransport) bootstrapAuthURL() (*url.URL, error) {
+	u, err := url.Parse(t.endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -158,13 +185,13 @@ func (c *ClientConfig) bootstrapAuthURL() (*url.URL, error) {
 	return u, nil
 }
 
-func (c *ClientConfig) bootstrapJoin() (*BootstrapJoinResponse, *x509.Certificate, error) {
-	url, err := c.bootstrapJoinURL()
+func (t *httpTransport) Join(context.Context) (*BootstrapJoinResponse, *x509.Certificate, error) {
+	url, err := t.bootstrapJoinURL()
 	if err != nil {
 		return nil, nil, err
 	}
 
-	tlsConfig, err := pkp.TLSConfig(c.Pins)
+	tlsConfig, err := pkp.TLSConfig(t.pins)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -193,6 +220,61 @@ func (c *ClientConfig) bootstrapJoin() (*BootstrapJoinResponse, *x509.Certificat
 	return bootstrapResponse, resp.TLS.PeerCertificates[0], nil
 }
 
+func (t *httpTransport) Auth(
+	_ context.Context,
+	completeJws []byte,
+	authReq BootstrapAuthRequest,
+) (*BootstrapAuthResponse, error) {
+	// error already checked in Join
+	tlsConfig, _ := pkp.TLSConfig(t.pins)
+
+	client := http.Client{
+		Transport: &http.Transport{
+			Dial: (&net.Dialer{
+				Timeout: 5 * time.Second,
+			}).Dial,
+			TLSHandshakeTimeout: 5 * time.Second,
+			TLSClientConfig:     tlsConfig,
+		},
+		Timeout: 10 * time.Second,
+	}
+
+	reqData, err := json.Marshal(authReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// error already checked in Join
+	url, _ := t.bootstrapAuthURL()
+
+	req, err := http.NewRequest(http.MethodPost, url.String(),
+		bytes.NewReader(reqData))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Request", "application/json")
+	req.Header.Add("Authorization", "Bearer "+string(completeJws))
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		// Include the reason given by the server, if any
+		if msg, err := io.ReadAll(io.LimitReader(resp.Body, 1024)); err == nil && len(msg) > 0 {
+			return nil, fmt.Errorf("%w: %s: %s", ErrBootstrapFailed, resp.Status, msg)
+		}
+		return nil, fmt.Errorf("%w: %s", ErrBootstrapFailed, resp.Status)
+	}
+
+	var authResp BootstrapAuthResponse
+	if err := json.NewDecoder(resp.Body).Decode(&authResp); err != nil {
+		return nil, err
+	}
+	return &authResp, nil
+}
+
 func (c *ClientConfig) findValidSignature(
 	signatures map[string][]byte,
 	pubKey interface{},
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 
@@ -170,6 +171,46 @@ var _ = Describe("Client", Ordered, Label(test.Unit, test.Slow), func() {
 
 			Expect(env.Stop()).To(Succeed())
 		})
+		It("should erase bootstrap tokens from a local config file", func() {
+			config := v1beta1.AgentConfig{
+				TypeMeta: meta.TypeMeta{
+					APIVersion: "v1beta1",
+					Kind:       "AgentConfig",
+				},
+				Spec: v1beta1.AgentConfigSpec{
+					IdentityProvider: "host",
+					Bootstrap: &v1beta1.BootstrapSpec{
+						Token: "foo",
+						Pins:  []string{"foo", "bar"},
+					},
+				},
+			}
+			data, err := yaml.Marshal(config)
+			Expect(err).NotTo(HaveOccurred())
+			other := "apiVersion: v1beta1\nkind: Other\nspec:\n  foo: bar\n"
+			path := filepath.Join(GinkgoT().TempDir(), "agent.yaml")
+			Expect(os.WriteFile(path, []byte(other+"---\n"+string(data)), 0640)).To(Succeed())
+
+			cc := bootstrap.ClientConfig{
+				ConfigFile: path,
+			}
+			Expect(cc.Finalize(context.Background())).To(Succeed())
+
+			info, err := os.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0640)))
+			updated, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			documents := strings.Split(string(updated), "\n---\n")
+			Expect(documents).To(HaveLen(2))
+			Expect(documents[0]).To(Equal(strings.TrimSuffix(other, "\n")))
+
+			erased := v1beta1.AgentConfig{}
+			Expect(yaml.Unmarshal([]byte(documents[1]), &erased)).To(Succeed())
+			Expect(erased.Spec.IdentityProvider).To(Equal("host"))
+			Expect(erased.Spec.Bootstrap).To(BeNil())
+			Expect(string(updated)).NotTo(ContainSubstring("token"))
+		})
 		When("the defaults are used and the in-cluster config is unavailable", func() {
 			It("should error", func() {
 				// sanity check, this is set above
@@ -31,5 +31,16 @@ The bootstrap process is as follows:
 	 a KDF to create two static ed25519 keys. One is used to generate and verify
 	 MACs for client->server messages, and the other is used to generate and
 	 verify MACs for server->client messages.
+
+The join and auth requests can also be sent using the versioned Bootstrap gRPC
+service (see pkg/apis/bootstrap/v1), which returns typed errors. Clients prefer
+the gRPC service when it is configured, and fall back to the HTTP endpoints if
+it is unavailable.
+
+Once bootstrapped, clients periodically fetch the pins announced by the server
+from the /bootstrap/pins endpoint, authenticated using their shared keys. While
+the server's serving certificate is being replaced, the pins of the next
+certificate are announced along with the current pins, and clients add them to
+their keyrings. Pins which the server no longer announces are removed.
 */
 package bootstrap
@@ -0,0 +1,236 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	bootstrapv1 "github.com/rancher/opni-monitoring/pkg/apis/bootstrap/v1"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type bootstrapServer struct {
+	bootstrapv1.UnimplementedBootstrapServer
+	config ServerConfig
+}
+
+// NewBootstrapServer returns a gRPC implementation of the bootstrap server,
+// with the same semantics as the HTTP handler.
+func (h ServerConfig) NewBootstrapServer() bootstrapv1.BootstrapServer {
+	return &bootstrapServer{
+		config: h,
+	}
+}
+
+func (s *bootstrapServer) Join(
+	ctx context.Context,
+	_ *bootstrapv1.BootstrapJoinRequest,
+) (*bootstrapv1.BootstrapJoinResponse, error) {
+	if incomingValue(ctx, "authorization") != "" {
+		return nil, status.Error(codes.InvalidArgument, "join requests must not contain an authorization token")
+	}
+	resp, err := s.config.join(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &bootstrapv1.BootstrapJoinResponse{
+		Signatures: resp.Signatures,
+	}, nil
+}
+
+func (s *bootstrapServer) Auth(
+	ctx context.Context,
+	req *bootstrapv1.BootstrapAuthRequest,
+) (*bootstrapv1.BootstrapAuthResponse, error) {
+	authHeader := incomingValue(ctx, "authorization")
+	if authHeader == "" {
+		return nil, status.Error(codes.Unauthenticated, "no authorization token given")
+	}
+	bearerToken := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer"))
+
+	caller := callerInfo{
+		Address:      peerAddress(ctx),
+		UserAgent:    incomingValue(ctx, "user-agent"),
+		ForwardedFor: incomingValue(ctx, "x-forwarded-for"),
+	}
+	if method, ok := grpc.Method(ctx); ok {
+		caller.Operation = method
+	}
+
+	bootstrapToken, err := s.config.verifyToken(ctx, []byte(bearerToken), caller.Address)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.config.auth(ctx, bootstrapToken, BootstrapAuthRequest{
+		ClientID:     req.GetClientID(),
+		ClientPubKey: req.GetClientPubKey(),
+		Capability:   req.GetCapability(),
+	}, caller)
+	if err != nil {
+		return nil, err
+	}
+	return &bootstrapv1.BootstrapAuthResponse{
+		ServerPubKey: resp.ServerPubKey,
+	}, nil
+}
+
+// incomingValue returns the first value of the given key in the incoming
+// request metadata, or an empty string if it is not present.
+func incomingValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if values := md.Get(key); len(values) > 0 {
+		return strings.TrimSpace(values[0])
+	}
+	return ""
+}
+
+func peerAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// RateLimitInterceptor returns a unary server interceptor which limits each
+// client address to the given number of requests per minute, matching the
+// rate limit applied to the HTTP bootstrap endpoints.
+func RateLimitInterceptor(requestsPerMinute int) grpc.UnaryServerInterceptor {
+	limiter := &peerRateLimiter{
+		limit:    rate.Every(time.Minute / time.Duration(requestsPerMinute)),
+		burst:    requestsPerMinute,
+		limiters: map[string]*peerLimiter{},
+	}
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if !limiter.Allow(peerAddress(ctx)) {
+			return nil, status.Error(codes.ResourceExhausted, "too many requests")
+		}
+		return handler(ctx, req)
+	}
+}
+
+type peerLimiter struct {
+	*rate.Limiter
+	lastSeen time.Time
+}
+
+type peerRateLimiter struct {
+	mu        sync.Mutex
+	limit     rate.Limit
+	burst     int
+	limiters  map[string]*peerLimiter
+	lastPrune time.Time
+}
+
+func (l *peerRateLimiter) Allow(addr string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	if now.Sub(l.lastPrune) > time.Minute {
+		// Limiters which have not been used for a minute have refilled, and
+		// can be recreated on demand.
+		for k, v := range l.limiters {
+			if now.Sub(v.lastSeen) > time.Minute {
+				delete(l.limiters, k)
+			}
+		}
+		l.lastPrune = now
+	}
+	pl, ok := l.limiters[addr]
+	if !ok {
+		pl = &peerLimiter{
+			Limiter: rate.NewLimiter(l.limit, l.burst),
+		}
+		l.limiters[addr] = pl
+	}
+	pl.lastSeen = now
+	return pl.AllowN(now, 1)
+}
+
+type grpcTransport struct {
+	cc     *grpc.ClientConn
+	client bootstrapv1.BootstrapClient
+}
+
+func newGRPCTransport(endpoint string, pins []*pkp.PublicKeyPin) (*grpcTransport, error) {
+	tlsConfig, err := pkp.TLSConfig(pins)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpc.Dial(endpoint, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidEndpoint, err)
+	}
+	return &grpcTransport{
+		cc:     cc,
+		client: bootstrapv1.NewBootstrapClient(cc),
+	}, nil
+}
+
+func (t *grpcTransport) Close() error {
+	return t.cc.Close()
+}
+
+func (t *grpcTransport) Join(ctx context.Context) (*BootstrapJoinResponse, *x509.Certificate, error) {
+	ctx, ca := context.WithTimeout(ctx, 10*time.Second)
+	defer ca()
+	var p peer.Peer
+	resp, err := t.client.Join(ctx, &bootstrapv1.BootstrapJoinRequest{}, grpc.Peer(&p))
+	if err != nil {
+		return nil, nil, err
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil, nil, errors.New("no peer certificates found in connection state")
+	}
+	return &BootstrapJoinResponse{
+		Signatures: resp.GetSignatures(),
+	}, tlsInfo.State.PeerCertificates[0], nil
+}
+
+// Errors returned by the server are returned unchanged, and can be compared to the server's
+// error values using errors.Is.
+func (t *grpcTransport) Auth(
+	ctx context.Context,
+	completeJws []byte,
+	req BootstrapAuthRequest,
+) (*BootstrapAuthResponse, error) {
+	ctx, ca := context.WithTimeout(ctx, 10*time.Second)
+	defer ca()
+	ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+string(completeJws))
+	resp, err := t.client.Auth(ctx, &bootstrapv1.BootstrapAuthRequest{
+		ClientID:     req.ClientID,
+		ClientPubKey: req.ClientPubKey,
+		Capability:   req.Capability,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &BootstrapAuthResponse{
+		ServerPubKey: resp.GetServerPubKey(),
+	}, nil
+}
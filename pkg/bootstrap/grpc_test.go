@@ -0,0 +1,250 @@
+package bootstrap_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jws"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	bootstrapv1 "github.com/rancher/opni-monitoring/pkg/apis/bootstrap/v1"
+	"github.com/rancher/opni-monitoring/pkg/bootstrap"
+	"github.com/rancher/opni-monitoring/pkg/capabilities"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/ecdh"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"github.com/rancher/opni-monitoring/pkg/tokens"
+)
+
+var _ = Describe("gRPC Server", Label(test.Unit, test.Slow), func() {
+	var token *core.BootstrapToken
+	var cert *tls.Certificate
+	var mockTokenStore storage.TokenStore
+	var mockClusterStore storage.ClusterStore
+	var serverConfig bootstrap.ServerConfig
+	var grpcAddr string
+	var registerService bool
+	var interceptors []grpc.UnaryServerInterceptor
+
+	BeforeEach(func() {
+		registerService = true
+		interceptors = nil
+		ctx, ca := context.WithCancel(context.Background())
+		DeferCleanup(ca)
+		mockTokenStore = test.NewTestTokenStore(ctx, ctrl)
+		mockClusterStore = test.NewTestClusterStore(ctrl)
+
+		var err error
+		token, err = mockTokenStore.CreateToken(context.Background(), time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+
+		crt, err := tls.X509KeyPair(test.TestData("self_signed_leaf.crt"), test.TestData("self_signed_leaf.key"))
+		Expect(err).NotTo(HaveOccurred())
+		crt.Leaf, err = x509.ParseCertificate(crt.Certificate[0])
+		Expect(err).NotTo(HaveOccurred())
+		cert = &crt
+
+		capBackendStore := capabilities.NewBackendStore(capabilities.ServerInstallerTemplateSpec{}, test.Log)
+		capBackendStore.Add("test", test.NewTestCapabilityBackend(ctrl, &test.CapabilityInfo{
+			Name:       "test",
+			CanInstall: true,
+		}))
+		serverConfig = bootstrap.ServerConfig{
+			Certificate:         cert,
+			TokenStore:          mockTokenStore,
+			ClusterStore:        mockClusterStore,
+			KeyringStoreBroker:  test.NewTestKeyringStoreBroker(ctrl),
+			CapabilityInstaller: capBackendStore,
+		}
+	})
+	JustBeforeEach(func() {
+		srv := grpc.NewServer(
+			grpc.Creds(credentials.NewTLS(&tls.Config{
+				Certificates: []tls.Certificate{*cert},
+			})),
+			grpc.ChainUnaryInterceptor(interceptors...),
+		)
+		if registerService {
+			bootstrapv1.RegisterBootstrapServer(srv, serverConfig.NewBootstrapServer())
+		}
+		listener, err := net.Listen("tcp4", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		grpcAddr = listener.Addr().String()
+		go srv.Serve(listener)
+		DeferCleanup(srv.Stop)
+	})
+
+	toRawToken := func(tk *core.BootstrapToken) *tokens.Token {
+		rawToken, err := tokens.FromBootstrapToken(tk)
+		Expect(err).NotTo(HaveOccurred())
+		return rawToken
+	}
+	newClient := func() bootstrapv1.BootstrapClient {
+		tlsConfig, err := pkp.TLSConfig([]*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)})
+		Expect(err).NotTo(HaveOccurred())
+		cc, err := grpc.Dial(grpcAddr, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(cc.Close)
+		return bootstrapv1.NewBootstrapClient(cc)
+	}
+	signedToken := func(tk *core.BootstrapToken) string {
+		jsonData, err := json.Marshal(toRawToken(tk))
+		Expect(err).NotTo(HaveOccurred())
+		sig, err := jws.Sign(jsonData, jwa.EdDSA, cert.PrivateKey)
+		Expect(err).NotTo(HaveOccurred())
+		return string(sig)
+	}
+	authRequest := func(clientID string) *bootstrapv1.BootstrapAuthRequest {
+		return &bootstrapv1.BootstrapAuthRequest{
+			ClientID:     clientID,
+			ClientPubKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			Capability:   "test",
+		}
+	}
+	withToken := func(tk *core.BootstrapToken) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(),
+			"authorization", "Bearer "+signedToken(tk))
+	}
+
+	It("should bootstrap a client", func() {
+		cc := bootstrap.ClientConfig{
+			Capability:   "test",
+			Token:        toRawToken(token),
+			Pins:         []*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)},
+			Endpoint:     "https://127.0.0.1:1",
+			GRPCEndpoint: grpcAddr,
+		}
+		kr, err := cc.Bootstrap(context.Background(), test.NewTestIdentProvider(ctrl, "foo"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kr).NotTo(BeNil())
+
+		cluster, err := mockClusterStore.GetCluster(context.Background(), &core.Reference{
+			Id: "foo",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(capabilities.Has(cluster, capabilities.Cluster("test"))).To(BeTrue())
+	})
+	It("should return the server's token signatures", func() {
+		resp, err := newClient().Join(context.Background(), &bootstrapv1.BootstrapJoinRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetSignatures()).To(HaveKey(toRawToken(token).HexID()))
+	})
+	It("should reject join requests with an authorization token", func() {
+		_, err := newClient().Join(withToken(token), &bootstrapv1.BootstrapJoinRequest{})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+	It("should return typed errors", func() {
+		client := newClient()
+
+		By("sending an auth request without a token")
+		_, err := client.Auth(context.Background(), authRequest("foo"))
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+		By("sending an auth request with an invalid token")
+		ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", "Bearer invalid")
+		_, err = client.Auth(ctx, authRequest("foo"))
+		Expect(errors.Is(err, bootstrap.ErrInvalidToken)).To(BeTrue())
+
+		By("sending an invalid auth request")
+		_, err = client.Auth(withToken(token), &bootstrapv1.BootstrapAuthRequest{
+			ClientID: "foo",
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+		By("requesting an unknown capability")
+		req := authRequest("foo")
+		req.Capability = "unknown"
+		_, err = client.Auth(withToken(token), req)
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		By("requesting a capability which is already installed")
+		_, err = client.Auth(withToken(token), authRequest("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Auth(withToken(token), authRequest("foo"))
+		Expect(errors.Is(err, bootstrap.ErrCapabilityAlreadyInstalled)).To(BeTrue())
+
+		By("using a token which has reached its usage limit")
+		tk, err := mockTokenStore.CreateToken(context.Background(), time.Hour, storage.WithLimits(&core.TokenLimits{
+			MaxUsages: 1,
+		}))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Auth(withToken(tk), authRequest("bar"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = client.Auth(withToken(tk), authRequest("baz"))
+		Expect(errors.Is(err, bootstrap.ErrTokenUsageLimitReached)).To(BeTrue())
+	})
+	When("cluster approval is required", func() {
+		BeforeEach(func() {
+			serverConfig.RequireApproval = true
+		})
+		It("should return a typed error for pending clusters", func() {
+			client := newClient()
+			_, err := client.Auth(withToken(token), authRequest("foo"))
+			Expect(err).NotTo(HaveOccurred())
+			_, err = client.Auth(withToken(token), authRequest("foo"))
+			Expect(errors.Is(err, bootstrap.ErrClusterPendingApproval)).To(BeTrue())
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+	})
+	When("the server is rate limited", func() {
+		BeforeEach(func() {
+			interceptors = append(interceptors, bootstrap.RateLimitInterceptor(2))
+		})
+		It("should reject requests which exceed the limit", func() {
+			client := newClient()
+			for i := 0; i < 2; i++ {
+				_, err := client.Join(context.Background(), &bootstrapv1.BootstrapJoinRequest{})
+				Expect(err).NotTo(HaveOccurred())
+			}
+			_, err := client.Join(context.Background(), &bootstrapv1.BootstrapJoinRequest{})
+			Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+		})
+	})
+	When("the bootstrap service is not available", func() {
+		BeforeEach(func() {
+			registerService = false
+		})
+		It("should fall back to the HTTP API", func() {
+			app := fiber.New(fiber.Config{
+				DisableStartupMessage: true,
+			})
+			app.Post("/bootstrap/*", serverConfig.Handle)
+			listener, err := tls.Listen("tcp4", "127.0.0.1:0", &tls.Config{
+				Certificates: []tls.Certificate{*cert},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			go app.Listener(listener)
+			DeferCleanup(app.Shutdown)
+
+			cc := bootstrap.ClientConfig{
+				Capability:   "test",
+				Token:        toRawToken(token),
+				Pins:         []*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)},
+				Endpoint:     "https://" + listener.Addr().String(),
+				GRPCEndpoint: grpcAddr,
+			}
+			_, err = cc.Bootstrap(context.Background(), test.NewTestIdentProvider(ctrl, "foo"))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = mockClusterStore.GetCluster(context.Background(), &core.Reference{
+				Id: "foo",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
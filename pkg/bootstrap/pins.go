@@ -0,0 +1,123 @@
+package bootstrap
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+)
+
+const PinsPath = "/bootstrap/pins"
+
+var ErrPinSyncFailed = errors.New("pin sync failed")
+
+// PinsServerConfig announces the public key pins of the gateway's serving
+// certificates to clusters which have already been bootstrapped, so that the
+// serving certificate can be replaced without bootstrapping them again. The
+// handler must be used behind the cluster auth middleware.
+type PinsServerConfig struct {
+	// The gateway's serving certificates. The first certificate is the one
+	// currently in use, and any others are pending certificates which will
+	// replace it.
+	Certificates []tls.Certificate
+}
+
+func (h PinsServerConfig) Handle(c *fiber.Ctx) error {
+	lg := c.Context().Logger()
+	resp := PinsResponse{
+		ActivePins:  []*pkp.PublicKeyPin{},
+		PendingPins: []*pkp.PublicKeyPin{},
+	}
+	for i := range h.Certificates {
+		pins, err := pkp.ChainPins(&h.Certificates[i])
+		if err != nil {
+			lg.Printf("error computing certificate pins: %v", err)
+			return c.SendStatus(fiber.StatusInternalServerError)
+		}
+		if i == 0 {
+			resp.ActivePins = pins
+		} else {
+			resp.PendingPins = append(resp.PendingPins, pins...)
+		}
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// SyncPins fetches the pins announced by the gateway, and returns a copy of
+// the keyring in which the pinned keys are updated to match. Pins for pending
+// certificates are added, and pins which the gateway no longer announces are
+// removed. The second return value is false if the pinned keys did not change.
+func SyncPins(
+	ctx context.Context,
+	client clients.GatewayHTTPClient,
+	kr keyring.Keyring,
+) (keyring.Keyring, bool, error) {
+	code, body, err := client.Get(ctx, PinsPath).Do()
+	if err != nil {
+		return nil, false, err
+	}
+	if code != http.StatusOK {
+		return nil, false, fmt.Errorf("%w: %s", ErrPinSyncFailed, http.StatusText(code))
+	}
+	var resp PinsResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, false, err
+	}
+
+	announcedPins := map[string]struct{}{}
+	for _, pin := range append(resp.ActivePins, resp.PendingPins...) {
+		if err := pin.Validate(); err != nil {
+			return nil, false, fmt.Errorf("%w: %v", ErrPinSyncFailed, err)
+		}
+		announcedPins[pin.Encode()] = struct{}{}
+	}
+
+	var current []*pkp.PublicKeyPin
+	kr.Try(func(pk *keyring.PKPKey) {
+		current = append(current, pk.PinnedKeys...)
+	})
+	pins := []*pkp.PublicKeyPin{}
+	seen := map[string]struct{}{}
+	changed := false
+	for _, pin := range current {
+		_, announced := announcedPins[pin.Encode()]
+		_, duplicate := seen[pin.Encode()]
+		if !announced || duplicate {
+			changed = true
+			continue
+		}
+		seen[pin.Encode()] = struct{}{}
+		pins = append(pins, pin)
+	}
+	// The gateway is only trusted to announce new pins if it could be
+	// verified using at least one of the existing pins.
+	if len(pins) == 0 {
+		return nil, false, fmt.Errorf("%w: none of the pinned keys are announced by the gateway", ErrPinSyncFailed)
+	}
+	for _, pin := range resp.PendingPins {
+		if _, ok := seen[pin.Encode()]; !ok {
+			changed = true
+			seen[pin.Encode()] = struct{}{}
+			pins = append(pins, pin)
+		}
+	}
+	if !changed {
+		return kr, false, nil
+	}
+
+	keys := []interface{}{}
+	kr.ForEach(func(key interface{}) {
+		if _, ok := key.(*keyring.PKPKey); !ok {
+			keys = append(keys, key)
+		}
+	})
+	keys = append(keys, keyring.NewPKPKey(pins))
+	return keyring.New(keys...), true, nil
+}
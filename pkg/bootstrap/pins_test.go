@@ -0,0 +1,134 @@
+package bootstrap_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/bootstrap"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/ecdh"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Pin Sync", Ordered, Label(test.Unit, test.Slow), func() {
+	var oldCert, newCert, otherCert tls.Certificate
+	var gatewayCerts []tls.Certificate
+	var agentKeyring keyring.Keyring
+	var address string
+	ref := &core.Reference{
+		Id: "foo",
+	}
+	loadCert := func(name string) tls.Certificate {
+		crt, err := tls.X509KeyPair(test.TestData(name+".crt"), test.TestData(name+".key"))
+		Expect(err).NotTo(HaveOccurred())
+		crt.Leaf, err = x509.ParseCertificate(crt.Certificate[0])
+		Expect(err).NotTo(HaveOccurred())
+		return crt
+	}
+	pinnedKeys := func(kr keyring.Keyring) []string {
+		var pins []string
+		kr.Try(func(pk *keyring.PKPKey) {
+			for _, pin := range pk.PinnedKeys {
+				pins = append(pins, pin.Encode())
+			}
+		})
+		return pins
+	}
+	syncPins := func() (keyring.Keyring, bool, error) {
+		client, err := clients.NewGatewayHTTPClient(address, test.NewTestIdentProvider(ctrl, ref.Id), agentKeyring)
+		Expect(err).NotTo(HaveOccurred())
+		return bootstrap.SyncPins(context.Background(), client, agentKeyring)
+	}
+
+	BeforeAll(func() {
+		oldCert = loadCert("self_signed_leaf")
+		newCert = loadCert("localhost")
+		otherCert = loadCert("example.com")
+
+		broker := test.NewTestKeyringStoreBroker(ctrl)
+		gatewayStore, err := broker.KeyringStore(context.Background(), "gateway", ref)
+		Expect(err).NotTo(HaveOccurred())
+		secret, err := ecdh.DeriveSharedSecret(ecdh.NewEphemeralKeyPair(), ecdh.PeerPublicKey{
+			PublicKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			PeerType:  ecdh.PeerTypeClient,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayStore.Put(context.Background(), keyring.New(keyring.NewSharedKeys(secret)))).To(Succeed())
+		agentKeyring = keyring.New(
+			keyring.NewSharedKeys(secret),
+			keyring.NewPKPKey([]*pkp.PublicKeyPin{pkp.NewSha256(oldCert.Leaf)}),
+		)
+
+		app := fiber.New(fiber.Config{
+			DisableStartupMessage: true,
+		})
+		cm, err := cluster.New(broker, "X-Test")
+		Expect(err).NotTo(HaveOccurred())
+		app.Get(bootstrap.PinsPath, cm.Handle, func(c *fiber.Ctx) error {
+			return bootstrap.PinsServerConfig{
+				Certificates: gatewayCerts,
+			}.Handle(c)
+		})
+
+		listener, err := tls.Listen("tcp4", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{oldCert},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		address = "https://" + listener.Addr().String()
+		go app.Listener(listener)
+		DeferCleanup(app.Shutdown)
+	})
+
+	It("should not change the keyring if no certificates are pending", func() {
+		gatewayCerts = []tls.Certificate{oldCert}
+		kr, changed, err := syncPins()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+		Expect(kr).To(Equal(agentKeyring))
+	})
+	It("should add the pins of pending certificates", func() {
+		gatewayCerts = []tls.Certificate{oldCert, newCert}
+		kr, changed, err := syncPins()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		pins := pinnedKeys(kr)
+		Expect(pins).To(ContainElements(
+			pkp.NewSha256(oldCert.Leaf).Encode(),
+			pkp.NewSha256(newCert.Leaf).Encode(),
+		))
+		var sharedKeys int
+		kr.Try(func(*keyring.SharedKeys) {
+			sharedKeys++
+		})
+		Expect(sharedKeys).To(Equal(1))
+		agentKeyring = kr
+
+		By("syncing again")
+		_, changed, err = syncPins()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+	It("should remove pins which are no longer announced", func() {
+		gatewayCerts = []tls.Certificate{newCert}
+		kr, changed, err := syncPins()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		pins := pinnedKeys(kr)
+		Expect(pins).To(ContainElement(pkp.NewSha256(newCert.Leaf).Encode()))
+		Expect(pins).NotTo(ContainElement(pkp.NewSha256(oldCert.Leaf).Encode()))
+	})
+	It("should not trust pins if none of the existing pins are announced", func() {
+		gatewayCerts = []tls.Certificate{otherCert}
+		_, _, err := syncPins()
+		Expect(err).To(MatchError(bootstrap.ErrPinSyncFailed))
+	})
+})
@@ -0,0 +1,186 @@
+package bootstrap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/control"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/ecdh"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/validation"
+)
+
+const RekeyPath = "/bootstrap/rekey"
+
+var (
+	ErrRekeyFailed    = errors.New("rekey failed")
+	errNotCurrentKeys = errors.New("rekey requests must be signed with the current shared keys")
+)
+
+// RekeyServerConfig handles key exchange requests from clusters which have
+// already been bootstrapped. Requests must be authenticated using the
+// cluster's newest shared keys, so the handler must be used behind the
+// cluster auth middleware.
+type RekeyServerConfig struct {
+	KeyringStoreBroker storage.KeyringStoreBroker
+	// Length of time for which the previous keys remain valid after a key
+	// exchange. Defaults to keyring.DefaultRotationGracePeriod.
+	GracePeriod time.Duration
+	// If set, the agent's control stream is closed once the previous keys
+	// expire, since it may have been opened with them. It is not closed
+	// immediately, since the key exchange may have been requested by a
+	// command sent over the stream. Optional.
+	AgentControl control.AgentController
+}
+
+func (h RekeyServerConfig) Handle(c *fiber.Ctx) error {
+	switch c.Method() {
+	case http.MethodGet:
+		return h.handleRekeyStatus(c)
+	case http.MethodPost:
+		return h.handleRekey(c)
+	default:
+		return c.SendStatus(fiber.StatusMethodNotAllowed)
+	}
+}
+
+func (h RekeyServerConfig) handleRekeyStatus(c *fiber.Ctx) error {
+	keys := cluster.AuthorizedKeys(c)
+	return c.Status(fiber.StatusOK).JSON(RekeyStatusResponse{
+		RotationRequired: keys.ExpiresAt != nil,
+		ExpiresAt:        keys.ExpiresAt,
+	})
+}
+
+func (h RekeyServerConfig) handleRekey(c *fiber.Ctx) error {
+	lg := c.Context().Logger()
+	clientReq := RekeyRequest{}
+	if err := json.Unmarshal(c.Body(), &clientReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid request body")
+	}
+	if err := validation.Validate(clientReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+	}
+
+	ekp := ecdh.NewEphemeralKeyPair()
+	sharedSecret, err := ecdh.DeriveSharedSecret(ekp, ecdh.PeerPublicKey{
+		PublicKey: clientReq.ClientPubKey,
+		PeerType:  ecdh.PeerTypeClient,
+	})
+	if err != nil {
+		lg.Printf("error computing shared secret: %v", err)
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	ref := &core.Reference{
+		Id: cluster.AuthorizedID(c),
+	}
+	krStore, err := h.KeyringStoreBroker.KeyringStore(context.Background(), "gateway", ref)
+	if err != nil {
+		lg.Printf("error getting keyring store: %v", err)
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+
+	gracePeriod := h.GracePeriod
+	if gracePeriod == 0 {
+		gracePeriod = keyring.DefaultRotationGracePeriod
+	}
+	authorizedKeys := cluster.AuthorizedKeys(c)
+	newKeys := keyring.NewSharedKeys(sharedSecret)
+	var expiresAt time.Time
+	_, err = krStore.Update(context.Background(), func(kr keyring.Keyring) (keyring.Keyring, error) {
+		// Only the keys which signed the request are replaced, since the
+		// keyring also contains the keys of any other agents for the cluster.
+		// Keys which were already replaced cannot be used to rekey again.
+		// Otherwise, previous keys which are still within their grace period
+		// could be used to replace the agent's current keys and lock it out.
+		updated, exp, ok := keyring.Replace(kr, authorizedKeys, newKeys, time.Now().Add(gracePeriod))
+		if !ok {
+			return nil, errNotCurrentKeys
+		}
+		expiresAt = exp
+		return updated, nil
+	})
+	if err != nil {
+		if errors.Is(err, errNotCurrentKeys) {
+			return c.Status(fiber.StatusUnauthorized).SendString(err.Error())
+		}
+		lg.Printf("error updating keyring: %v", err)
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+	if h.AgentControl != nil {
+		h.AgentControl.DisconnectAt(ref.Id, expiresAt)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(RekeyResponse{
+		ServerPubKey: ekp.PublicKey,
+		ExpiresAt:    expiresAt,
+	})
+}
+
+// KeyRotationRequired checks whether the keys used by the client have been
+// scheduled for rotation by the gateway.
+func KeyRotationRequired(ctx context.Context, client clients.GatewayHTTPClient) (bool, error) {
+	code, body, err := client.Get(ctx, RekeyPath).Do()
+	if err != nil {
+		return false, err
+	}
+	if code != http.StatusOK {
+		return false, fmt.Errorf("%w: %s", ErrRekeyFailed, http.StatusText(code))
+	}
+	var resp RekeyStatusResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false, err
+	}
+	return resp.RotationRequired, nil
+}
+
+// Rekey performs a new key exchange with the gateway, authenticated using
+// the client's current shared keys. It returns a copy of the keyring which
+// contains the new shared keys. The previous keys remain in the keyring until
+// they expire, so that they can still be used if the new keyring cannot be
+// persisted.
+func Rekey(
+	ctx context.Context,
+	client clients.GatewayHTTPClient,
+	kr keyring.Keyring,
+) (keyring.Keyring, error) {
+	ekp := ecdh.NewEphemeralKeyPair()
+	rekeyReq, err := json.Marshal(RekeyRequest{
+		ClientPubKey: ekp.PublicKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	code, body, err := client.Post(ctx, RekeyPath).
+		Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON).
+		Body(rekeyReq).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+	if code != http.StatusOK {
+		return nil, fmt.Errorf("%w: %s", ErrRekeyFailed, http.StatusText(code))
+	}
+	var rekeyResp RekeyResponse
+	if err := json.Unmarshal(body, &rekeyResp); err != nil {
+		return nil, err
+	}
+	sharedSecret, err := ecdh.DeriveSharedSecret(ekp, ecdh.PeerPublicKey{
+		PublicKey: rekeyResp.ServerPubKey,
+		PeerType:  ecdh.PeerTypeServer,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keyring.Rotate(kr, keyring.NewSharedKeys(sharedSecret), rekeyResp.ExpiresAt), nil
+}
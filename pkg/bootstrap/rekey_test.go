@@ -0,0 +1,225 @@
+package bootstrap_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/bootstrap"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/ecdh"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Rekey", Ordered, Label(test.Unit, test.Slow), func() {
+	var broker storage.KeyringStoreBroker
+	var gatewayStore storage.KeyringStore
+	var agentKeyring keyring.Keyring
+	var pkpKey *keyring.PKPKey
+	var address string
+	ref := &core.Reference{
+		Id: "foo",
+	}
+	newClient := func(kr keyring.Keyring) clients.GatewayHTTPClient {
+		client, err := clients.NewGatewayHTTPClient(address, test.NewTestIdentProvider(ctrl, ref.Id), kr)
+		Expect(err).NotTo(HaveOccurred())
+		return client
+	}
+	gatewaySharedKeys := func() []*keyring.SharedKeys {
+		kr, err := gatewayStore.Get(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		var keys []*keyring.SharedKeys
+		kr.Try(func(sk *keyring.SharedKeys) {
+			keys = append(keys, sk)
+		})
+		return keys
+	}
+
+	BeforeAll(func() {
+		crt, err := tls.X509KeyPair(test.TestData("self_signed_leaf.crt"), test.TestData("self_signed_leaf.key"))
+		Expect(err).NotTo(HaveOccurred())
+		crt.Leaf, err = x509.ParseCertificate(crt.Certificate[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		broker = test.NewTestKeyringStoreBroker(ctrl)
+		gatewayStore, err = broker.KeyringStore(context.Background(), "gateway", ref)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Simulate a completed bootstrap
+		clientKp := ecdh.NewEphemeralKeyPair()
+		serverKp := ecdh.NewEphemeralKeyPair()
+		secret, err := ecdh.DeriveSharedSecret(serverKp, ecdh.PeerPublicKey{
+			PublicKey: clientKp.PublicKey,
+			PeerType:  ecdh.PeerTypeClient,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayStore.Put(context.Background(), keyring.New(keyring.NewSharedKeys(secret)))).To(Succeed())
+		pkpKey = keyring.NewPKPKey([]*pkp.PublicKeyPin{pkp.NewSha256(crt.Leaf)})
+		agentKeyring = keyring.New(keyring.NewSharedKeys(secret), pkpKey)
+
+		app := fiber.New(fiber.Config{
+			DisableStartupMessage: true,
+		})
+		cm, err := cluster.New(broker, "X-Test")
+		Expect(err).NotTo(HaveOccurred())
+		handler := bootstrap.RekeyServerConfig{
+			KeyringStoreBroker: broker,
+			GracePeriod:        time.Minute,
+		}.Handle
+		app.Get(bootstrap.RekeyPath, cm.Handle, handler)
+		app.Post(bootstrap.RekeyPath, cm.Handle, handler)
+
+		listener, err := tls.Listen("tcp4", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{crt},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		address = "https://" + listener.Addr().String()
+		go app.Listener(listener)
+		DeferCleanup(app.Shutdown)
+	})
+
+	It("should not require rotation of current keys", func() {
+		required, err := bootstrap.KeyRotationRequired(context.Background(), newClient(agentKeyring))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(required).To(BeFalse())
+	})
+	It("should perform a new key exchange", func() {
+		oldClient := newClient(agentKeyring)
+		kr, err := bootstrap.Rekey(context.Background(), oldClient, agentKeyring)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("ensuring both sides keep the previous keys during the grace period")
+		keys := gatewaySharedKeys()
+		Expect(keys).To(HaveLen(2))
+		agentKeys := 0
+		kr.Try(func(sk *keyring.SharedKeys) {
+			agentKeys++
+		})
+		Expect(agentKeys).To(Equal(2))
+
+		By("ensuring the agent and gateway derived the same new keys")
+		current, ok := keyring.CurrentSharedKeys(kr)
+		Expect(ok).To(BeTrue())
+		Expect(current.ExpiresAt).To(BeNil())
+		found := false
+		for _, sk := range keys {
+			if sk.ExpiresAt == nil {
+				found = true
+				Expect(sk.ClientKey.Equal(current.ClientKey)).To(BeTrue())
+				Expect(sk.ServerKey.Equal(current.ServerKey)).To(BeTrue())
+			} else {
+				Expect(*sk.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Minute), 10*time.Second))
+			}
+		}
+		Expect(found).To(BeTrue())
+
+		By("ensuring requests can be authenticated with both the old and new keys")
+		newClient := newClient(kr)
+		required, err := bootstrap.KeyRotationRequired(context.Background(), newClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(required).To(BeFalse())
+		required, err = bootstrap.KeyRotationRequired(context.Background(), oldClient)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(required).To(BeTrue())
+
+		By("ensuring the previous keys cannot be used to rekey")
+		_, err = bootstrap.Rekey(context.Background(), oldClient, agentKeyring)
+		Expect(err).To(MatchError(bootstrap.ErrRekeyFailed))
+		Expect(gatewaySharedKeys()).To(Equal(keys))
+
+		agentKeyring = kr
+	})
+	When("the cluster has agents for multiple capabilities", func() {
+		It("should only rotate the keys of the agent which requested it", func() {
+			By("adding keys for a second capability")
+			secret, err := ecdh.DeriveSharedSecret(ecdh.NewEphemeralKeyPair(), ecdh.PeerPublicKey{
+				PublicKey: ecdh.NewEphemeralKeyPair().PublicKey,
+				PeerType:  ecdh.PeerTypeClient,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			otherKeys := keyring.NewSharedKeys(secret)
+			_, err = gatewayStore.Update(context.Background(), func(kr keyring.Keyring) (keyring.Keyring, error) {
+				return kr.Merge(keyring.New(otherKeys)), nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			otherKeyring := keyring.New(otherKeys, pkpKey)
+
+			By("rotating the second agent's keys")
+			otherKeyring, err = bootstrap.Rekey(context.Background(), newClient(otherKeyring), otherKeyring)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("ensuring the first agent's keys are unchanged")
+			current, ok := keyring.CurrentSharedKeys(agentKeyring)
+			Expect(ok).To(BeTrue())
+			for _, sk := range gatewaySharedKeys() {
+				if sk.ClientKey.Equal(current.ClientKey) {
+					Expect(sk.ExpiresAt).To(BeNil())
+					Expect(sk.Replaced).To(BeFalse())
+				}
+			}
+			required, err := bootstrap.KeyRotationRequired(context.Background(), newClient(agentKeyring))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(BeFalse())
+
+			By("rotating the first agent's keys")
+			agentKeyring, err = bootstrap.Rekey(context.Background(), newClient(agentKeyring), agentKeyring)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("ensuring both agents can rotate their keys after all keys are scheduled for rotation")
+			_, err = gatewayStore.Update(context.Background(), func(kr keyring.Keyring) (keyring.Keyring, error) {
+				return keyring.Expire(kr, time.Now().Add(time.Hour)), nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			for _, kr := range []*keyring.Keyring{&agentKeyring, &otherKeyring} {
+				required, err := bootstrap.KeyRotationRequired(context.Background(), newClient(*kr))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(required).To(BeTrue())
+				*kr, err = bootstrap.Rekey(context.Background(), newClient(*kr), *kr)
+				Expect(err).NotTo(HaveOccurred())
+				required, err = bootstrap.KeyRotationRequired(context.Background(), newClient(*kr))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(required).To(BeFalse())
+			}
+		})
+	})
+	When("the gateway has scheduled the keys for rotation", func() {
+		It("should require rotation", func() {
+			kr, err := gatewayStore.Get(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gatewayStore.Put(context.Background(), keyring.Expire(kr, time.Now().Add(time.Hour)))).To(Succeed())
+
+			client := newClient(agentKeyring)
+			required, err := bootstrap.KeyRotationRequired(context.Background(), client)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(BeTrue())
+
+			By("rotating the keys")
+			kr, err = bootstrap.Rekey(context.Background(), client, agentKeyring)
+			Expect(err).NotTo(HaveOccurred())
+			required, err = bootstrap.KeyRotationRequired(context.Background(), newClient(kr))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(required).To(BeFalse())
+		})
+	})
+	When("the keys have expired", func() {
+		It("should reject requests", func() {
+			kr, err := gatewayStore.Get(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(gatewayStore.Put(context.Background(), keyring.Expire(kr, time.Now()))).To(Succeed())
+
+			_, err = bootstrap.KeyRotationRequired(context.Background(), newClient(agentKeyring))
+			Expect(err).To(MatchError(ContainSubstring(http.StatusText(http.StatusUnauthorized))))
+		})
+	})
+})
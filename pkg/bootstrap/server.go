@@ -4,20 +4,27 @@ import (
 	"context"
 	"crypto"
 	"crypto/tls"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/lestrrat-go/jwx/jwa"
 	"github.com/lestrrat-go/jwx/jws"
+	"github.com/rancher/opni-monitoring/pkg/audit"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/ecdh"
 	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/logger"
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/tokens"
 	"github.com/rancher/opni-monitoring/pkg/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ServerConfig struct {
@@ -26,6 +33,11 @@ type ServerConfig struct {
 	ClusterStore        storage.ClusterStore
 	KeyringStoreBroker  storage.KeyringStoreBroker
 	CapabilityInstaller capabilities.Installer
+	// Optional audit log in which successful bootstrap requests are recorded.
+	AuditLog *audit.Log
+	// If true, new clusters are created pending approval, and capabilities
+	// are not installed until the cluster is approved.
+	RequireApproval bool
 }
 
 func (h ServerConfig) bootstrapJoinResponse(
@@ -36,7 +48,7 @@ func (h ServerConfig) bootstrapJoinResponse(
 	if err != nil {
 		return BootstrapJoinResponse{}, err
 	}
-	for _, token := range tokenList {
+	for _, token := range tokenList.Items {
 		// Generate a JWS containing the signature of the detached secret token
 		rawToken, err := tokens.FromBootstrapToken(token)
 		if err != nil {
@@ -53,6 +65,32 @@ func (h ServerConfig) bootstrapJoinResponse(
 	}, nil
 }
 
+// Errors returned by the bootstrap server. These are gRPC status errors, so
+// they are received unchanged by gRPC clients and can be compared using
+// errors.Is. HTTP clients receive the equivalent HTTP status code and the
+// error message in the response body.
+var (
+	ErrNotAcceptingRequests       = status.Error(codes.Unavailable, "server is not accepting bootstrap requests")
+	ErrInvalidToken               = status.Error(codes.Unauthenticated, "invalid bootstrap token")
+	ErrTokenUsageLimitReached     = status.Error(codes.PermissionDenied, "token has reached its maximum number of uses")
+	ErrTokenAddressNotAllowed     = status.Error(codes.PermissionDenied, "token cannot be used from this address")
+	ErrClusterPendingApproval     = status.Error(codes.FailedPrecondition, "cluster is pending approval")
+	ErrClusterRejected            = status.Error(codes.PermissionDenied, "cluster has been rejected")
+	ErrCapabilityAlreadyInstalled = status.Error(codes.AlreadyExists, "capability is already installed on this cluster")
+	ErrInsufficientPermissions    = status.Error(codes.PermissionDenied, "insufficient permissions for this cluster")
+)
+
+var bootstrapLog = logger.New().Named("bootstrap")
+
+// callerInfo describes the client which sent a bootstrap request, independent
+// of the transport used.
+type callerInfo struct {
+	Operation    string
+	Address      string
+	UserAgent    string
+	ForwardedFor string
+}
+
 func (h ServerConfig) Handle(c *fiber.Ctx) error {
 	switch c.Path() {
 	case "/bootstrap/join":
@@ -66,23 +104,17 @@ func (h ServerConfig) Handle(c *fiber.Ctx) error {
 
 func (h ServerConfig) handleBootstrapJoin(c *fiber.Ctx) error {
 	authHeader := strings.TrimSpace(c.Get("Authorization"))
-	if authHeader == "" {
-		if resp, err := h.bootstrapJoinResponse(context.Background()); err != nil {
-			return c.SendStatus(fiber.StatusInternalServerError)
-		} else {
-			if len(resp.Signatures) == 0 {
-				// No tokens - server is not accepting bootstrap requests
-				return c.SendStatus(fiber.StatusMethodNotAllowed)
-			}
-			return c.Status(fiber.StatusOK).JSON(resp)
-		}
-	} else {
+	if authHeader != "" {
 		return c.SendStatus(fiber.StatusBadRequest)
 	}
+	resp, err := h.join(context.Background())
+	if err != nil {
+		return sendError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
 }
 
 func (h ServerConfig) handleBootstrapAuth(c *fiber.Ctx) error {
-	lg := c.Context().Logger()
 	authHeader := strings.TrimSpace(c.Get("Authorization"))
 	if strings.TrimSpace(authHeader) == "" {
 		return c.SendStatus(fiber.StatusUnauthorized)
@@ -90,11 +122,83 @@ func (h ServerConfig) handleBootstrapAuth(c *fiber.Ctx) error {
 	// Authorization is given, check the authToken
 	// Remove "Bearer " from the header
 	bearerToken := strings.TrimSpace(strings.TrimPrefix(authHeader, "Bearer"))
+
+	bootstrapToken, err := h.verifyToken(context.Background(), []byte(bearerToken), c.IP())
+	if err != nil {
+		return sendError(c, err)
+	}
+
+	clientReq := BootstrapAuthRequest{}
+	if err := c.BodyParser(&clientReq); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid request body")
+	}
+
+	resp, err := h.auth(context.Background(), bootstrapToken, clientReq, callerInfo{
+		Operation:    c.Path(),
+		Address:      c.IP(),
+		UserAgent:    c.Get(fiber.HeaderUserAgent),
+		ForwardedFor: c.Get(fiber.HeaderXForwardedFor),
+	})
+	if err != nil {
+		return sendError(c, err)
+	}
+	return c.Status(fiber.StatusOK).JSON(resp)
+}
+
+// sendError writes the HTTP equivalent of a bootstrap server error.
+func sendError(c *fiber.Ctx, err error) error {
+	code := fiber.StatusInternalServerError
+	switch {
+	case errors.Is(err, ErrNotAcceptingRequests):
+		code = fiber.StatusMethodNotAllowed
+	case errors.Is(err, ErrInsufficientPermissions):
+		// kept for compatibility with existing clients
+		code = fiber.StatusUnauthorized
+	default:
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			code = fiber.StatusBadRequest
+		case codes.Unauthenticated:
+			code = fiber.StatusUnauthorized
+		case codes.PermissionDenied:
+			code = fiber.StatusForbidden
+		case codes.NotFound:
+			code = fiber.StatusNotFound
+		case codes.AlreadyExists, codes.FailedPrecondition:
+			code = fiber.StatusConflict
+		case codes.Unavailable:
+			code = fiber.StatusServiceUnavailable
+		}
+	}
+	return c.Status(code).SendString(status.Convert(err).Message())
+}
+
+// join returns the server's signatures of all known bootstrap tokens.
+func (h ServerConfig) join(ctx context.Context) (*BootstrapJoinResponse, error) {
+	resp, err := h.bootstrapJoinResponse(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(resp.Signatures) == 0 {
+		// No tokens - server is not accepting bootstrap requests
+		return nil, ErrNotAcceptingRequests
+	}
+	return &resp, nil
+}
+
+// verifyToken verifies the complete JWS sent by the client, and checks that
+// the bootstrap token it contains can be used by a client with the given
+// address.
+func (h ServerConfig) verifyToken(
+	ctx context.Context,
+	bearerToken []byte,
+	address string,
+) (*core.BootstrapToken, error) {
 	// Verify the token
 	privKey := h.Certificate.PrivateKey.(crypto.Signer)
-	payload, err := jws.Verify([]byte(bearerToken), jwa.EdDSA, privKey.Public())
+	payload, err := jws.Verify(bearerToken, jwa.EdDSA, privKey.Public())
 	if err != nil {
-		return c.SendStatus(fiber.StatusUnauthorized)
+		return nil, ErrInvalidToken
 	}
 
 	// The payload should contain the entire token encoded as JSON
@@ -102,22 +206,33 @@ func (h ServerConfig) handleBootstrapAuth(c *fiber.Ctx) error {
 	if err != nil {
 		panic("bug: jws.Verify returned a malformed token")
 	}
-	bootstrapToken, err := h.TokenStore.GetToken(context.Background(), token.Reference())
+	bootstrapToken, err := h.TokenStore.GetToken(ctx, token.Reference())
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
-			return c.SendStatus(fiber.StatusUnauthorized)
+			return nil, ErrInvalidToken
 		}
-		lg.Printf("error checking if token exists: %v")
-		return c.SendStatus(fiber.StatusInternalServerError)
+		bootstrapLog.Errorf("error checking if token exists: %v", err)
+		return nil, status.Error(codes.Internal, "error checking if token exists")
 	}
 
-	// Token is valid and not expired. Check the client's requested UUID
-	clientReq := BootstrapAuthRequest{}
-	if err := c.BodyParser(&clientReq); err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString("Invalid request body")
+	if err := checkTokenLimits(bootstrapToken, address); err != nil {
+		return nil, err
 	}
+	return bootstrapToken, nil
+}
+
+// auth creates or edits the cluster requested by a client with a verified
+// bootstrap token, and completes the key exchange.
+func (h ServerConfig) auth(
+	ctx context.Context,
+	bootstrapToken *core.BootstrapToken,
+	clientReq BootstrapAuthRequest,
+	caller callerInfo,
+) (*BootstrapAuthResponse, error) {
+	lg := bootstrapLog
+	// Token is valid and not expired. Check the client's requested UUID
 	if err := validation.Validate(clientReq); err != nil {
-		return c.Status(fiber.StatusBadRequest).SendString(err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	// If the cluster with the requested ID does not exist, it can be created
@@ -129,23 +244,27 @@ func (h ServerConfig) handleBootstrapAuth(c *fiber.Ctx) error {
 	}
 	var shouldEditExisting bool
 
-	if cluster, err := h.ClusterStore.GetCluster(context.Background(), existing); err != nil {
+	if cluster, err := h.ClusterStore.GetCluster(ctx, existing); err != nil {
 		if !errors.Is(err, storage.ErrNotFound) {
-			lg.Printf("error checking if cluster exists: %v", err)
-			return c.SendStatus(fiber.StatusInternalServerError)
+			lg.Errorf("error checking if cluster exists: %v", err)
+			return nil, status.Error(codes.Internal, "error checking if cluster exists")
 		}
 	} else {
+		switch cluster.GetMetadata().GetApproval().GetState() {
+		case core.ApprovalState_ClusterPendingApproval:
+			return nil, ErrClusterPendingApproval
+		case core.ApprovalState_ClusterRejected:
+			return nil, ErrClusterRejected
+		}
 		if capabilities.Has(cluster, capabilities.Cluster(clientReq.Capability)) {
-			return c.Status(fiber.StatusConflict).
-				SendString("Capability is already installed on this cluster")
+			return nil, ErrCapabilityAlreadyInstalled
 		}
 
 		// the cluster capability is new, check if the token can edit it
 		if capabilities.Has(bootstrapToken, capabilities.JoinExistingCluster.For(existing)) {
 			shouldEditExisting = true
 		} else {
-			return c.Status(fiber.StatusUnauthorized).
-				SendString("Insufficient permissions for this cluster")
+			return nil, ErrInsufficientPermissions
 		}
 	}
 
@@ -155,27 +274,32 @@ func (h ServerConfig) handleBootstrapAuth(c *fiber.Ctx) error {
 		PeerType:  ecdh.PeerTypeClient,
 	})
 	if err != nil {
-		lg.Printf("error computing shared secret: %v", err)
-		return c.SendStatus(fiber.StatusInternalServerError)
+		lg.Errorf("error computing shared secret: %v", err)
+		return nil, status.Error(codes.Internal, "error computing shared secret")
 	}
 	kr := keyring.New(keyring.NewSharedKeys(sharedSecret))
 
 	// Check if the capability exists and can be installed
 	if err := h.CapabilityInstaller.CanInstall(clientReq.Capability); err != nil {
 		if errors.Is(err, capabilities.ErrUnknownCapability) {
-			lg.Printf("unknown capability: %s", clientReq.Capability)
-			return c.Status(fiber.StatusNotFound).
-				SendString(fmt.Sprintf("Unknown capability %s", clientReq.Capability))
+			lg.Warnf("unknown capability: %s", clientReq.Capability)
+			return nil, status.Errorf(codes.NotFound, "Unknown capability %s", clientReq.Capability)
 		}
-		lg.Printf("capability cannot be installed: %v", err)
-		return c.Status(fiber.StatusServiceUnavailable).
-			SendString(fmt.Sprintf("Capability cannot be installed: %v", err))
+		lg.Warnf("capability cannot be installed: %v", err)
+		return nil, status.Errorf(codes.Unavailable, "Capability cannot be installed: %v", err)
+	}
+
+	// The token is consumed before any changes are made, so that concurrent
+	// requests cannot exceed its usage limits
+	if err := h.consumeToken(ctx, bootstrapToken); err != nil {
+		return nil, err
 	}
 
 	if shouldEditExisting {
-		if err := h.handleEdit(existing, clientReq.Capability, bootstrapToken, kr); err != nil {
-			lg.Printf("error editing cluster capabilities: %v", err)
-			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+		if err := h.handleEdit(existing, clientReq.Capability, kr); err != nil {
+			lg.Errorf("error editing cluster capabilities: %v", err)
+			h.releaseToken(ctx, bootstrapToken)
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 	} else {
 		newCluster := &core.Cluster{
@@ -189,15 +313,141 @@ func (h ServerConfig) handleBootstrapAuth(c *fiber.Ctx) error {
 				},
 			},
 		}
+		if h.RequireApproval {
+			// The capability is added to the cluster once it is approved
+			newCluster.Metadata.Capabilities = nil
+			newCluster.Metadata.Approval = &core.ClusterApproval{
+				State:               core.ApprovalState_ClusterPendingApproval,
+				RequestedCapability: clientReq.Capability,
+				TokenID:             bootstrapToken.GetTokenID(),
+				Address:             caller.Address,
+				RequestTimestamp:    timestamppb.Now(),
+			}
+		}
 		if err := h.handleCreate(newCluster, clientReq.Capability, bootstrapToken, kr); err != nil {
-			lg.Printf("error creating cluster: %v", err)
-			return c.Status(fiber.StatusInternalServerError).SendString(err.Error())
+			lg.Errorf("error creating cluster: %v", err)
+			h.releaseToken(ctx, bootstrapToken)
+			return nil, status.Error(codes.Internal, err.Error())
 		}
 	}
 
-	return c.Status(fiber.StatusOK).JSON(BootstrapAuthResponse{
+	h.recordBootstrap(ctx, caller, bootstrapToken, clientReq)
+
+	// One-time tokens have already been consumed, and cannot be used again
+	if bootstrapToken.GetMetadata().GetLimits().GetOneTime() {
+		if err := h.TokenStore.DeleteToken(ctx, bootstrapToken.Reference()); err != nil {
+			lg.Errorf("error deleting one-time token: %v", err)
+		}
+	}
+
+	return &BootstrapAuthResponse{
 		ServerPubKey: ekp.PublicKey,
+	}, nil
+}
+
+// checkTokenLimits checks whether the token can be used by a client with the
+// given address. The usage limit is enforced again when the token is
+// consumed.
+func checkTokenLimits(token *core.BootstrapToken, address string) error {
+	if usageLimitReached(token) {
+		return ErrTokenUsageLimitReached
+	}
+	limits := token.GetMetadata().GetLimits()
+	if len(limits.GetAllowedCIDRs()) > 0 {
+		ip := net.ParseIP(address)
+		for _, cidr := range limits.GetAllowedCIDRs() {
+			if _, ipNet, err := net.ParseCIDR(cidr); err == nil && ip != nil && ipNet.Contains(ip) {
+				return nil
+			}
+		}
+		return ErrTokenAddressNotAllowed
+	}
+	return nil
+}
+
+// usageLimitReached returns true if the token cannot be used again. One-time
+// tokens can be used once.
+func usageLimitReached(token *core.BootstrapToken) bool {
+	limits := token.GetMetadata().GetLimits()
+	max := limits.GetMaxUsages()
+	if limits.GetOneTime() {
+		max = 1
+	}
+	return max > 0 && token.GetMetadata().GetUsageCount() >= max
+}
+
+// consumeToken increments the usage count of the token, failing if its usage
+// limit has already been reached. The limit is checked within the mutator,
+// so that it is enforced atomically with the increment.
+func (h ServerConfig) consumeToken(ctx context.Context, token *core.BootstrapToken) error {
+	var limitReached bool
+	_, err := h.TokenStore.UpdateToken(ctx, token.Reference(), func(tk *core.BootstrapToken) {
+		// The mutator is called again if the token was modified concurrently
+		limitReached = usageLimitReached(tk)
+		if !limitReached {
+			tk.Metadata.UsageCount++
+		}
 	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			// One-time tokens are deleted after they are used
+			return ErrInvalidToken
+		}
+		bootstrapLog.Errorf("error incrementing usage count: %v", err)
+		return status.Error(codes.Internal, "error incrementing usage count")
+	}
+	if limitReached {
+		return ErrTokenUsageLimitReached
+	}
+	return nil
+}
+
+// releaseToken reverts a call to consumeToken if bootstrapping fails, so that
+// the failed attempt does not count towards the token's usage limit.
+func (h ServerConfig) releaseToken(ctx context.Context, token *core.BootstrapToken) {
+	_, err := h.TokenStore.UpdateToken(ctx, token.Reference(), func(tk *core.BootstrapToken) {
+		if tk.Metadata.UsageCount > 0 {
+			tk.Metadata.UsageCount--
+		}
+	})
+	if err != nil {
+		bootstrapLog.Errorf("error releasing token: %v", err)
+	}
+}
+
+func (h ServerConfig) recordBootstrap(
+	ctx context.Context,
+	caller callerInfo,
+	token *core.BootstrapToken,
+	req BootstrapAuthRequest,
+) {
+	if h.AuditLog == nil {
+		return
+	}
+	lg := bootstrapLog
+	// The client's public key is omitted
+	request, err := json.Marshal(map[string]string{
+		"client_id":  req.ClientID,
+		"capability": req.Capability,
+	})
+	if err != nil {
+		lg.Errorf("error encoding audit event: %v", err)
+		return
+	}
+	event := &core.AuditEvent{
+		Operation: caller.Operation,
+		Caller: &core.AuditCaller{
+			Address:      caller.Address,
+			UserAgent:    caller.UserAgent,
+			ForwardedFor: caller.ForwardedFor,
+			TokenID:      token.GetTokenID(),
+		},
+		Request: string(request),
+		Result:  audit.NewResult(nil),
+	}
+	if err := h.AuditLog.Record(ctx, event); err != nil {
+		lg.Errorf("error recording audit event: %v", err)
+	}
 }
 
 func (h ServerConfig) handleCreate(
@@ -210,16 +460,13 @@ func (h ServerConfig) handleCreate(
 		return fmt.Errorf("error creating cluster: %w", err)
 	}
 	_, err := h.TokenStore.UpdateToken(context.Background(), token.Reference(),
-		storage.NewCompositeMutator(
-			storage.NewIncrementUsageCountMutator(),
-			storage.NewAddCapabilityMutator[*core.BootstrapToken](&core.TokenCapability{
-				Type:      string(capabilities.JoinExistingCluster),
-				Reference: newCluster.Reference(),
-			}),
-		),
+		storage.NewAddCapabilityMutator[*core.BootstrapToken](&core.TokenCapability{
+			Type:      string(capabilities.JoinExistingCluster),
+			Reference: newCluster.Reference(),
+		}),
 	)
 	if err != nil {
-		return fmt.Errorf("error incrementing usage count: %w", err)
+		return fmt.Errorf("error updating token capabilities: %w", err)
 	}
 	krStore, err := h.KeyringStoreBroker.KeyringStore(context.Background(), "gateway", newCluster.Reference())
 	if err != nil {
@@ -228,6 +475,9 @@ func (h ServerConfig) handleCreate(
 	if err := krStore.Put(context.Background(), kr); err != nil {
 		return fmt.Errorf("error storing keyring: %w", err)
 	}
+	if newCluster.GetMetadata().GetApproval().GetState() == core.ApprovalState_ClusterPendingApproval {
+		return nil
+	}
 	h.CapabilityInstaller.InstallCapabilities(newCluster.Reference(), newCapability)
 	return nil
 }
@@ -235,15 +485,9 @@ func (h ServerConfig) handleCreate(
 func (h ServerConfig) handleEdit(
 	existingCluster *core.Reference,
 	newCapability string,
-	token *core.BootstrapToken,
-	keyring keyring.Keyring,
+	kr keyring.Keyring,
 ) error {
-	_, err := h.TokenStore.UpdateToken(context.Background(), token.Reference(),
-		storage.NewIncrementUsageCountMutator())
-	if err != nil {
-		return fmt.Errorf("error incrementing usage count: %w", err)
-	}
-	_, err = h.ClusterStore.UpdateCluster(context.Background(), existingCluster,
+	_, err := h.ClusterStore.UpdateCluster(context.Background(), existingCluster,
 		storage.NewAddCapabilityMutator[*core.Cluster](capabilities.Cluster(newCapability)),
 	)
 	if err != nil {
@@ -253,12 +497,11 @@ func (h ServerConfig) handleEdit(
 	if err != nil {
 		return fmt.Errorf("error getting keyring store: %w", err)
 	}
-	kr, err := krStore.Get(context.Background())
+	_, err = krStore.Update(context.Background(), func(existing keyring.Keyring) (keyring.Keyring, error) {
+		return existing.Merge(kr), nil
+	})
 	if err != nil {
-		return fmt.Errorf("error getting existing keyring: %w", err)
-	}
-	if err := krStore.Put(context.Background(), keyring.Merge(kr)); err != nil {
-		return fmt.Errorf("error storing keyring: %w", err)
+		return fmt.Errorf("error updating keyring: %w", err)
 	}
 	h.CapabilityInstaller.InstallCapabilities(existingCluster, newCapability)
 	return nil
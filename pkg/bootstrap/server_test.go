@@ -6,9 +6,11 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/gofiber/fiber/v2"
@@ -16,8 +18,10 @@ import (
 	"github.com/lestrrat-go/jwx/jws"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/samber/lo"
 	"github.com/valyala/fasthttp/fasthttputil"
 
+	"github.com/rancher/opni-monitoring/pkg/audit"
 	"github.com/rancher/opni-monitoring/pkg/bootstrap"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/core"
@@ -33,6 +37,16 @@ type testCapBackend struct {
 	CanInstall bool
 }
 
+type slowClusterStore struct {
+	storage.ClusterStore
+	delay time.Duration
+}
+
+func (s slowClusterStore) CreateCluster(ctx context.Context, cluster *core.Cluster) error {
+	time.Sleep(s.delay)
+	return s.ClusterStore.CreateCluster(ctx, cluster)
+}
+
 var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 	var token *core.BootstrapToken
 	var token2 *core.BootstrapToken
@@ -44,6 +58,9 @@ var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 	var mockClusterStore storage.ClusterStore
 	var mockKeyringStoreBroker storage.KeyringStoreBroker
 	var testCapBackends []*test.CapabilityInfo
+	var auditLog *audit.Log
+	var requireApproval bool
+	var createClusterDelay time.Duration
 
 	BeforeEach(func() {
 		testCapBackends = append(testCapBackends, &test.CapabilityInfo{
@@ -55,6 +72,7 @@ var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 		mockTokenStore = test.NewTestTokenStore(ctx, ctrl)
 		mockClusterStore = test.NewTestClusterStore(ctrl)
 		mockKeyringStoreBroker = test.NewTestKeyringStoreBroker(ctrl)
+		auditLog = audit.NewLog(test.NewTestKeyValueStore(ctrl))
 
 		token, _ = mockTokenStore.CreateToken(context.Background(), 1*time.Hour,
 			storage.WithLabels(map[string]string{"foo": "bar"}),
@@ -63,6 +81,8 @@ var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 	})
 	AfterEach(func() {
 		testCapBackends = []*test.CapabilityInfo{}
+		requireApproval = false
+		createClusterDelay = 0
 	})
 	JustBeforeEach(func() {
 		var err error
@@ -84,8 +104,13 @@ var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 			CapabilityInstaller: capBackendStore,
 			Certificate:         cert,
 			TokenStore:          mockTokenStore,
-			ClusterStore:        mockClusterStore,
-			KeyringStoreBroker:  mockKeyringStoreBroker,
+			ClusterStore: slowClusterStore{
+				ClusterStore: mockClusterStore,
+				delay:        createClusterDelay,
+			},
+			KeyringStoreBroker: mockKeyringStoreBroker,
+			AuditLog:           auditLog,
+			RequireApproval:    requireApproval,
 		}
 		app.Post("/bootstrap/*", server.Handle)
 		tlsConfig := &tls.Config{
@@ -235,6 +260,15 @@ var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 						Expect(cluster.GetCapabilities()).To(ContainElement(BeEquivalentTo(&core.ClusterCapability{
 							Name: "test",
 						})))
+
+						By("checking that the bootstrap request was audited")
+						events, err := auditLog.List(context.Background(), time.Time{}, time.Time{})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(events.Items).To(HaveLen(1))
+						Expect(events.Items[0].Operation).To(Equal("/bootstrap/auth"))
+						Expect(events.Items[0].Caller.TokenID).To(Equal(rawToken.HexID()))
+						Expect(events.Items[0].Request).To(ContainSubstring(`"client_id":"foo"`))
+						Expect(events.Items[0].Request).NotTo(ContainSubstring(string(ekp.PublicKey)))
 					})
 				})
 			})
@@ -418,6 +452,196 @@ var _ = Describe("Server", Label(test.Unit, test.Slow), func() {
 			})
 		})
 	})
+	When("the token has usage limits", func() {
+		bootstrapWith := func(tk *core.BootstrapToken, clientID string) *http.Response {
+			rawToken, err := tokens.FromBootstrapToken(tk)
+			Expect(err).NotTo(HaveOccurred())
+			jsonData, err := json.Marshal(rawToken)
+			Expect(err).NotTo(HaveOccurred())
+			sig, err := jws.Sign(jsonData, jwa.EdDSA, cert.PrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+			req, err := http.NewRequest("POST", *addr+"/bootstrap/auth", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", "Bearer "+string(sig))
+			authReq := bootstrap.BootstrapAuthRequest{
+				Capability:   "test",
+				ClientID:     clientID,
+				ClientPubKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			}
+			j, _ := json.Marshal(authReq)
+			req.Header.Set("Content-Type", "application/json")
+			req.Body = io.NopCloser(bytes.NewReader(j))
+			resp, err := client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			return resp
+		}
+		createToken := func(limits *core.TokenLimits) *core.BootstrapToken {
+			tk, err := mockTokenStore.CreateToken(context.Background(), time.Hour, storage.WithLimits(limits))
+			Expect(err).NotTo(HaveOccurred())
+			return tk
+		}
+		It("should reject tokens which have reached their maximum number of uses", func() {
+			tk := createToken(&core.TokenLimits{
+				MaxUsages: 2,
+			})
+			Expect(bootstrapWith(tk, "foo").StatusCode).To(Equal(http.StatusOK))
+			Expect(bootstrapWith(tk, "bar").StatusCode).To(Equal(http.StatusOK))
+			resp := bootstrapWith(tk, "baz")
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			body, _ := io.ReadAll(resp.Body)
+			Expect(string(body)).To(ContainSubstring("maximum number of uses"))
+		})
+		It("should delete one-time tokens after they are used", func() {
+			tk := createToken(&core.TokenLimits{
+				OneTime: true,
+			})
+			Expect(bootstrapWith(tk, "foo").StatusCode).To(Equal(http.StatusOK))
+			_, err := mockTokenStore.GetToken(context.Background(), tk.Reference())
+			Expect(err).To(MatchError(storage.ErrNotFound))
+			Expect(bootstrapWith(tk, "bar").StatusCode).To(Equal(http.StatusUnauthorized))
+		})
+		It("should not delete one-time tokens if bootstrapping fails", func() {
+			tk := createToken(&core.TokenLimits{
+				OneTime: true,
+			})
+			rawToken, err := tokens.FromBootstrapToken(tk)
+			Expect(err).NotTo(HaveOccurred())
+			jsonData, err := json.Marshal(rawToken)
+			Expect(err).NotTo(HaveOccurred())
+			sig, err := jws.Sign(jsonData, jwa.EdDSA, cert.PrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+			req, err := http.NewRequest("POST", *addr+"/bootstrap/auth", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", "Bearer "+string(sig))
+			j, _ := json.Marshal(bootstrap.BootstrapAuthRequest{
+				Capability:   "unknown",
+				ClientID:     "foo",
+				ClientPubKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			})
+			req.Header.Set("Content-Type", "application/json")
+			req.Body = io.NopCloser(bytes.NewReader(j))
+			resp, err := client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+			_, err = mockTokenStore.GetToken(context.Background(), tk.Reference())
+			Expect(err).NotTo(HaveOccurred())
+		})
+		When("requests are sent concurrently", func() {
+			BeforeEach(func() {
+				// Widen the window in which concurrent requests could race
+				createClusterDelay = 100 * time.Millisecond
+			})
+			It("should enforce usage limits", func() {
+				for i, limits := range []*core.TokenLimits{
+					{MaxUsages: 2},
+					{OneTime: true},
+				} {
+					tk := createToken(limits)
+					var wg sync.WaitGroup
+					var mu sync.Mutex
+					succeeded := 0
+					for j := 0; j < 10; j++ {
+						wg.Add(1)
+						go func(clientID string) {
+							defer GinkgoRecover()
+							defer wg.Done()
+							if bootstrapWith(tk, clientID).StatusCode == http.StatusOK {
+								mu.Lock()
+								succeeded++
+								mu.Unlock()
+							}
+						}(fmt.Sprintf("cluster-%d-%d", i, j))
+					}
+					wg.Wait()
+					Expect(succeeded).To(Equal(lo.Ternary(limits.OneTime, 1, int(limits.MaxUsages))))
+				}
+			})
+		})
+		It("should reject clients outside of the allowed address ranges", func() {
+			// The in-memory listener reports the client address as 0.0.0.0
+			tk := createToken(&core.TokenLimits{
+				AllowedCIDRs: []string{"10.0.0.0/8"},
+			})
+			resp := bootstrapWith(tk, "foo")
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			body, _ := io.ReadAll(resp.Body)
+			Expect(string(body)).To(ContainSubstring("cannot be used from this address"))
+
+			tk = createToken(&core.TokenLimits{
+				AllowedCIDRs: []string{"10.0.0.0/8", "0.0.0.0/32"},
+			})
+			Expect(bootstrapWith(tk, "foo").StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+	When("cluster approval is required", func() {
+		BeforeEach(func() {
+			requireApproval = true
+		})
+		newReq := func() *http.Request {
+			rawToken, err := tokens.FromBootstrapToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			jsonData, err := json.Marshal(rawToken)
+			Expect(err).NotTo(HaveOccurred())
+			sig, err := jws.Sign(jsonData, jwa.EdDSA, cert.PrivateKey)
+			Expect(err).NotTo(HaveOccurred())
+			req, err := http.NewRequest("POST", *addr+"/bootstrap/auth", nil)
+			Expect(err).NotTo(HaveOccurred())
+			req.Header.Add("Authorization", "Bearer "+string(sig))
+			authReq := bootstrap.BootstrapAuthRequest{
+				Capability:   "test",
+				ClientID:     "foo",
+				ClientPubKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			}
+			j, _ := json.Marshal(authReq)
+			req.Header.Set("Content-Type", "application/json")
+			req.Body = io.NopCloser(bytes.NewReader(j))
+			return req
+		}
+		It("should create a pending cluster without installing capabilities", func() {
+			resp, err := client.Do(newReq())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			cluster, err := mockClusterStore.GetCluster(context.Background(), &core.Reference{
+				Id: "foo",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cluster.GetCapabilities()).To(BeEmpty())
+			approval := cluster.GetMetadata().GetApproval()
+			Expect(approval.GetState()).To(Equal(core.ApprovalState_ClusterPendingApproval))
+			Expect(approval.GetRequestedCapability()).To(Equal("test"))
+			Expect(approval.GetTokenID()).To(Equal(token.GetTokenID()))
+			Expect(approval.GetRequestTimestamp()).NotTo(BeNil())
+
+			By("checking that the cluster's keyring was stored")
+			ks, err := mockKeyringStoreBroker.KeyringStore(context.Background(), "gateway", &core.Reference{
+				Id: "foo",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = ks.Get(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			By("checking that the pending cluster cannot be bootstrapped again")
+			resp, err = client.Do(newReq())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusConflict))
+		})
+		It("should not allow rejected clusters to be bootstrapped again", func() {
+			resp, err := client.Do(newReq())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			_, err = mockClusterStore.UpdateCluster(context.Background(), &core.Reference{
+				Id: "foo",
+			}, func(c *core.Cluster) {
+				c.Metadata.Approval.State = core.ApprovalState_ClusterRejected
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			resp, err = client.Do(newReq())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+		})
+	})
 	When("sending a request to an invalid path", func() {
 		It("should return http 404", func() {
 			req, err := http.NewRequest("POST", *addr+"/bootstrap/foo", nil)
@@ -2,9 +2,11 @@ package bootstrap
 
 import (
 	"context"
+	"time"
 
 	"github.com/rancher/opni-monitoring/pkg/ident"
 	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
 	"github.com/rancher/opni-monitoring/pkg/validation"
 )
 
@@ -27,6 +29,30 @@ type BootstrapAuthResponse struct {
 	ServerPubKey []byte `json:"server_pub_key"`
 }
 
+type RekeyStatusResponse struct {
+	// True if the keys used to authenticate the request are being rotated out.
+	RotationRequired bool `json:"rotation_required"`
+	// The time at which the keys used to authenticate the request expire.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+type RekeyRequest struct {
+	ClientPubKey []byte `json:"client_pub_key"`
+}
+
+type RekeyResponse struct {
+	ServerPubKey []byte `json:"server_pub_key"`
+	// The time at which the previous keys expire.
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (h RekeyRequest) Validate() error {
+	if len(h.ClientPubKey) == 0 {
+		return validation.Errorf("%w: %s", validation.ErrMissingRequiredField, "client_pub_key")
+	}
+	return nil
+}
+
 func (h BootstrapAuthRequest) Validate() error {
 	if h.ClientID == "" {
 		return validation.Errorf("%w: %s", validation.ErrMissingRequiredField, "client_id")
@@ -42,3 +68,10 @@ func (h BootstrapAuthRequest) Validate() error {
 	}
 	return nil
 }
+
+type PinsResponse struct {
+	// Pins for the certificate chain currently served by the gateway.
+	ActivePins []*pkp.PublicKeyPin `json:"active_pins"`
+	// Pins for certificate chains which will replace the current chain.
+	PendingPins []*pkp.PublicKeyPin `json:"pending_pins,omitempty"`
+}
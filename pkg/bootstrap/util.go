@@ -1,9 +1,13 @@
 package bootstrap
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 
+	"github.com/rancher/opni-monitoring/pkg/config/meta"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
 	"sigs.k8s.io/yaml"
 
@@ -56,3 +60,69 @@ func eraseBootstrapTokensFromConfig(
 	}
 	return nil
 }
+
+// Erases the bootstrap tokens from the agent config in a local config file.
+// Other documents in the file are left unchanged. The file is replaced
+// atomically and keeps its original permissions.
+func eraseBootstrapTokensFromFile(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	documents := bytes.Split(data, []byte("\n---\n"))
+	found := false
+	for i, document := range documents {
+		typeMeta := meta.TypeMeta{}
+		if err := yaml.Unmarshal(document, &typeMeta); err != nil {
+			continue
+		}
+		if typeMeta.APIVersion != v1beta1.APIVersion || typeMeta.Kind != "AgentConfig" {
+			continue
+		}
+		agentConfig := v1beta1.AgentConfig{}
+		if err := yaml.Unmarshal(document, &agentConfig); err != nil {
+			return err
+		}
+		agentConfig.Spec.Bootstrap = nil
+		document, err = yaml.Marshal(agentConfig)
+		if err != nil {
+			return err
+		}
+		documents[i] = bytes.TrimSuffix(document, []byte("\n"))
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("no agent config found in %s", path)
+	}
+	data = bytes.Join(documents, []byte("\n---\n"))
+	if !bytes.HasSuffix(data, []byte("\n")) {
+		data = append(data, '\n')
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to update agent config file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Chmod(info.Mode().Perm())
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update agent config file: %w", err)
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("failed to update agent config file: %w", err)
+	}
+	return nil
+}
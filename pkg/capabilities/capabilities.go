@@ -37,15 +37,17 @@ func (cb *capabilityBackend) CanInstall() error {
 	return err
 }
 
-func (cb *capabilityBackend) Install(cluster *core.Reference) error {
-	_, err := cb.client.Install(context.Background(), &capability.InstallRequest{
+func (cb *capabilityBackend) Install(ctx context.Context, cluster *core.Reference) error {
+	_, err := cb.client.Install(ctx, &capability.InstallRequest{
 		Cluster: cluster,
 	})
 	return err
 }
 
-func (cb *capabilityBackend) Uninstall() error {
-	_, err := cb.client.Uninstall(context.Background(), &emptypb.Empty{})
+func (cb *capabilityBackend) Uninstall(cluster *core.Reference) error {
+	_, err := cb.client.Uninstall(context.Background(), &capability.UninstallRequest{
+		Cluster: cluster,
+	})
 	return err
 }
 
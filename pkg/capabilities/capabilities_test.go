@@ -1,6 +1,8 @@
 package capabilities_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
@@ -18,7 +20,7 @@ var _ = Describe("Capabilities", func() {
 		backend := capabilities.NewBackend(client)
 		Expect(backend.InstallerTemplate()).To(Equal("foo"))
 		Expect(backend.CanInstall()).To(Succeed())
-		Expect(backend.Install(nil)).To(Succeed())
+		Expect(backend.Install(context.Background(), nil)).To(Succeed())
 
 		client = test.NewTestCapabilityBackendClient(ctrl, &test.CapabilityInfo{
 			Name:              "test2",
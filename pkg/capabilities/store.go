@@ -1,10 +1,14 @@
 package capabilities
 
 import (
+	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/plugins/apis/capability"
+	"github.com/rancher/opni-monitoring/pkg/storage"
 	"go.uber.org/zap"
 )
 
@@ -19,20 +23,98 @@ type BackendStore interface {
 	List() []string
 	RenderInstaller(name string, spec UserInstallerTemplateSpec) (string, error)
 	CanInstall(capabilities ...string) error
+	// Starts installing the capabilities on the cluster in the background.
+	// The progress of each installation can be checked using InstallStatus.
+	// Capabilities which are already being installed on the cluster are
+	// skipped.
 	InstallCapabilities(cluster *core.Reference, capabilities ...string)
+	// Uninstalls the capability from the cluster, and clears its install
+	// status. Any installation in progress is canceled first. Returns
+	// ErrBackendNotFound if the capability is not known.
+	UninstallCapability(cluster *core.Reference, capability string) error
+	// Cancels all installations in progress on the cluster, and waits for
+	// them to stop.
+	CancelInstalls(cluster *core.Reference)
+	// Returns the status of the most recent installation of the capability
+	// on the cluster, or storage.ErrNotFound if it has never been installed.
+	InstallStatus(cluster *core.Reference, capability string) (*core.CapabilityStatus, error)
+	// Restarts installations which were pending or running when the store
+	// was last shut down. This should be called after all backends have
+	// been added.
+	ResumeInstalls(ctx context.Context) error
+}
+
+type BackendStoreOptions struct {
+	statusStore        storage.KeyValueStore
+	maxInstallAttempts int
+	minRetryInterval   time.Duration
+	maxRetryInterval   time.Duration
+}
+
+type BackendStoreOption func(*BackendStoreOptions)
+
+func (o *BackendStoreOptions) Apply(opts ...BackendStoreOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithStatusStore persists install statuses in the given key-value store.
+// If not set, statuses are only kept in memory.
+func WithStatusStore(store storage.KeyValueStore) BackendStoreOption {
+	return func(o *BackendStoreOptions) {
+		o.statusStore = store
+	}
+}
+
+// WithMaxInstallAttempts sets the number of times an installation will be
+// attempted before it is marked as failed. Defaults to 5.
+func WithMaxInstallAttempts(attempts int) BackendStoreOption {
+	return func(o *BackendStoreOptions) {
+		o.maxInstallAttempts = attempts
+	}
+}
+
+// WithRetryInterval sets the bounds of the exponential backoff between
+// installation attempts. Defaults to 1 second and 1 minute.
+func WithRetryInterval(min, max time.Duration) BackendStoreOption {
+	return func(o *BackendStoreOptions) {
+		o.minRetryInterval = min
+		o.maxRetryInterval = max
+	}
 }
 
 type backendStore struct {
+	BackendStoreOptions
 	serverSpec ServerInstallerTemplateSpec
 	backends   map[string]capability.Backend
+	statuses   *statusTracker
 	logger     *zap.SugaredLogger
+
+	// Installations in progress, keyed by cluster ID and capability name
+	installsMu sync.Mutex
+	installs   map[string]*installTask
 }
 
-func NewBackendStore(serverSpec ServerInstallerTemplateSpec, logger *zap.SugaredLogger) BackendStore {
+func NewBackendStore(
+	serverSpec ServerInstallerTemplateSpec,
+	logger *zap.SugaredLogger,
+	opts ...BackendStoreOption,
+) BackendStore {
+	options := BackendStoreOptions{
+		maxInstallAttempts: 5,
+		minRetryInterval:   time.Second,
+		maxRetryInterval:   time.Minute,
+	}
+	options.Apply(opts...)
+
 	return &backendStore{
-		serverSpec: serverSpec,
-		backends:   make(map[string]capability.Backend),
-		logger:     logger,
+		BackendStoreOptions: options,
+		serverSpec:          serverSpec,
+		backends:            make(map[string]capability.Backend),
+		statuses:            newStatusTracker(options.statusStore),
+		logger:              logger,
+		installs:            map[string]*installTask{},
 	}
 }
 
@@ -94,28 +176,3 @@ func (s *backendStore) CanInstall(capabilities ...string) error {
 	}
 	return nil
 }
-
-func (s *backendStore) InstallCapabilities(
-	cluster *core.Reference,
-	capabilities ...string,
-) {
-	lg := s.logger.With(
-		"cluster", cluster.GetId(),
-	)
-	lg.With(
-		"capabilities", capabilities,
-	).Info("installing capabilities for cluster")
-
-	for _, capability := range capabilities {
-		backend := s.backends[capability]
-		// an installation can fail, but it is a fatal error. It is assumed that
-		// CanInstall() has already been called and did not return an error.
-		err := backend.Install(cluster)
-		if err != nil {
-			lg.With(
-				"capability", capability,
-				"error", err,
-			).Fatal("failed to install capability")
-		}
-	}
-}
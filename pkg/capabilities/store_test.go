@@ -1,12 +1,20 @@
 package capabilities_test
 
 import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/plugins/apis/capability"
+	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/test"
+	"google.golang.org/protobuf/proto"
 )
 
 var lg = test.Log
@@ -144,18 +152,190 @@ var _ = Describe("Store", Ordered, Label("unit"), func() {
 		Expect(store.CanInstall("capability3")).To(MatchError(capabilities.ErrUnknownCapability))
 	})
 
-	It("should install capabilities", func() {
-		backend1 := test.NewTestCapabilityBackend(ctrl, &test.CapabilityInfo{
-			Name:       "capability1",
-			CanInstall: true,
+	When("installing capabilities", func() {
+		var kvStore storage.KeyValueStore
+		var failures int32
+		var attempts int32
+		// If set, installations block until it is closed
+		var blockInstall chan struct{}
+		var hangInstall bool
+		newStore := func() capabilities.BackendStore {
+			return capabilities.NewBackendStore(capabilities.ServerInstallerTemplateSpec{}, lg,
+				capabilities.WithStatusStore(kvStore),
+				capabilities.WithMaxInstallAttempts(3),
+				capabilities.WithRetryInterval(10*time.Millisecond, 20*time.Millisecond),
+			)
+		}
+		newBackend := func() capability.Backend {
+			return test.NewTestCapabilityBackend(ctrl, &test.CapabilityInfo{
+				Name:       "capability1",
+				CanInstall: true,
+				OnInstall: func(ctx context.Context, _ *core.Reference) error {
+					atomic.AddInt32(&attempts, 1)
+					if blockInstall != nil {
+						<-blockInstall
+					}
+					if hangInstall {
+						<-ctx.Done()
+						return ctx.Err()
+					}
+					if atomic.LoadInt32(&failures) > 0 {
+						atomic.AddInt32(&failures, -1)
+						return errors.New("test error")
+					}
+					return nil
+				},
+			})
+		}
+		BeforeEach(func() {
+			kvStore = test.NewTestKeyValueStore(ctrl)
+			atomic.StoreInt32(&failures, 0)
+			atomic.StoreInt32(&attempts, 0)
+			blockInstall = nil
+			hangInstall = false
+			store = newStore()
+			Expect(store.Add("capability1", newBackend())).To(Succeed())
 		})
-		backend2 := test.NewTestCapabilityBackend(ctrl, &test.CapabilityInfo{
-			Name:       "capability2",
-			CanInstall: false,
+		getState := func(id string, name string) func() core.TaskState {
+			return func() core.TaskState {
+				status, err := store.InstallStatus(&core.Reference{Id: id}, name)
+				if err != nil {
+					return -1
+				}
+				return status.State
+			}
+		}
+
+		It("should install capabilities in the background", func() {
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskSucceeded))
+			status, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Attempts).To(BeEquivalentTo(1))
+			Expect(status.LastError).To(BeEmpty())
+			Expect(status.CreationTimestamp).NotTo(BeNil())
 		})
-		Expect(store.Add("capability1", backend1)).To(Succeed())
-		Expect(store.Add("capability2", backend2)).To(Succeed())
+		It("should retry failed installations", func() {
+			atomic.StoreInt32(&failures, 2)
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskSucceeded))
+			status, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Attempts).To(BeEquivalentTo(3))
+		})
+		It("should mark installations as failed after the maximum number of attempts", func() {
+			atomic.StoreInt32(&failures, 3)
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskFailed))
+			status, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.Attempts).To(BeEquivalentTo(3))
+			Expect(status.LastError).To(Equal("test error"))
+		})
+		It("should mark installations of unknown capabilities as failed", func() {
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability2")
+			Eventually(getState("foo", "capability2")).Should(Equal(core.TaskState_TaskFailed))
+			status, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status.LastError).To(ContainSubstring(capabilities.ErrBackendNotFound.Error()))
+		})
+		It("should return ErrNotFound for capabilities which were never installed", func() {
+			_, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
+		It("should clear the install status when uninstalling", func() {
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskSucceeded))
+			Expect(store.UninstallCapability(&core.Reference{Id: "foo"}, "capability1")).To(Succeed())
+			_, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).To(MatchError(storage.ErrNotFound))
+			_, err = kvStore.Get(context.Background(), "foo/capability1")
+			Expect(err).To(MatchError(storage.ErrNotFound))
+
+			err = store.UninstallCapability(&core.Reference{Id: "foo"}, "capability2")
+			Expect(err).To(MatchError(capabilities.ErrBackendNotFound))
+		})
+		It("should cancel pending installations when uninstalling", func() {
+			atomic.StoreInt32(&failures, 100)
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&attempts)
+			}).Should(BeNumerically(">=", 1))
+			Expect(store.UninstallCapability(&core.Reference{Id: "foo"}, "capability1")).To(Succeed())
+
+			n := atomic.LoadInt32(&attempts)
+			Consistently(func() int32 {
+				return atomic.LoadInt32(&attempts)
+			}, 200*time.Millisecond, 10*time.Millisecond).Should(Equal(n))
+			_, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
+		It("should wait for running installations to stop when canceling", func() {
+			blockInstall = make(chan struct{})
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskRunning))
+
+			canceled := make(chan struct{})
+			go func() {
+				defer close(canceled)
+				store.CancelInstalls(&core.Reference{Id: "foo"})
+			}()
+			Consistently(canceled, 50*time.Millisecond).ShouldNot(BeClosed())
+			close(blockInstall)
+			Eventually(canceled).Should(BeClosed())
+
+			// The result of the canceled installation is not recorded
+			Expect(getState("foo", "capability1")()).To(Equal(core.TaskState_TaskRunning))
+		})
+		It("should interrupt installations which do not complete when canceling", func() {
+			hangInstall = true
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskRunning))
 
-		store.InstallCapabilities(&core.Reference{}, "capability1", "capability2")
+			canceled := make(chan struct{})
+			go func() {
+				defer close(canceled)
+				Expect(store.UninstallCapability(&core.Reference{Id: "foo"}, "capability1")).To(Succeed())
+			}()
+			Eventually(canceled).Should(BeClosed())
+			_, err := store.InstallStatus(&core.Reference{Id: "foo"}, "capability1")
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
+		It("should not start duplicate installations", func() {
+			blockInstall = make(chan struct{})
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskRunning))
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			close(blockInstall)
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskSucceeded))
+			Expect(atomic.LoadInt32(&attempts)).To(BeEquivalentTo(1))
+
+			// Installations can be started again once the previous one is done
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(func() int32 {
+				return atomic.LoadInt32(&attempts)
+			}).Should(BeEquivalentTo(2))
+		})
+		It("should persist statuses and resume incomplete installations", func() {
+			status := &core.CapabilityStatus{
+				Name:    "capability1",
+				Cluster: &core.Reference{Id: "bar"},
+				State:   core.TaskState_TaskRunning,
+			}
+			data, err := proto.Marshal(status)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kvStore.Put(context.Background(), "bar/capability1", data)).To(Succeed())
+
+			store.InstallCapabilities(&core.Reference{Id: "foo"}, "capability1")
+			Eventually(getState("foo", "capability1")).Should(Equal(core.TaskState_TaskSucceeded))
+
+			store = newStore()
+			Expect(store.Add("capability1", newBackend())).To(Succeed())
+			Expect(getState("foo", "capability1")()).To(Equal(core.TaskState_TaskSucceeded))
+			Expect(getState("bar", "capability1")()).To(Equal(core.TaskState_TaskRunning))
+
+			Expect(store.ResumeInstalls(context.Background())).To(Succeed())
+			Eventually(getState("bar", "capability1")).Should(Equal(core.TaskState_TaskSucceeded))
+		})
 	})
 })
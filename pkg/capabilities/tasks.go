@@ -0,0 +1,356 @@
+package capabilities
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/lestrrat-go/backoff/v2"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Namespace of the key-value store in which install statuses are persisted.
+const StatusNamespace = "capabilities"
+
+func (s *backendStore) InstallCapabilities(
+	cluster *core.Reference,
+	capabilities ...string,
+) {
+	s.logger.With(
+		"cluster", cluster.GetId(),
+		"capabilities", capabilities,
+	).Info("installing capabilities for cluster")
+
+	for _, name := range capabilities {
+		now := timestamppb.Now()
+		status := &core.CapabilityStatus{
+			Name:              name,
+			Cluster:           cluster,
+			State:             core.TaskState_TaskPending,
+			CreationTimestamp: now,
+			LastModified:      now,
+		}
+		s.startInstall(status)
+	}
+}
+
+// installTask tracks an installation which is running in the background.
+type installTask struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startInstall starts installing the capability described by the status in
+// the background, unless an installation of the same capability on the same
+// cluster is already in progress.
+func (s *backendStore) startInstall(status *core.CapabilityStatus) {
+	key := statusKey(status.Cluster, status.Name)
+	s.installsMu.Lock()
+	defer s.installsMu.Unlock()
+	if _, ok := s.installs[key]; ok {
+		s.logger.With(
+			"cluster", status.GetCluster().GetId(),
+			"capability", status.Name,
+		).Info("capability installation already in progress")
+		return
+	}
+	ctx, ca := context.WithCancel(context.Background())
+	task := &installTask{
+		cancel: ca,
+		done:   make(chan struct{}),
+	}
+	s.installs[key] = task
+	s.updateStatus(status)
+	go func() {
+		defer func() {
+			s.installsMu.Lock()
+			if s.installs[key] == task {
+				delete(s.installs, key)
+			}
+			s.installsMu.Unlock()
+			ca()
+			close(task.done)
+		}()
+		s.runInstall(ctx, status)
+	}()
+}
+
+// cancelInstalls cancels the installations matching the filter, and waits
+// for them to stop. Canceling an installation also cancels the context of any
+// call to the backend's Install method which is in progress. Once this
+// returns, the canceled installations will not call the backend or update
+// their status again.
+func (s *backendStore) cancelInstalls(filter func(key string) bool) {
+	var tasks []*installTask
+	s.installsMu.Lock()
+	for key, task := range s.installs {
+		if filter(key) {
+			tasks = append(tasks, task)
+			delete(s.installs, key)
+		}
+	}
+	s.installsMu.Unlock()
+	for _, task := range tasks {
+		task.cancel()
+		<-task.done
+	}
+}
+
+func (s *backendStore) CancelInstalls(cluster *core.Reference) {
+	prefix := cluster.GetId() + "/"
+	s.cancelInstalls(func(key string) bool {
+		return strings.HasPrefix(key, prefix)
+	})
+}
+
+func (s *backendStore) UninstallCapability(
+	cluster *core.Reference,
+	capability string,
+) error {
+	// Stop any pending installation first, so that it cannot recreate the
+	// capability's resources after they have been removed
+	key := statusKey(cluster, capability)
+	s.cancelInstalls(func(k string) bool {
+		return k == key
+	})
+	backend, err := s.Get(capability)
+	if err != nil {
+		return err
+	}
+	s.logger.With(
+		"cluster", cluster.GetId(),
+		"capability", capability,
+	).Info("uninstalling capability from cluster")
+	if err := backend.Uninstall(cluster); err != nil {
+		return err
+	}
+	if err := s.statuses.Delete(context.Background(), cluster, capability); err != nil {
+		s.logger.With(
+			"cluster", cluster.GetId(),
+			"capability", capability,
+			zap.Error(err),
+		).Warn("failed to clear capability install status")
+	}
+	return nil
+}
+
+func (s *backendStore) InstallStatus(
+	cluster *core.Reference,
+	capability string,
+) (*core.CapabilityStatus, error) {
+	return s.statuses.Get(context.Background(), cluster, capability)
+}
+
+func (s *backendStore) ResumeInstalls(ctx context.Context) error {
+	statuses, err := s.statuses.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list install statuses: %w", err)
+	}
+	for _, status := range statuses {
+		switch status.State {
+		case core.TaskState_TaskPending, core.TaskState_TaskRunning:
+			s.logger.With(
+				"cluster", status.GetCluster().GetId(),
+				"capability", status.Name,
+			).Info("resuming capability installation")
+			s.startInstall(status)
+		}
+	}
+	return nil
+}
+
+// runInstall attempts to install the capability described by the status,
+// retrying with exponential backoff until the installation succeeds, the
+// maximum number of attempts is reached, or the context is canceled. Errors
+// are recorded in the status, and are never fatal. Once the context is
+// canceled, the status is no longer updated.
+func (s *backendStore) runInstall(ctx context.Context, status *core.CapabilityStatus) {
+	lg := s.logger.With(
+		"cluster", status.GetCluster().GetId(),
+		"capability", status.Name,
+	)
+	backend, err := s.Get(status.Name)
+	if err != nil {
+		lg.With(
+			zap.Error(err),
+		).Error("failed to install capability")
+		status.State = core.TaskState_TaskFailed
+		status.LastError = err.Error()
+		s.updateStatus(status)
+		return
+	}
+
+	p := backoff.Exponential(
+		backoff.WithMinInterval(s.minRetryInterval),
+		backoff.WithMaxInterval(s.maxRetryInterval),
+		backoff.WithMultiplier(2),
+		backoff.WithJitterFactor(0.05),
+	)
+	b := p.Start(ctx)
+	for backoff.Continue(b) {
+		if ctx.Err() != nil {
+			break
+		}
+		status.Attempts++
+		status.State = core.TaskState_TaskRunning
+		s.updateStatus(status)
+
+		err := backend.Install(ctx, status.Cluster)
+		if ctx.Err() != nil {
+			break
+		}
+		if err == nil {
+			lg.Info("capability installed")
+			status.State = core.TaskState_TaskSucceeded
+			status.LastError = ""
+			s.updateStatus(status)
+			return
+		}
+		status.LastError = err.Error()
+		if int(status.Attempts) >= s.maxInstallAttempts {
+			lg.With(
+				zap.Error(err),
+				"attempts", status.Attempts,
+			).Error("failed to install capability")
+			status.State = core.TaskState_TaskFailed
+			s.updateStatus(status)
+			return
+		}
+		lg.With(
+			zap.Error(err),
+			"attempts", status.Attempts,
+		).Warn("failed to install capability (will retry)")
+		status.State = core.TaskState_TaskPending
+		s.updateStatus(status)
+	}
+	if ctx.Err() != nil {
+		lg.Info("capability installation canceled")
+	}
+}
+
+func (s *backendStore) updateStatus(status *core.CapabilityStatus) {
+	status.LastModified = timestamppb.Now()
+	if err := s.statuses.Put(context.Background(), status); err != nil {
+		s.logger.With(
+			"cluster", status.GetCluster().GetId(),
+			"capability", status.Name,
+			zap.Error(err),
+		).Error("failed to store capability install status")
+	}
+}
+
+// statusTracker keeps install statuses in memory, and optionally persists
+// them in a key-value store.
+type statusTracker struct {
+	mu       sync.Mutex
+	statuses map[string]*core.CapabilityStatus
+	store    storage.KeyValueStore
+}
+
+func newStatusTracker(store storage.KeyValueStore) *statusTracker {
+	return &statusTracker{
+		statuses: map[string]*core.CapabilityStatus{},
+		store:    store,
+	}
+}
+
+func statusKey(cluster *core.Reference, capability string) string {
+	return path.Join(cluster.GetId(), capability)
+}
+
+func (t *statusTracker) Put(ctx context.Context, status *core.CapabilityStatus) error {
+	status = proto.Clone(status).(*core.CapabilityStatus)
+	key := statusKey(status.Cluster, status.Name)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.statuses[key] = status
+	if t.store == nil {
+		return nil
+	}
+	data, err := proto.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return t.store.Put(ctx, key, data)
+}
+
+func (t *statusTracker) Get(
+	ctx context.Context,
+	cluster *core.Reference,
+	capability string,
+) (*core.CapabilityStatus, error) {
+	key := statusKey(cluster, capability)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if status, ok := t.statuses[key]; ok {
+		return proto.Clone(status).(*core.CapabilityStatus), nil
+	}
+	if t.store == nil {
+		return nil, storage.ErrNotFound
+	}
+	status, err := t.load(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	t.statuses[key] = status
+	return proto.Clone(status).(*core.CapabilityStatus), nil
+}
+
+func (t *statusTracker) Delete(
+	ctx context.Context,
+	cluster *core.Reference,
+	capability string,
+) error {
+	key := statusKey(cluster, capability)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.statuses, key)
+	if t.store == nil {
+		return nil
+	}
+	if err := t.store.Delete(ctx, key); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (t *statusTracker) List(ctx context.Context) ([]*core.CapabilityStatus, error) {
+	if t.store == nil {
+		return nil, nil
+	}
+	keys, err := t.store.ListKeys(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*core.CapabilityStatus, 0, len(keys))
+	for _, key := range keys {
+		status, err := t.load(ctx, key)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func (t *statusTracker) load(ctx context.Context, key string) (*core.CapabilityStatus, error) {
+	data, err := t.store.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	status := &core.CapabilityStatus{}
+	if err := proto.Unmarshal(data, status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal install status: %w", err)
+	}
+	return status, nil
+}
@@ -48,16 +48,37 @@ func NewGatewayHTTPClient(
 	if err != nil {
 		return nil, err
 	}
-	var sharedKeys *keyring.SharedKeys
+	sharedKeys, pkpKey, err := gatewayKeys(kr)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := pkp.TLSConfig(pkpKey.PinnedKeys)
+	if err != nil {
+		return nil, err
+	}
+	// Advertise the pinned keys, so that the gateway can serve a certificate
+	// we trust while its serving certificate is being replaced.
+	tlsConfig.NextProtos = append(pkp.ALPNProtocols(pkpKey.PinnedKeys), "http/1.1")
+	return &gatewayClient{
+		address:    address,
+		id:         id,
+		sharedKeys: sharedKeys,
+		tlsConfig:  tlsConfig,
+	}, nil
+}
+
+// gatewayKeys returns the shared keys used to sign requests to the gateway,
+// and the pinned keys used to verify the gateway's certificate.
+func gatewayKeys(kr keyring.Keyring) (*keyring.SharedKeys, *keyring.PKPKey, error) {
+	// During key rotation, the keyring will contain both the old and new
+	// shared keys. Requests are always signed using the new keys.
+	sharedKeys, ok := keyring.CurrentSharedKeys(kr)
+	if !ok {
+		return nil, nil, errors.New("keyring contains multiple shared key sets")
+	}
 	var pkpKey *keyring.PKPKey
+	var err error
 	kr.Try(
-		func(sk *keyring.SharedKeys) {
-			if sharedKeys != nil {
-				err = errors.New("keyring contains multiple shared key sets")
-				return
-			}
-			sharedKeys = sk
-		},
 		func(pk *keyring.PKPKey) {
 			if pkpKey != nil {
 				err = errors.New("keyring contains multiple PKP key sets")
@@ -67,24 +88,15 @@ func NewGatewayHTTPClient(
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if sharedKeys == nil {
-		return nil, errors.New("keyring is missing shared keys")
+		return nil, nil, errors.New("keyring is missing shared keys")
 	}
 	if pkpKey == nil {
-		return nil, errors.New("keyring is missing PKP key")
+		return nil, nil, errors.New("keyring is missing PKP key")
 	}
-	tlsConfig, err := pkp.TLSConfig(pkpKey.PinnedKeys)
-	if err != nil {
-		return nil, err
-	}
-	return &gatewayClient{
-		address:    address,
-		id:         id,
-		sharedKeys: sharedKeys,
-		tlsConfig:  tlsConfig,
-	}, nil
+	return sharedKeys, pkpKey, nil
 }
 
 type gatewayClient struct {
@@ -162,12 +174,16 @@ func (rb *requestBuilder) Body(body []byte) RequestBuilder {
 
 // Sends the request
 func (rb *requestBuilder) Do() (code int, body []byte, err error) {
-	nonce, mac, err := b2mac.New512([]byte(rb.gatewayClient.id),
-		rb.req.Request().Body(), rb.gatewayClient.sharedKeys.ClientKey)
+	req := rb.req.Request()
+	header, err := b2mac.NewAuthHeader([]byte(rb.gatewayClient.id), b2mac.RequestInfo{
+		Method: string(req.Header.Method()),
+		Path:   string(req.URI().Path()),
+		Query:  string(req.URI().QueryString()),
+	}, req.Body(), rb.gatewayClient.sharedKeys.ClientKey)
 	if err != nil {
 		return 0, nil, err
 	}
-	authHeader, err := b2mac.EncodeAuthHeader([]byte(rb.gatewayClient.id), nonce, mac)
+	authHeader, err := header.Encode()
 	if err != nil {
 		return 0, nil, err
 	}
@@ -0,0 +1,75 @@
+package clients
+
+import (
+	"context"
+
+	"emperror.dev/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/rancher/opni-monitoring/pkg/b2mac"
+	"github.com/rancher/opni-monitoring/pkg/ident"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+)
+
+// NewGatewayGRPCConn creates a connection to the gateway's gRPC services, in
+// which each call is authenticated using the cluster's shared keys. The
+// address should be in the format "host:port".
+func NewGatewayGRPCConn(
+	ctx context.Context,
+	address string,
+	ip ident.Provider,
+	kr keyring.Keyring,
+	opts ...grpc.DialOption,
+) (*grpc.ClientConn, error) {
+	id, err := ip.UniqueIdentifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sharedKeys, pkpKey, err := gatewayKeys(kr)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := pkp.TLSConfig(pkpKey.PinnedKeys)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.DialContext(ctx, address, append([]grpc.DialOption{
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithPerRPCCredentials(&macCredentials{
+			id:         id,
+			sharedKeys: sharedKeys,
+		}),
+	}, opts...)...)
+}
+
+// macCredentials signs each call with a MAC header in the "authorization"
+// metadata key. Messages are not covered by the MAC, and are instead
+// protected by the connection's TLS session.
+type macCredentials struct {
+	id         string
+	sharedKeys *keyring.SharedKeys
+}
+
+func (c *macCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	ri, ok := credentials.RequestInfoFromContext(ctx)
+	if !ok {
+		return nil, errors.New("missing request info")
+	}
+	header, err := b2mac.NewAuthHeader([]byte(c.id), b2mac.GRPCRequestInfo(ri.Method), nil, c.sharedKeys.ClientKey)
+	if err != nil {
+		return nil, err
+	}
+	authHeader, err := header.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"authorization": authHeader,
+	}, nil
+}
+
+func (c *macCredentials) RequireTransportSecurity() bool {
+	return true
+}
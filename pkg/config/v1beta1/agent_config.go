@@ -18,12 +18,64 @@ type AgentConfigSpec struct {
 	// The address of the gateway's public HTTP API. This should be of the format
 	// "https://host:port". The scheme must be "https".
 	GatewayAddress string `json:"gatewayAddress,omitempty"`
+	// The address of the gateway's gRPC services, in the format "host:port".
+	// If set, it is preferred over the HTTP API when bootstrapping, and the
+	// agent keeps a control stream open to the gateway, which the gateway uses
+	// to send commands to the agent.
+	GatewayGRPCAddress string `json:"gatewayGrpcAddress,omitempty"`
 	// The name of the identity provider to use. Defaults to "kubernetes".
+	// Agents which are not running in a Kubernetes cluster should use "host".
 	IdentityProvider string `json:"identityProvider,omitempty"`
 	// Configuration for agent keyring storage.
 	Storage   StorageSpec    `json:"storage,omitempty"`
 	Bootstrap *BootstrapSpec `json:"bootstrap,omitempty"`
 	Rules     *RulesSpec     `json:"rules,omitempty"`
+	// Optional on-disk buffer for remote-write requests. If set, pushes are
+	// acknowledged once they are written to disk, and forwarded to the gateway
+	// in the background. Otherwise, pushes are forwarded synchronously.
+	WAL *WALSpec `json:"wal,omitempty"`
+	// Relabeling rules applied to remote-write requests before they are
+	// forwarded to the gateway, in the same format as Prometheus'
+	// write_relabel_configs. Series which are dropped are not forwarded.
+	WriteRelabelConfigs []RelabelConfig `json:"writeRelabelConfigs,omitempty"`
+}
+
+type WALSpec struct {
+	// Directory in which buffered requests are stored. It will be created if
+	// it does not exist.
+	Dir string `json:"dir,omitempty"`
+	// Maximum total size of buffered requests, in bytes. Once the limit is
+	// reached, the oldest requests are dropped to make room for new ones.
+	// Defaults to 256 MiB.
+	MaxSizeBytes int64 `json:"maxSizeBytes,omitempty"`
+}
+
+type RelabelAction string
+
+const (
+	RelabelActionReplace   RelabelAction = "replace"
+	RelabelActionKeep      RelabelAction = "keep"
+	RelabelActionDrop      RelabelAction = "drop"
+	RelabelActionLabelDrop RelabelAction = "labeldrop"
+)
+
+type RelabelConfig struct {
+	// Labels whose values are concatenated, using the separator, and matched
+	// against the regex.
+	SourceLabels []string `json:"sourceLabels,omitempty"`
+	// Defaults to ";".
+	Separator string `json:"separator,omitempty"`
+	// Regular expression against which the concatenated source label values
+	// are matched, or for labeldrop, against which label names are matched.
+	// It is anchored at both ends. Defaults to "(.*)".
+	Regex string `json:"regex,omitempty"`
+	// Label to which the replacement is written, for the replace action.
+	TargetLabel string `json:"targetLabel,omitempty"`
+	// Value written to the target label if the regex matches, which may
+	// reference capture groups. Defaults to "$1".
+	Replacement string `json:"replacement,omitempty"`
+	// One of "replace", "keep", "drop" or "labeldrop". Defaults to "replace".
+	Action RelabelAction `json:"action,omitempty"`
 }
 
 type BootstrapSpec struct {
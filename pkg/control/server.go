@@ -0,0 +1,291 @@
+package control
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+)
+
+var (
+	ErrAgentNotConnected = status.Error(codes.Unavailable, "agent is not connected")
+	ErrAgentDisconnected = status.Error(codes.Unavailable, "agent disconnected before responding")
+	ErrStreamReplaced    = status.Error(codes.Aborted, "agent opened a new control stream")
+	ErrStreamRevoked     = status.Error(codes.Unauthenticated, "control stream was revoked by the gateway")
+	ErrServerClosed      = status.Error(codes.Unavailable, "gateway is shutting down")
+)
+
+// CommandSender sends commands to agents which are connected to the gateway.
+type CommandSender interface {
+	// SendCommand sends a command to the agent for the given cluster, and
+	// waits for the agent to respond. Commands which fail on the agent return
+	// a result with the Error field set.
+	SendCommand(
+		ctx context.Context,
+		clusterID string,
+		cmdType controlv1.CommandType,
+	) (*controlv1.CommandResult, error)
+}
+
+// AgentController sends commands to connected agents, and can disconnect
+// them when their credentials are no longer valid.
+type AgentController interface {
+	CommandSender
+	// Disconnect closes the control stream of the agent for the given cluster,
+	// if it is connected. Streams are only authenticated when they are opened,
+	// so this must be called when the cluster's keys are revoked or rotated,
+	// or when the cluster is rejected or deleted. The agent must authenticate
+	// again to open a new stream.
+	Disconnect(clusterID string)
+	// DisconnectAt closes the agent's current control stream at the given
+	// time, such as when the keys it was opened with expire.
+	DisconnectAt(clusterID string, t time.Time)
+}
+
+// Server implements the AgentControl service. Streams must be authenticated
+// using the cluster auth middleware's stream interceptor. Each cluster can
+// have at most one open stream; if an agent opens a new stream, for example
+// after a network interruption which was not yet detected by the gateway, the
+// previous stream is closed.
+type Server struct {
+	controlv1.UnsafeAgentControlServer
+	logger *zap.SugaredLogger
+
+	mu     sync.Mutex
+	agents map[string]*agentStream
+	closed chan struct{}
+}
+
+var (
+	_ controlv1.AgentControlServer = (*Server)(nil)
+	_ AgentController              = (*Server)(nil)
+)
+
+func NewServer(lg *zap.SugaredLogger) *Server {
+	return &Server{
+		logger: lg,
+		agents: map[string]*agentStream{},
+		closed: make(chan struct{}),
+	}
+}
+
+// Close closes all open streams, and rejects new streams. Agents will
+// reconnect once the gateway is available again.
+func (s *Server) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.closed:
+	default:
+		close(s.closed)
+	}
+}
+
+type agentStream struct {
+	stream controlv1.AgentControl_ConnectServer
+	// Commands are sent on the stream by a single goroutine, since sends can
+	// block for as long as the agent is not reading from the stream.
+	sendC    chan *controlv1.Command
+	done     chan struct{}
+	replaced chan struct{}
+
+	revokeOnce sync.Once
+	revoked    chan struct{}
+
+	pendingMu sync.Mutex
+	pending   map[string]chan *controlv1.CommandResult
+}
+
+func (s *Server) Connect(stream controlv1.AgentControl_ConnectServer) error {
+	clusterID, ok := cluster.AuthorizedIDFromContext(stream.Context())
+	if !ok {
+		return status.Error(codes.Unauthenticated, "stream is not authenticated")
+	}
+	lg := s.logger.With("cluster", clusterID)
+
+	as := &agentStream{
+		stream:   stream,
+		sendC:    make(chan *controlv1.Command),
+		done:     make(chan struct{}),
+		replaced: make(chan struct{}),
+		revoked:  make(chan struct{}),
+		pending:  map[string]chan *controlv1.CommandResult{},
+	}
+	s.mu.Lock()
+	select {
+	case <-s.closed:
+		s.mu.Unlock()
+		return ErrServerClosed
+	default:
+	}
+	if prev, ok := s.agents[clusterID]; ok {
+		close(prev.replaced)
+	}
+	s.agents[clusterID] = as
+	s.mu.Unlock()
+	lg.Info("agent connected")
+
+	// Streams are only authenticated when they are opened, so streams opened
+	// with keys which are being rotated out are closed when the keys expire.
+	if keys, ok := cluster.AuthorizedKeysFromContext(stream.Context()); ok && keys.ExpiresAt != nil {
+		as.revokeAt(*keys.ExpiresAt)
+	}
+
+	defer func() {
+		s.mu.Lock()
+		if s.agents[clusterID] == as {
+			delete(s.agents, clusterID)
+		}
+		s.mu.Unlock()
+		close(as.done)
+		lg.Info("agent disconnected")
+	}()
+
+	// Send headers immediately, so that the agent knows the stream has been
+	// accepted without waiting for the first command. Commands can be sent
+	// to the agent once it has received the headers.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			select {
+			case cmd := <-as.sendC:
+				// If the send fails, the stream is broken and Recv will
+				// return an error as well.
+				if err := stream.Send(cmd); err != nil {
+					lg.With(zap.Error(err)).Debug("failed to send command")
+				}
+			case <-as.done:
+				return
+			}
+		}
+	}()
+
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			result, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+			as.deliver(result)
+		}
+	}()
+
+	select {
+	case err := <-recvErr:
+		if status.Code(err) == codes.Canceled {
+			return nil
+		}
+		return err
+	case <-as.replaced:
+		return ErrStreamReplaced
+	case <-as.revoked:
+		lg.Info("control stream revoked")
+		return ErrStreamRevoked
+	case <-s.closed:
+		return ErrServerClosed
+	}
+}
+
+func (s *Server) Disconnect(clusterID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if as, ok := s.agents[clusterID]; ok {
+		delete(s.agents, clusterID)
+		as.revoke()
+	}
+}
+
+func (s *Server) DisconnectAt(clusterID string, t time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if as, ok := s.agents[clusterID]; ok {
+		as.revokeAt(t)
+	}
+}
+
+func (as *agentStream) revoke() {
+	as.revokeOnce.Do(func() {
+		close(as.revoked)
+	})
+}
+
+func (as *agentStream) revokeAt(t time.Time) {
+	timer := time.AfterFunc(time.Until(t), as.revoke)
+	go func() {
+		<-as.done
+		timer.Stop()
+	}()
+}
+
+func (as *agentStream) deliver(result *controlv1.CommandResult) {
+	as.pendingMu.Lock()
+	defer as.pendingMu.Unlock()
+	if c, ok := as.pending[result.GetId()]; ok {
+		c <- result
+		delete(as.pending, result.GetId())
+	}
+}
+
+func (as *agentStream) send(ctx context.Context, cmd *controlv1.Command) error {
+	select {
+	case as.sendC <- cmd:
+		return nil
+	case <-as.done:
+		return ErrAgentDisconnected
+	case <-ctx.Done():
+		return status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+func (s *Server) SendCommand(
+	ctx context.Context,
+	clusterID string,
+	cmdType controlv1.CommandType,
+) (*controlv1.CommandResult, error) {
+	s.mu.Lock()
+	as, ok := s.agents[clusterID]
+	s.mu.Unlock()
+	if !ok {
+		return nil, ErrAgentNotConnected
+	}
+
+	id := uuid.NewString()
+	resultC := make(chan *controlv1.CommandResult, 1)
+	as.pendingMu.Lock()
+	as.pending[id] = resultC
+	as.pendingMu.Unlock()
+	defer func() {
+		as.pendingMu.Lock()
+		delete(as.pending, id)
+		as.pendingMu.Unlock()
+	}()
+
+	if err := as.send(ctx, &controlv1.Command{
+		Id:   id,
+		Type: cmdType,
+	}); err != nil {
+		return nil, err
+	}
+
+	select {
+	case result := <-resultC:
+		return result, nil
+	case <-as.done:
+		return nil, ErrAgentDisconnected
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
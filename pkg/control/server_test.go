@@ -0,0 +1,293 @@
+package control_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/b2mac"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/control"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/ecdh"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Control Server", Label(test.Unit, test.Slow), func() {
+	var server *control.Server
+	var cert tls.Certificate
+	var agentKeyring keyring.Keyring
+	var sharedKeys *keyring.SharedKeys
+	var gatewayStore storage.KeyringStore
+	var address string
+
+	BeforeEach(func() {
+		var err error
+		cert, err = tls.X509KeyPair(test.TestData("self_signed_leaf.crt"), test.TestData("self_signed_leaf.key"))
+		Expect(err).NotTo(HaveOccurred())
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		broker := test.NewTestKeyringStoreBroker(ctrl)
+		gatewayStore, err = broker.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: "foo",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		secret, err := ecdh.DeriveSharedSecret(ecdh.NewEphemeralKeyPair(), ecdh.PeerPublicKey{
+			PublicKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			PeerType:  ecdh.PeerTypeClient,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		sharedKeys = keyring.NewSharedKeys(secret)
+		Expect(gatewayStore.Put(context.Background(), keyring.New(sharedKeys))).To(Succeed())
+		agentKeyring = keyring.New(
+			sharedKeys,
+			keyring.NewPKPKey([]*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)}),
+		)
+
+		cm, err := cluster.New(broker, "X-Test")
+		Expect(err).NotTo(HaveOccurred())
+		srv := grpc.NewServer(
+			grpc.Creds(credentials.NewTLS(&tls.Config{
+				Certificates: []tls.Certificate{cert},
+			})),
+			grpc.StreamInterceptor(cm.StreamServerInterceptor()),
+		)
+		server = control.NewServer(test.Log)
+		controlv1.RegisterAgentControlServer(srv, server)
+		listener, err := net.Listen("tcp4", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+		address = listener.Addr().String()
+		go srv.Serve(listener)
+		DeferCleanup(srv.Stop)
+	})
+
+	// connect opens a control stream as the agent for cluster "foo", and
+	// waits until the stream has been accepted.
+	connect := func(ctx context.Context, opts ...grpc.DialOption) controlv1.AgentControl_ConnectClient {
+		cc, err := clients.NewGatewayGRPCConn(ctx, address, test.NewTestIdentProvider(ctrl, "foo"), agentKeyring, opts...)
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(cc.Close)
+		stream, err := controlv1.NewAgentControlClient(cc).Connect(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = stream.Header()
+		Expect(err).NotTo(HaveOccurred())
+		return stream
+	}
+	// respond replies to each command received on the stream with the
+	// command type in the result data, until the stream is closed.
+	respond := func(stream controlv1.AgentControl_ConnectClient) {
+		go func() {
+			defer GinkgoRecover()
+			for {
+				cmd, err := stream.Recv()
+				if err != nil {
+					return
+				}
+				// the server may close the stream at any time, in which case
+				// Send returns io.EOF
+				if err := stream.Send(&controlv1.CommandResult{
+					Id: cmd.GetId(),
+					Data: map[string]string{
+						"type": cmd.GetType().String(),
+					},
+				}); err != nil {
+					return
+				}
+			}
+		}()
+	}
+
+	It("should send commands to connected agents", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		respond(connect(ctx))
+
+		for _, cmdType := range []controlv1.CommandType{
+			controlv1.CommandType_SyncRules,
+			controlv1.CommandType_Diagnostics,
+			controlv1.CommandType_RotateKeys,
+		} {
+			result, err := server.SendCommand(context.Background(), "foo", cmdType)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.GetError()).To(BeEmpty())
+			Expect(result.GetData()).To(HaveKeyWithValue("type", cmdType.String()))
+		}
+	})
+	It("should return an error if the agent is not connected", func() {
+		_, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).To(MatchError(control.ErrAgentNotConnected))
+	})
+	It("should reject unauthenticated streams", func() {
+		tlsConfig, err := pkp.TLSConfig([]*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)})
+		Expect(err).NotTo(HaveOccurred())
+		cc, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		Expect(err).NotTo(HaveOccurred())
+		defer cc.Close()
+		stream, err := controlv1.NewAgentControlClient(cc).Connect(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		_, err = stream.Recv()
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+	})
+	It("should reject streams authenticated with older header versions", func() {
+		tlsConfig, err := pkp.TLSConfig([]*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)})
+		Expect(err).NotTo(HaveOccurred())
+		cc, err := grpc.Dial(address, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+		Expect(err).NotTo(HaveOccurred())
+		defer cc.Close()
+
+		v0, err := b2mac.NewEncodedHeader([]byte("foo"), nil, sharedKeys.ClientKey)
+		Expect(err).NotTo(HaveOccurred())
+		ts := time.Now().Truncate(time.Second)
+		nonce, mac, err := b2mac.New512Timestamped([]byte("foo"), ts, nil, sharedKeys.ClientKey)
+		Expect(err).NotTo(HaveOccurred())
+		v1, err := b2mac.AuthHeader{
+			Version:   b2mac.Version1,
+			ID:        []byte("foo"),
+			Nonce:     nonce,
+			Timestamp: ts,
+			MAC:       mac,
+		}.Encode()
+		Expect(err).NotTo(HaveOccurred())
+
+		for _, header := range []string{v0, v1} {
+			ctx := metadata.AppendToOutgoingContext(context.Background(), "authorization", header)
+			stream, err := controlv1.NewAgentControlClient(cc).Connect(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			Expect(status.Convert(err).Message()).To(ContainSubstring("unsupported authorization header version"))
+		}
+	})
+	It("should return an error if the agent disconnects before responding", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		stream := connect(ctx)
+		go func() {
+			defer GinkgoRecover()
+			_, err := stream.Recv()
+			Expect(err).NotTo(HaveOccurred())
+			ca()
+		}()
+		_, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).To(MatchError(control.ErrAgentDisconnected))
+	})
+	It("should time out if the agent does not respond", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		connect(ctx)
+		reqCtx, reqCa := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer reqCa()
+		_, err := server.SendCommand(reqCtx, "foo", controlv1.CommandType_Diagnostics)
+		Expect(status.Code(err)).To(Equal(codes.DeadlineExceeded))
+	})
+	It("should not block disconnects while sends are blocked on the agent", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		// The agent never reads from the stream, so once the flow control
+		// window is full, sends block until the stream is closed.
+		connect(ctx, grpc.WithInitialWindowSize(64*1024), grpc.WithInitialConnWindowSize(64*1024))
+
+		const count = 10000
+		errs := make(chan error, count)
+		for i := 0; i < count; i++ {
+			go func() {
+				_, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+				errs <- err
+			}()
+		}
+		time.Sleep(500 * time.Millisecond)
+		server.Disconnect("foo")
+
+		for i := 0; i < count; i++ {
+			Eventually(errs, 5*time.Second).Should(Receive(BeElementOf(
+				control.ErrAgentDisconnected,
+				control.ErrAgentNotConnected,
+			)))
+		}
+	})
+	It("should replace existing streams for the same cluster", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		oldStream := connect(ctx)
+		newStream := connect(ctx)
+		respond(newStream)
+
+		_, err := oldStream.Recv()
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+
+		result, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.GetData()).To(HaveKey("type"))
+	})
+	It("should close streams when the server is closed", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		stream := connect(ctx)
+		server.Close()
+		_, err := stream.Recv()
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+
+		_, err = server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).To(MatchError(control.ErrAgentNotConnected))
+	})
+	It("should close streams when the agent is disconnected", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		stream := connect(ctx)
+		server.Disconnect("foo")
+		_, err := stream.Recv()
+		Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+		_, err = server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).To(MatchError(control.ErrAgentNotConnected))
+	})
+	It("should close streams at the requested time", func() {
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		stream := connect(ctx)
+		respond(stream)
+		server.DisconnectAt("foo", time.Now().Add(500*time.Millisecond))
+
+		_, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() error {
+			_, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+			return err
+		}).Should(MatchError(control.ErrAgentNotConnected))
+	})
+	It("should close streams opened with keys which expire", func() {
+		_, err := gatewayStore.Update(context.Background(), func(kr keyring.Keyring) (keyring.Keyring, error) {
+			return keyring.Expire(kr, time.Now().Add(500*time.Millisecond)), nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		stream := connect(ctx)
+		respond(stream)
+
+		_, err = server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+		Expect(err).NotTo(HaveOccurred())
+
+		Eventually(func() error {
+			_, err := server.SendCommand(context.Background(), "foo", controlv1.CommandType_Diagnostics)
+			return err
+		}).Should(MatchError(control.ErrAgentNotConnected))
+	})
+})
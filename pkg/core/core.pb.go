@@ -9,6 +9,8 @@ package core
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -20,6 +22,107 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ApprovalState int32
+
+const (
+	ApprovalState_ClusterApproved        ApprovalState = 0
+	ApprovalState_ClusterPendingApproval ApprovalState = 1
+	ApprovalState_ClusterRejected        ApprovalState = 2
+)
+
+// Enum value maps for ApprovalState.
+var (
+	ApprovalState_name = map[int32]string{
+		0: "ClusterApproved",
+		1: "ClusterPendingApproval",
+		2: "ClusterRejected",
+	}
+	ApprovalState_value = map[string]int32{
+		"ClusterApproved":        0,
+		"ClusterPendingApproval": 1,
+		"ClusterRejected":        2,
+	}
+)
+
+func (x ApprovalState) Enum() *ApprovalState {
+	p := new(ApprovalState)
+	*p = x
+	return p
+}
+
+func (x ApprovalState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_core_core_proto_enumTypes[0].Descriptor()
+}
+
+func (ApprovalState) Type() protoreflect.EnumType {
+	return &file_pkg_core_core_proto_enumTypes[0]
+}
+
+func (x ApprovalState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalState.Descriptor instead.
+func (ApprovalState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{0}
+}
+
+type ClusterHealth int32
+
+const (
+	ClusterHealth_ClusterHealthUnknown ClusterHealth = 0
+	ClusterHealth_ClusterHealthy       ClusterHealth = 1
+	ClusterHealth_ClusterDegraded      ClusterHealth = 2
+	ClusterHealth_ClusterDisconnected  ClusterHealth = 3
+)
+
+// Enum value maps for ClusterHealth.
+var (
+	ClusterHealth_name = map[int32]string{
+		0: "ClusterHealthUnknown",
+		1: "ClusterHealthy",
+		2: "ClusterDegraded",
+		3: "ClusterDisconnected",
+	}
+	ClusterHealth_value = map[string]int32{
+		"ClusterHealthUnknown": 0,
+		"ClusterHealthy":       1,
+		"ClusterDegraded":      2,
+		"ClusterDisconnected":  3,
+	}
+)
+
+func (x ClusterHealth) Enum() *ClusterHealth {
+	p := new(ClusterHealth)
+	*p = x
+	return p
+}
+
+func (x ClusterHealth) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClusterHealth) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_core_core_proto_enumTypes[1].Descriptor()
+}
+
+func (ClusterHealth) Type() protoreflect.EnumType {
+	return &file_pkg_core_core_proto_enumTypes[1]
+}
+
+func (x ClusterHealth) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClusterHealth.Descriptor instead.
+func (ClusterHealth) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{1}
+}
+
 type MatchOptions int32
 
 const (
@@ -50,11 +153,11 @@ func (x MatchOptions) String() string {
 }
 
 func (MatchOptions) Descriptor() protoreflect.EnumDescriptor {
-	return file_pkg_core_core_proto_enumTypes[0].Descriptor()
+	return file_pkg_core_core_proto_enumTypes[2].Descriptor()
 }
 
 func (MatchOptions) Type() protoreflect.EnumType {
-	return &file_pkg_core_core_proto_enumTypes[0]
+	return &file_pkg_core_core_proto_enumTypes[2]
 }
 
 func (x MatchOptions) Number() protoreflect.EnumNumber {
@@ -63,7 +166,105 @@ func (x MatchOptions) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MatchOptions.Descriptor instead.
 func (MatchOptions) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{0}
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{2}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_Ascending  SortOrder = 0
+	SortOrder_Descending SortOrder = 1
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "Ascending",
+		1: "Descending",
+	}
+	SortOrder_value = map[string]int32{
+		"Ascending":  0,
+		"Descending": 1,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_core_core_proto_enumTypes[3].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_pkg_core_core_proto_enumTypes[3]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{3}
+}
+
+type TaskState int32
+
+const (
+	TaskState_TaskPending   TaskState = 0
+	TaskState_TaskRunning   TaskState = 1
+	TaskState_TaskSucceeded TaskState = 2
+	TaskState_TaskFailed    TaskState = 3
+)
+
+// Enum value maps for TaskState.
+var (
+	TaskState_name = map[int32]string{
+		0: "TaskPending",
+		1: "TaskRunning",
+		2: "TaskSucceeded",
+		3: "TaskFailed",
+	}
+	TaskState_value = map[string]int32{
+		"TaskPending":   0,
+		"TaskRunning":   1,
+		"TaskSucceeded": 2,
+		"TaskFailed":    3,
+	}
+)
+
+func (x TaskState) Enum() *TaskState {
+	p := new(TaskState)
+	*p = x
+	return p
+}
+
+func (x TaskState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_core_core_proto_enumTypes[4].Descriptor()
+}
+
+func (TaskState) Type() protoreflect.EnumType {
+	return &file_pkg_core_core_proto_enumTypes[4]
+}
+
+func (x TaskState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskState.Descriptor instead.
+func (TaskState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{4}
 }
 
 type BootstrapToken struct {
@@ -139,6 +340,7 @@ type BootstrapTokenMetadata struct {
 	UsageCount   int64              `protobuf:"varint,3,opt,name=usageCount,proto3" json:"usageCount,omitempty"`
 	Labels       map[string]string  `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Capabilities []*TokenCapability `protobuf:"bytes,5,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Limits       *TokenLimits       `protobuf:"bytes,6,opt,name=limits,proto3" json:"limits,omitempty"`
 }
 
 func (x *BootstrapTokenMetadata) Reset() {
@@ -208,6 +410,76 @@ func (x *BootstrapTokenMetadata) GetCapabilities() []*TokenCapability {
 	return nil
 }
 
+func (x *BootstrapTokenMetadata) GetLimits() *TokenLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type TokenLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxUsages    int64    `protobuf:"varint,1,opt,name=maxUsages,proto3" json:"maxUsages,omitempty"`
+	AllowedCIDRs []string `protobuf:"bytes,2,rep,name=allowedCIDRs,proto3" json:"allowedCIDRs,omitempty"`
+	OneTime      bool     `protobuf:"varint,3,opt,name=oneTime,proto3" json:"oneTime,omitempty"`
+}
+
+func (x *TokenLimits) Reset() {
+	*x = TokenLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenLimits) ProtoMessage() {}
+
+func (x *TokenLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenLimits.ProtoReflect.Descriptor instead.
+func (*TokenLimits) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TokenLimits) GetMaxUsages() int64 {
+	if x != nil {
+		return x.MaxUsages
+	}
+	return 0
+}
+
+func (x *TokenLimits) GetAllowedCIDRs() []string {
+	if x != nil {
+		return x.AllowedCIDRs
+	}
+	return nil
+}
+
+func (x *TokenLimits) GetOneTime() bool {
+	if x != nil {
+		return x.OneTime
+	}
+	return false
+}
+
 type TokenCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -220,7 +492,7 @@ type TokenCapability struct {
 func (x *TokenCapability) Reset() {
 	*x = TokenCapability{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[2]
+		mi := &file_pkg_core_core_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -233,7 +505,7 @@ func (x *TokenCapability) String() string {
 func (*TokenCapability) ProtoMessage() {}
 
 func (x *TokenCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[2]
+	mi := &file_pkg_core_core_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -246,7 +518,7 @@ func (x *TokenCapability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TokenCapability.ProtoReflect.Descriptor instead.
 func (*TokenCapability) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{2}
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *TokenCapability) GetType() string {
@@ -268,13 +540,14 @@ type BootstrapTokenList struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*BootstrapToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*BootstrapToken `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string            `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *BootstrapTokenList) Reset() {
 	*x = BootstrapTokenList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[3]
+		mi := &file_pkg_core_core_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +560,7 @@ func (x *BootstrapTokenList) String() string {
 func (*BootstrapTokenList) ProtoMessage() {}
 
 func (x *BootstrapTokenList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[3]
+	mi := &file_pkg_core_core_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +573,7 @@ func (x *BootstrapTokenList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BootstrapTokenList.ProtoReflect.Descriptor instead.
 func (*BootstrapTokenList) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{3}
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *BootstrapTokenList) GetItems() []*BootstrapToken {
@@ -310,6 +583,13 @@ func (x *BootstrapTokenList) GetItems() []*BootstrapToken {
 	return nil
 }
 
+func (x *BootstrapTokenList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type Cluster struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -317,12 +597,13 @@ type Cluster struct {
 
 	Id       string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Metadata *ClusterMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Status   *ClusterStatus   `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 }
 
 func (x *Cluster) Reset() {
 	*x = Cluster{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[4]
+		mi := &file_pkg_core_core_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -335,7 +616,7 @@ func (x *Cluster) String() string {
 func (*Cluster) ProtoMessage() {}
 
 func (x *Cluster) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[4]
+	mi := &file_pkg_core_core_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,7 +629,7 @@ func (x *Cluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Cluster.ProtoReflect.Descriptor instead.
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{4}
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Cluster) GetId() string {
@@ -365,19 +646,30 @@ func (x *Cluster) GetMetadata() *ClusterMetadata {
 	return nil
 }
 
+func (x *Cluster) GetStatus() *ClusterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 type ClusterMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Labels       map[string]string    `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Capabilities []*ClusterCapability `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Capabilities      []*ClusterCapability   `protobuf:"bytes,2,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	ResourceVersion   string                 `protobuf:"bytes,3,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastModified      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastModified,proto3" json:"lastModified,omitempty"`
+	Approval          *ClusterApproval       `protobuf:"bytes,6,opt,name=approval,proto3" json:"approval,omitempty"`
 }
 
 func (x *ClusterMetadata) Reset() {
 	*x = ClusterMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[5]
+		mi := &file_pkg_core_core_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -390,7 +682,7 @@ func (x *ClusterMetadata) String() string {
 func (*ClusterMetadata) ProtoMessage() {}
 
 func (x *ClusterMetadata) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[5]
+	mi := &file_pkg_core_core_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -403,7 +695,7 @@ func (x *ClusterMetadata) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterMetadata.ProtoReflect.Descriptor instead.
 func (*ClusterMetadata) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{5}
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ClusterMetadata) GetLabels() map[string]string {
@@ -420,63 +712,48 @@ func (x *ClusterMetadata) GetCapabilities() []*ClusterCapability {
 	return nil
 }
 
-type ClusterCapability struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-}
-
-func (x *ClusterCapability) Reset() {
-	*x = ClusterCapability{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ClusterMetadata) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
 	}
+	return ""
 }
 
-func (x *ClusterCapability) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ClusterCapability) ProtoMessage() {}
-
-func (x *ClusterCapability) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ClusterMetadata) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ClusterCapability.ProtoReflect.Descriptor instead.
-func (*ClusterCapability) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{6}
+func (x *ClusterMetadata) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
 }
 
-func (x *ClusterCapability) GetName() string {
+func (x *ClusterMetadata) GetApproval() *ClusterApproval {
 	if x != nil {
-		return x.Name
+		return x.Approval
 	}
-	return ""
+	return nil
 }
 
-type ClusterList struct {
+type ClusterApproval struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*Cluster `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	State               ApprovalState          `protobuf:"varint,1,opt,name=state,proto3,enum=core.ApprovalState" json:"state,omitempty"`
+	RequestedCapability string                 `protobuf:"bytes,2,opt,name=requestedCapability,proto3" json:"requestedCapability,omitempty"`
+	TokenID             string                 `protobuf:"bytes,3,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
+	Address             string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	RequestTimestamp    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=requestTimestamp,proto3" json:"requestTimestamp,omitempty"`
 }
 
-func (x *ClusterList) Reset() {
-	*x = ClusterList{}
+func (x *ClusterApproval) Reset() {
+	*x = ClusterApproval{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +761,13 @@ func (x *ClusterList) Reset() {
 	}
 }
 
-func (x *ClusterList) String() string {
+func (x *ClusterApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClusterList) ProtoMessage() {}
+func (*ClusterApproval) ProtoMessage() {}
 
-func (x *ClusterList) ProtoReflect() protoreflect.Message {
+func (x *ClusterApproval) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,29 +779,62 @@ func (x *ClusterList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClusterList.ProtoReflect.Descriptor instead.
-func (*ClusterList) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterApproval.ProtoReflect.Descriptor instead.
+func (*ClusterApproval) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ClusterList) GetItems() []*Cluster {
+func (x *ClusterApproval) GetState() ApprovalState {
 	if x != nil {
-		return x.Items
+		return x.State
 	}
-	return nil
+	return ApprovalState_ClusterApproved
 }
 
-type LabelSelector struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ClusterApproval) GetRequestedCapability() string {
+	if x != nil {
+		return x.RequestedCapability
+	}
+	return ""
+}
 
-	MatchLabels      map[string]string           `protobuf:"bytes,1,rep,name=matchLabels,proto3" json:"matchLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MatchExpressions []*LabelSelectorRequirement `protobuf:"bytes,2,rep,name=matchExpressions,proto3" json:"matchExpressions,omitempty"`
+func (x *ClusterApproval) GetTokenID() string {
+	if x != nil {
+		return x.TokenID
+	}
+	return ""
 }
 
-func (x *LabelSelector) Reset() {
-	*x = LabelSelector{}
+func (x *ClusterApproval) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ClusterApproval) GetRequestTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestTimestamp
+	}
+	return nil
+}
+
+type ClusterStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AgentVersion      string                 `protobuf:"bytes,1,opt,name=agentVersion,proto3" json:"agentVersion,omitempty"`
+	Uptime            *durationpb.Duration   `protobuf:"bytes,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	LastPushTimestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lastPushTimestamp,proto3" json:"lastPushTimestamp,omitempty"`
+	RuleSync          *RuleSyncStatus        `protobuf:"bytes,4,opt,name=ruleSync,proto3" json:"ruleSync,omitempty"`
+	HeartbeatInterval *durationpb.Duration   `protobuf:"bytes,5,opt,name=heartbeatInterval,proto3" json:"heartbeatInterval,omitempty"`
+	LastSeen          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=lastSeen,proto3" json:"lastSeen,omitempty"`
+	Health            ClusterHealth          `protobuf:"varint,7,opt,name=health,proto3,enum=core.ClusterHealth" json:"health,omitempty"`
+}
+
+func (x *ClusterStatus) Reset() {
+	*x = ClusterStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -532,13 +842,13 @@ func (x *LabelSelector) Reset() {
 	}
 }
 
-func (x *LabelSelector) String() string {
+func (x *ClusterStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LabelSelector) ProtoMessage() {}
+func (*ClusterStatus) ProtoMessage() {}
 
-func (x *LabelSelector) ProtoReflect() protoreflect.Message {
+func (x *ClusterStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -550,37 +860,73 @@ func (x *LabelSelector) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LabelSelector.ProtoReflect.Descriptor instead.
-func (*LabelSelector) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterStatus.ProtoReflect.Descriptor instead.
+func (*ClusterStatus) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *LabelSelector) GetMatchLabels() map[string]string {
+func (x *ClusterStatus) GetAgentVersion() string {
 	if x != nil {
-		return x.MatchLabels
+		return x.AgentVersion
+	}
+	return ""
+}
+
+func (x *ClusterStatus) GetUptime() *durationpb.Duration {
+	if x != nil {
+		return x.Uptime
 	}
 	return nil
 }
 
-func (x *LabelSelector) GetMatchExpressions() []*LabelSelectorRequirement {
+func (x *ClusterStatus) GetLastPushTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MatchExpressions
+		return x.LastPushTimestamp
 	}
 	return nil
 }
 
-type LabelSelectorRequirement struct {
+func (x *ClusterStatus) GetRuleSync() *RuleSyncStatus {
+	if x != nil {
+		return x.RuleSync
+	}
+	return nil
+}
+
+func (x *ClusterStatus) GetHeartbeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HeartbeatInterval
+	}
+	return nil
+}
+
+func (x *ClusterStatus) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *ClusterStatus) GetHealth() ClusterHealth {
+	if x != nil {
+		return x.Health
+	}
+	return ClusterHealth_ClusterHealthUnknown
+}
+
+type RuleSyncStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key      string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Operator string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
-	Values   []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	Enabled           bool                   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	LastSyncTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=lastSyncTimestamp,proto3" json:"lastSyncTimestamp,omitempty"`
+	Groups            int32                  `protobuf:"varint,3,opt,name=groups,proto3" json:"groups,omitempty"`
+	LastError         string                 `protobuf:"bytes,4,opt,name=lastError,proto3" json:"lastError,omitempty"`
 }
 
-func (x *LabelSelectorRequirement) Reset() {
-	*x = LabelSelectorRequirement{}
+func (x *RuleSyncStatus) Reset() {
+	*x = RuleSyncStatus{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -588,13 +934,13 @@ func (x *LabelSelectorRequirement) Reset() {
 	}
 }
 
-func (x *LabelSelectorRequirement) String() string {
+func (x *RuleSyncStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LabelSelectorRequirement) ProtoMessage() {}
+func (*RuleSyncStatus) ProtoMessage() {}
 
-func (x *LabelSelectorRequirement) ProtoReflect() protoreflect.Message {
+func (x *RuleSyncStatus) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,44 +952,49 @@ func (x *LabelSelectorRequirement) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LabelSelectorRequirement.ProtoReflect.Descriptor instead.
-func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+// Deprecated: Use RuleSyncStatus.ProtoReflect.Descriptor instead.
+func (*RuleSyncStatus) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *LabelSelectorRequirement) GetKey() string {
+func (x *RuleSyncStatus) GetEnabled() bool {
 	if x != nil {
-		return x.Key
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *LabelSelectorRequirement) GetOperator() string {
+func (x *RuleSyncStatus) GetLastSyncTimestamp() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Operator
+		return x.LastSyncTimestamp
 	}
-	return ""
+	return nil
 }
 
-func (x *LabelSelectorRequirement) GetValues() []string {
+func (x *RuleSyncStatus) GetGroups() int32 {
 	if x != nil {
-		return x.Values
+		return x.Groups
 	}
-	return nil
+	return 0
 }
 
-type Role struct {
+func (x *RuleSyncStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type ClusterCapability struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ClusterIDs  []string       `protobuf:"bytes,2,rep,name=clusterIDs,proto3" json:"clusterIDs,omitempty"`
-	MatchLabels *LabelSelector `protobuf:"bytes,3,opt,name=matchLabels,proto3" json:"matchLabels,omitempty"`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (x *Role) Reset() {
-	*x = Role{}
+func (x *ClusterCapability) Reset() {
+	*x = ClusterCapability{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -651,13 +1002,13 @@ func (x *Role) Reset() {
 	}
 }
 
-func (x *Role) String() string {
+func (x *ClusterCapability) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Role) ProtoMessage() {}
+func (*ClusterCapability) ProtoMessage() {}
 
-func (x *Role) ProtoReflect() protoreflect.Message {
+func (x *ClusterCapability) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -669,45 +1020,29 @@ func (x *Role) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Role.ProtoReflect.Descriptor instead.
-func (*Role) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterCapability.ProtoReflect.Descriptor instead.
+func (*ClusterCapability) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Role) GetId() string {
+func (x *ClusterCapability) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Role) GetClusterIDs() []string {
-	if x != nil {
-		return x.ClusterIDs
-	}
-	return nil
-}
-
-func (x *Role) GetMatchLabels() *LabelSelector {
-	if x != nil {
-		return x.MatchLabels
-	}
-	return nil
-}
-
-type RoleBinding struct {
+type ClusterList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	RoleId   string   `protobuf:"bytes,2,opt,name=roleId,proto3" json:"roleId,omitempty"`
-	Subjects []string `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
-	Taints   []string `protobuf:"bytes,4,rep,name=taints,proto3" json:"taints,omitempty"`
+	Items         []*Cluster `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *RoleBinding) Reset() {
-	*x = RoleBinding{}
+func (x *ClusterList) Reset() {
+	*x = ClusterList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,13 +1050,13 @@ func (x *RoleBinding) Reset() {
 	}
 }
 
-func (x *RoleBinding) String() string {
+func (x *ClusterList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleBinding) ProtoMessage() {}
+func (*ClusterList) ProtoMessage() {}
 
-func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+func (x *ClusterList) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -733,49 +1068,36 @@ func (x *RoleBinding) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
-func (*RoleBinding) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterList.ProtoReflect.Descriptor instead.
+func (*ClusterList) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *RoleBinding) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RoleBinding) GetRoleId() string {
-	if x != nil {
-		return x.RoleId
-	}
-	return ""
-}
-
-func (x *RoleBinding) GetSubjects() []string {
+func (x *ClusterList) GetItems() []*Cluster {
 	if x != nil {
-		return x.Subjects
+		return x.Items
 	}
 	return nil
 }
 
-func (x *RoleBinding) GetTaints() []string {
+func (x *ClusterList) GetNextPageToken() string {
 	if x != nil {
-		return x.Taints
+		return x.NextPageToken
 	}
-	return nil
+	return ""
 }
 
-type RoleList struct {
+type LabelSelector struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*Role `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	MatchLabels      map[string]string           `protobuf:"bytes,1,rep,name=matchLabels,proto3" json:"matchLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MatchExpressions []*LabelSelectorRequirement `protobuf:"bytes,2,rep,name=matchExpressions,proto3" json:"matchExpressions,omitempty"`
 }
 
-func (x *RoleList) Reset() {
-	*x = RoleList{}
+func (x *LabelSelector) Reset() {
+	*x = LabelSelector{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,13 +1105,13 @@ func (x *RoleList) Reset() {
 	}
 }
 
-func (x *RoleList) String() string {
+func (x *LabelSelector) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleList) ProtoMessage() {}
+func (*LabelSelector) ProtoMessage() {}
 
-func (x *RoleList) ProtoReflect() protoreflect.Message {
+func (x *LabelSelector) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,28 +1123,37 @@ func (x *RoleList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleList.ProtoReflect.Descriptor instead.
-func (*RoleList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LabelSelector.ProtoReflect.Descriptor instead.
+func (*LabelSelector) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *RoleList) GetItems() []*Role {
+func (x *LabelSelector) GetMatchLabels() map[string]string {
 	if x != nil {
-		return x.Items
+		return x.MatchLabels
 	}
 	return nil
 }
 
-type RoleBindingList struct {
+func (x *LabelSelector) GetMatchExpressions() []*LabelSelectorRequirement {
+	if x != nil {
+		return x.MatchExpressions
+	}
+	return nil
+}
+
+type LabelSelectorRequirement struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*RoleBinding `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Key      string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator string   `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Values   []string `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 }
 
-func (x *RoleBindingList) Reset() {
-	*x = RoleBindingList{}
+func (x *LabelSelectorRequirement) Reset() {
+	*x = LabelSelectorRequirement{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_core_core_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -830,13 +1161,13 @@ func (x *RoleBindingList) Reset() {
 	}
 }
 
-func (x *RoleBindingList) String() string {
+func (x *LabelSelectorRequirement) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RoleBindingList) ProtoMessage() {}
+func (*LabelSelectorRequirement) ProtoMessage() {}
 
-func (x *RoleBindingList) ProtoReflect() protoreflect.Message {
+func (x *LabelSelectorRequirement) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_core_core_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,48 +1179,761 @@ func (x *RoleBindingList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RoleBindingList.ProtoReflect.Descriptor instead.
-func (*RoleBindingList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LabelSelectorRequirement.ProtoReflect.Descriptor instead.
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
 	return file_pkg_core_core_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RoleBindingList) GetItems() []*RoleBinding {
+func (x *LabelSelectorRequirement) GetKey() string {
 	if x != nil {
-		return x.Items
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *LabelSelectorRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-type CertInfo struct {
+type Role struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Issuer      string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	Subject     string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	IsCA        bool   `protobuf:"varint,3,opt,name=isCA,proto3" json:"isCA,omitempty"`
-	NotBefore   string `protobuf:"bytes,4,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
-	NotAfter    string `protobuf:"bytes,5,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
-	Fingerprint string `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Id          string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ClusterIDs  []string       `protobuf:"bytes,2,rep,name=clusterIDs,proto3" json:"clusterIDs,omitempty"`
+	MatchLabels *LabelSelector `protobuf:"bytes,3,opt,name=matchLabels,proto3" json:"matchLabels,omitempty"`
+	Metadata    *RoleMetadata  `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *Role) Reset() {
+	*x = Role{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Role) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Role) ProtoMessage() {}
+
+func (x *Role) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Role.ProtoReflect.Descriptor instead.
+func (*Role) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Role) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Role) GetClusterIDs() []string {
+	if x != nil {
+		return x.ClusterIDs
+	}
+	return nil
+}
+
+func (x *Role) GetMatchLabels() *LabelSelector {
+	if x != nil {
+		return x.MatchLabels
+	}
+	return nil
+}
+
+func (x *Role) GetMetadata() *RoleMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RoleMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceVersion   string                 `protobuf:"bytes,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastModified      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lastModified,proto3" json:"lastModified,omitempty"`
+}
+
+func (x *RoleMetadata) Reset() {
+	*x = RoleMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleMetadata) ProtoMessage() {}
+
+func (x *RoleMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleMetadata.ProtoReflect.Descriptor instead.
+func (*RoleMetadata) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RoleMetadata) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *RoleMetadata) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return nil
+}
+
+func (x *RoleMetadata) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+type RoleBinding struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RoleId   string               `protobuf:"bytes,2,opt,name=roleId,proto3" json:"roleId,omitempty"`
+	Subjects []string             `protobuf:"bytes,3,rep,name=subjects,proto3" json:"subjects,omitempty"`
+	Taints   []string             `protobuf:"bytes,4,rep,name=taints,proto3" json:"taints,omitempty"`
+	Metadata *RoleBindingMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *RoleBinding) Reset() {
+	*x = RoleBinding{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBinding) ProtoMessage() {}
+
+func (x *RoleBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBinding.ProtoReflect.Descriptor instead.
+func (*RoleBinding) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RoleBinding) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetRoleId() string {
+	if x != nil {
+		return x.RoleId
+	}
+	return ""
+}
+
+func (x *RoleBinding) GetSubjects() []string {
+	if x != nil {
+		return x.Subjects
+	}
+	return nil
+}
+
+func (x *RoleBinding) GetTaints() []string {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *RoleBinding) GetMetadata() *RoleBindingMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type RoleBindingMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceVersion   string                 `protobuf:"bytes,1,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastModified      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=lastModified,proto3" json:"lastModified,omitempty"`
+}
+
+func (x *RoleBindingMetadata) Reset() {
+	*x = RoleBindingMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingMetadata) ProtoMessage() {}
+
+func (x *RoleBindingMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingMetadata.ProtoReflect.Descriptor instead.
+func (*RoleBindingMetadata) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RoleBindingMetadata) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+func (x *RoleBindingMetadata) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return nil
+}
+
+func (x *RoleBindingMetadata) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
+type RoleList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*Role `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string  `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *RoleList) Reset() {
+	*x = RoleList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleList) ProtoMessage() {}
+
+func (x *RoleList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleList.ProtoReflect.Descriptor instead.
+func (*RoleList) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RoleList) GetItems() []*Role {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RoleList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RoleBindingList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*RoleBinding `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *RoleBindingList) Reset() {
+	*x = RoleBindingList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RoleBindingList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleBindingList) ProtoMessage() {}
+
+func (x *RoleBindingList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleBindingList.ProtoReflect.Descriptor instead.
+func (*RoleBindingList) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RoleBindingList) GetItems() []*RoleBinding {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RoleBindingList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CertInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer      string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Subject     string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	IsCA        bool   `protobuf:"varint,3,opt,name=isCA,proto3" json:"isCA,omitempty"`
+	NotBefore   string `protobuf:"bytes,4,opt,name=notBefore,proto3" json:"notBefore,omitempty"`
+	NotAfter    string `protobuf:"bytes,5,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	Fingerprint string `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+}
+
+func (x *CertInfo) Reset() {
+	*x = CertInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CertInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CertInfo) ProtoMessage() {}
+
+func (x *CertInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CertInfo.ProtoReflect.Descriptor instead.
+func (*CertInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CertInfo) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *CertInfo) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *CertInfo) GetIsCA() bool {
+	if x != nil {
+		return x.IsCA
+	}
+	return false
+}
+
+func (x *CertInfo) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *CertInfo) GetNotAfter() string {
+	if x != nil {
+		return x.NotAfter
+	}
+	return ""
+}
+
+func (x *CertInfo) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+type Reference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Reference) Reset() {
+	*x = Reference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference) ProtoMessage() {}
+
+func (x *Reference) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
+func (*Reference) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Reference) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ReferenceList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Reference `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ReferenceList) Reset() {
+	*x = ReferenceList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceList) ProtoMessage() {}
+
+func (x *ReferenceList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReferenceList.ProtoReflect.Descriptor instead.
+func (*ReferenceList) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReferenceList) GetItems() []*Reference {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type SubjectAccessRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *SubjectAccessRequest) Reset() {
+	*x = SubjectAccessRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubjectAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubjectAccessRequest) ProtoMessage() {}
+
+func (x *SubjectAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubjectAccessRequest.ProtoReflect.Descriptor instead.
+func (*SubjectAccessRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubjectAccessRequest) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Operation string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Caller    *AuditCaller           `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	Request   string                 `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	Result    *AuditResult           `protobuf:"bytes,6,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_core_core_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AuditEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetCaller() *AuditCaller {
+	if x != nil {
+		return x.Caller
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetRequest() string {
+	if x != nil {
+		return x.Request
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetResult() *AuditResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type AuditCaller struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	UserAgent    string `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	ForwardedFor string `protobuf:"bytes,3,opt,name=forwardedFor,proto3" json:"forwardedFor,omitempty"`
+	TokenID      string `protobuf:"bytes,4,opt,name=tokenID,proto3" json:"tokenID,omitempty"`
 }
 
-func (x *CertInfo) Reset() {
-	*x = CertInfo{}
+func (x *AuditCaller) Reset() {
+	*x = AuditCaller{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[14]
+		mi := &file_pkg_core_core_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CertInfo) String() string {
+func (x *AuditCaller) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CertInfo) ProtoMessage() {}
+func (*AuditCaller) ProtoMessage() {}
 
-func (x *CertInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[14]
+func (x *AuditCaller) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -900,78 +1944,65 @@ func (x *CertInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CertInfo.ProtoReflect.Descriptor instead.
-func (*CertInfo) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *CertInfo) GetIssuer() string {
-	if x != nil {
-		return x.Issuer
-	}
-	return ""
+// Deprecated: Use AuditCaller.ProtoReflect.Descriptor instead.
+func (*AuditCaller) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CertInfo) GetSubject() string {
+func (x *AuditCaller) GetAddress() string {
 	if x != nil {
-		return x.Subject
+		return x.Address
 	}
 	return ""
 }
 
-func (x *CertInfo) GetIsCA() bool {
-	if x != nil {
-		return x.IsCA
-	}
-	return false
-}
-
-func (x *CertInfo) GetNotBefore() string {
+func (x *AuditCaller) GetUserAgent() string {
 	if x != nil {
-		return x.NotBefore
+		return x.UserAgent
 	}
 	return ""
 }
 
-func (x *CertInfo) GetNotAfter() string {
+func (x *AuditCaller) GetForwardedFor() string {
 	if x != nil {
-		return x.NotAfter
+		return x.ForwardedFor
 	}
 	return ""
 }
 
-func (x *CertInfo) GetFingerprint() string {
+func (x *AuditCaller) GetTokenID() string {
 	if x != nil {
-		return x.Fingerprint
+		return x.TokenID
 	}
 	return ""
 }
 
-type Reference struct {
+type AuditResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code    string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *Reference) Reset() {
-	*x = Reference{}
+func (x *AuditResult) Reset() {
+	*x = AuditResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[15]
+		mi := &file_pkg_core_core_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Reference) String() string {
+func (x *AuditResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Reference) ProtoMessage() {}
+func (*AuditResult) ProtoMessage() {}
 
-func (x *Reference) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[15]
+func (x *AuditResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -982,43 +2013,51 @@ func (x *Reference) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Reference.ProtoReflect.Descriptor instead.
-func (*Reference) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AuditResult.ProtoReflect.Descriptor instead.
+func (*AuditResult) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Reference) GetId() string {
+func (x *AuditResult) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
 	return ""
 }
 
-type ReferenceList struct {
+func (x *AuditResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AuditEventList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*Reference `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Items         []*AuditEvent `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
-func (x *ReferenceList) Reset() {
-	*x = ReferenceList{}
+func (x *AuditEventList) Reset() {
+	*x = AuditEventList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[16]
+		mi := &file_pkg_core_core_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReferenceList) String() string {
+func (x *AuditEventList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReferenceList) ProtoMessage() {}
+func (*AuditEventList) ProtoMessage() {}
 
-func (x *ReferenceList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[16]
+func (x *AuditEventList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1029,43 +2068,56 @@ func (x *ReferenceList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReferenceList.ProtoReflect.Descriptor instead.
-func (*ReferenceList) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AuditEventList.ProtoReflect.Descriptor instead.
+func (*AuditEventList) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *ReferenceList) GetItems() []*Reference {
+func (x *AuditEventList) GetItems() []*AuditEvent {
 	if x != nil {
 		return x.Items
 	}
 	return nil
 }
 
-type SubjectAccessRequest struct {
+func (x *AuditEventList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CapabilityStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subject string `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cluster           *Reference             `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	State             TaskState              `protobuf:"varint,3,opt,name=state,proto3,enum=core.TaskState" json:"state,omitempty"`
+	Attempts          int32                  `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError         string                 `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	LastModified      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=lastModified,proto3" json:"lastModified,omitempty"`
 }
 
-func (x *SubjectAccessRequest) Reset() {
-	*x = SubjectAccessRequest{}
+func (x *CapabilityStatus) Reset() {
+	*x = CapabilityStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_core_core_proto_msgTypes[17]
+		mi := &file_pkg_core_core_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubjectAccessRequest) String() string {
+func (x *CapabilityStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubjectAccessRequest) ProtoMessage() {}
+func (*CapabilityStatus) ProtoMessage() {}
 
-func (x *SubjectAccessRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_core_core_proto_msgTypes[17]
+func (x *CapabilityStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_core_core_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1076,134 +2128,337 @@ func (x *SubjectAccessRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubjectAccessRequest.ProtoReflect.Descriptor instead.
-func (*SubjectAccessRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_core_core_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CapabilityStatus.ProtoReflect.Descriptor instead.
+func (*CapabilityStatus) Descriptor() ([]byte, []int) {
+	return file_pkg_core_core_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *SubjectAccessRequest) GetSubject() string {
+func (x *CapabilityStatus) GetName() string {
 	if x != nil {
-		return x.Subject
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CapabilityStatus) GetCluster() *Reference {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *CapabilityStatus) GetState() TaskState {
+	if x != nil {
+		return x.State
+	}
+	return TaskState_TaskPending
+}
+
+func (x *CapabilityStatus) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *CapabilityStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
 	}
 	return ""
 }
 
+func (x *CapabilityStatus) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return nil
+}
+
+func (x *CapabilityStatus) GetLastModified() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastModified
+	}
+	return nil
+}
+
 var File_pkg_core_core_proto protoreflect.FileDescriptor
 
 var file_pkg_core_core_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x69, 0x0a, 0x0e, 0x42,
-	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x11, 0x0a,
-	0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00,
-	0x12, 0x10, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x6f, 0x72, 0x65, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a, 0x0e,
+	0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x11,
+	0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x12, 0x10, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x00, 0x12, 0x30, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x9b, 0x02, 0x0a, 0x16, 0x42, 0x6f, 0x6f, 0x74,
 	0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xf6, 0x01, 0x0a, 0x16, 0x42, 0x6f, 0x6f, 0x74, 0x73,
-	0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x11, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x00, 0x12, 0x0d, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x00, 0x12, 0x14, 0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
-	0x42, 0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22,
-	0x49, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x12, 0x0e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x00, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x3d, 0x0a, 0x12, 0x42, 0x6f,
-	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x44, 0x0a, 0x07, 0x43, 0x6c, 0x75,
+	0x74, 0x61, 0x12, 0x11, 0x0a, 0x07, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x00, 0x12, 0x0d, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x00, 0x12, 0x14, 0x0a, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x42, 0x00, 0x12, 0x23, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x42, 0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x4f, 0x0a, 0x0b, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x73, 0x12, 0x13, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x00, 0x12, 0x16, 0x0a, 0x0c, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x43, 0x49, 0x44, 0x52, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x00, 0x12, 0x11, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x49, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x24, 0x0a, 0x09, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a,
+	0x00, 0x22, 0x56, 0x0a, 0x12, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f,
+	0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x00, 0x12, 0x17,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x6b, 0x0a, 0x07, 0x43, 0x6c, 0x75,
 	0x73, 0x74, 0x65, 0x72, 0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x00, 0x12, 0x29, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x00, 0x3a, 0x00, 0x22,
-	0xb2, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
-	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
-	0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62,
-	0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x3a, 0x00, 0x22, 0x25, 0x0a, 0x11, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x0e, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x2f, 0x0a, 0x0b, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xc8, 0x01, 0x0a,
-	0x0d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x39,
-	0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x10, 0x6d, 0x61, 0x74,
-	0x63, 0x68, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x42, 0x00, 0x1a, 0x3e, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x51, 0x0a, 0x18, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x0d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x00, 0x12, 0x12, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x10, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x58, 0x0a, 0x04, 0x52, 0x6f,
+	0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x00, 0x12, 0x25, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xe5, 0x02, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2f, 0x0a,
+	0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x00, 0x12, 0x19,
+	0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x00, 0x12, 0x32, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x42,
+	0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0xb6,
+	0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x00, 0x12, 0x1d, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11, 0x0a, 0x07, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x36, 0x0a,
+	0x10, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xc8, 0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x0c, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x12, 0x2b, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x00, 0x12, 0x37,
+	0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x12, 0x28, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x53,
+	0x79, 0x6e, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
+	0x00, 0x12, 0x36, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x00, 0x12, 0x2e, 0x0a, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x12, 0x25, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x00,
+	0x3a, 0x00, 0x22, 0x85, 0x01, 0x0a, 0x0e, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x79, 0x6e, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x00, 0x12, 0x10, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x25, 0x0a, 0x11, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12,
+	0x0e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a,
+	0x00, 0x22, 0x48, 0x0a, 0x0b, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x00,
+	0x12, 0x17, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xc8, 0x01, 0x0a, 0x0d,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x39, 0x0a,
+	0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3a, 0x0a, 0x10, 0x6d, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x42, 0x00, 0x1a, 0x3e, 0x0a, 0x10, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x51, 0x0a, 0x18, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x0d, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x12, 0x12, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x10, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x80, 0x01, 0x0a, 0x04, 0x52, 0x6f,
 	0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00,
 	0x12, 0x14, 0x0a, 0x0a, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x12, 0x2a, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c,
 	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x42, 0x00, 0x3a, 0x00, 0x22, 0x55, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x00, 0x12, 0x10, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x00, 0x12, 0x12, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x12, 0x10, 0x0a, 0x06, 0x74, 0x61, 0x69, 0x6e, 0x74,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x29, 0x0a, 0x08, 0x52,
-	0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f,
-	0x6c, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x37, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65,
-	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
-	0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x00, 0x3a, 0x00, 0x22,
-	0x81, 0x01, 0x0a, 0x08, 0x43, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x06,
-	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11,
-	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x00, 0x12, 0x0e, 0x0a, 0x04, 0x69, 0x73, 0x43, 0x41, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x00, 0x12, 0x13, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x12, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74,
-	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x15, 0x0a, 0x0b, 0x66, 0x69,
-	0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x00, 0x3a, 0x00, 0x22, 0x1b, 0x0a, 0x09, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00,
-	0x22, 0x33, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x2b, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x11, 0x0a,
-	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00,
-	0x3a, 0x00, 0x2a, 0x3b, 0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12,
-	0x1c, 0x0a, 0x18, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x4d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x1a, 0x00, 0x42,
-	0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61,
-	0x6e, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x6e, 0x69, 0x2d, 0x6d, 0x6f, 0x6e, 0x69, 0x74,
-	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x42, 0x00, 0x12, 0x26, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x98, 0x01, 0x0a,
+	0x0c, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a,
+	0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x00, 0x12, 0x32, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x84, 0x01, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x10, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x12, 0x0a, 0x08, 0x73, 0x75, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x12, 0x10, 0x0a, 0x06, 0x74,
+	0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x12, 0x2d, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x9f,
+	0x01, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x12, 0x37, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x12, 0x32, 0x0a, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x3a, 0x00,
+	0x22, 0x42, 0x0a, 0x08, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x00, 0x12, 0x17, 0x0a, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x00, 0x3a, 0x00, 0x22, 0x50, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x00, 0x12, 0x17, 0x0a, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x81, 0x01, 0x0a, 0x08, 0x43, 0x65, 0x72, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x0e, 0x0a, 0x04, 0x69, 0x73, 0x43, 0x41,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x12, 0x0a,
+	0x08, 0x6e, 0x6f, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x12, 0x15, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x1b, 0x0a, 0x09, 0x52, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x33, 0x0a, 0x0d, 0x52, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x2b, 0x0a, 0x14,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x11, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xbf, 0x01, 0x0a, 0x0a, 0x41, 0x75,
+	0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0c, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x23, 0x0a, 0x06,
+	0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x42,
+	0x00, 0x12, 0x11, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x00, 0x12, 0x23, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x62, 0x0a, 0x0b, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x11, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x13, 0x0a,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x00, 0x12, 0x16, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x46,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11, 0x0a, 0x07, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22,
+	0x32, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x3a, 0x00, 0x22, 0x4e, 0x0a, 0x0e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69,
+	0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x00, 0x12, 0x17, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74,
+	0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x3a, 0x00, 0x22, 0x80, 0x02, 0x0a, 0x10, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x12, 0x20, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x00, 0x12, 0x12,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x37, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00,
+	0x12, 0x32, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x00, 0x3a, 0x00, 0x2a, 0x57, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x02, 0x1a, 0x00, 0x2a,
+	0x6d, 0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x12, 0x18, 0x0a, 0x14, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x10, 0x01, 0x12, 0x13,
+	0x0a, 0x0f, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x64, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x10, 0x03, 0x1a, 0x00, 0x2a, 0x3b,
+	0x0a, 0x0c, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x0b,
+	0x0a, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x4d, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x73, 0x4e, 0x6f, 0x6e, 0x65, 0x10, 0x01, 0x1a, 0x00, 0x2a, 0x2c, 0x0a, 0x09, 0x53,
+	0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x65, 0x73, 0x63, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x1a, 0x00, 0x2a, 0x52, 0x0a, 0x09, 0x54, 0x61, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x50, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x54, 0x61, 0x73, 0x6b, 0x52,
+	0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x54,
+	0x61, 0x73, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x1a, 0x00, 0x42, 0x2d, 0x5a,
+	0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x6e, 0x69, 0x2d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72,
+	0x69, 0x6e, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1218,53 +2473,98 @@ func file_pkg_core_core_proto_rawDescGZIP() []byte {
 	return file_pkg_core_core_proto_rawDescData
 }
 
-var file_pkg_core_core_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_core_core_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
+var file_pkg_core_core_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_pkg_core_core_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_pkg_core_core_proto_goTypes = []interface{}{
-	(MatchOptions)(0),                // 0: core.MatchOptions
-	(*BootstrapToken)(nil),           // 1: core.BootstrapToken
-	(*BootstrapTokenMetadata)(nil),   // 2: core.BootstrapTokenMetadata
-	(*TokenCapability)(nil),          // 3: core.TokenCapability
-	(*BootstrapTokenList)(nil),       // 4: core.BootstrapTokenList
-	(*Cluster)(nil),                  // 5: core.Cluster
-	(*ClusterMetadata)(nil),          // 6: core.ClusterMetadata
-	(*ClusterCapability)(nil),        // 7: core.ClusterCapability
-	(*ClusterList)(nil),              // 8: core.ClusterList
-	(*LabelSelector)(nil),            // 9: core.LabelSelector
-	(*LabelSelectorRequirement)(nil), // 10: core.LabelSelectorRequirement
-	(*Role)(nil),                     // 11: core.Role
-	(*RoleBinding)(nil),              // 12: core.RoleBinding
-	(*RoleList)(nil),                 // 13: core.RoleList
-	(*RoleBindingList)(nil),          // 14: core.RoleBindingList
-	(*CertInfo)(nil),                 // 15: core.CertInfo
-	(*Reference)(nil),                // 16: core.Reference
-	(*ReferenceList)(nil),            // 17: core.ReferenceList
-	(*SubjectAccessRequest)(nil),     // 18: core.SubjectAccessRequest
-	nil,                              // 19: core.BootstrapTokenMetadata.LabelsEntry
-	nil,                              // 20: core.ClusterMetadata.LabelsEntry
-	nil,                              // 21: core.LabelSelector.MatchLabelsEntry
+	(ApprovalState)(0),               // 0: core.ApprovalState
+	(ClusterHealth)(0),               // 1: core.ClusterHealth
+	(MatchOptions)(0),                // 2: core.MatchOptions
+	(SortOrder)(0),                   // 3: core.SortOrder
+	(TaskState)(0),                   // 4: core.TaskState
+	(*BootstrapToken)(nil),           // 5: core.BootstrapToken
+	(*BootstrapTokenMetadata)(nil),   // 6: core.BootstrapTokenMetadata
+	(*TokenLimits)(nil),              // 7: core.TokenLimits
+	(*TokenCapability)(nil),          // 8: core.TokenCapability
+	(*BootstrapTokenList)(nil),       // 9: core.BootstrapTokenList
+	(*Cluster)(nil),                  // 10: core.Cluster
+	(*ClusterMetadata)(nil),          // 11: core.ClusterMetadata
+	(*ClusterApproval)(nil),          // 12: core.ClusterApproval
+	(*ClusterStatus)(nil),            // 13: core.ClusterStatus
+	(*RuleSyncStatus)(nil),           // 14: core.RuleSyncStatus
+	(*ClusterCapability)(nil),        // 15: core.ClusterCapability
+	(*ClusterList)(nil),              // 16: core.ClusterList
+	(*LabelSelector)(nil),            // 17: core.LabelSelector
+	(*LabelSelectorRequirement)(nil), // 18: core.LabelSelectorRequirement
+	(*Role)(nil),                     // 19: core.Role
+	(*RoleMetadata)(nil),             // 20: core.RoleMetadata
+	(*RoleBinding)(nil),              // 21: core.RoleBinding
+	(*RoleBindingMetadata)(nil),      // 22: core.RoleBindingMetadata
+	(*RoleList)(nil),                 // 23: core.RoleList
+	(*RoleBindingList)(nil),          // 24: core.RoleBindingList
+	(*CertInfo)(nil),                 // 25: core.CertInfo
+	(*Reference)(nil),                // 26: core.Reference
+	(*ReferenceList)(nil),            // 27: core.ReferenceList
+	(*SubjectAccessRequest)(nil),     // 28: core.SubjectAccessRequest
+	(*AuditEvent)(nil),               // 29: core.AuditEvent
+	(*AuditCaller)(nil),              // 30: core.AuditCaller
+	(*AuditResult)(nil),              // 31: core.AuditResult
+	(*AuditEventList)(nil),           // 32: core.AuditEventList
+	(*CapabilityStatus)(nil),         // 33: core.CapabilityStatus
+	nil,                              // 34: core.BootstrapTokenMetadata.LabelsEntry
+	nil,                              // 35: core.ClusterMetadata.LabelsEntry
+	nil,                              // 36: core.LabelSelector.MatchLabelsEntry
+	(*timestamppb.Timestamp)(nil),    // 37: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),      // 38: google.protobuf.Duration
 }
 var file_pkg_core_core_proto_depIdxs = []int32{
-	2,  // 0: core.BootstrapToken.metadata:type_name -> core.BootstrapTokenMetadata
-	19, // 1: core.BootstrapTokenMetadata.labels:type_name -> core.BootstrapTokenMetadata.LabelsEntry
-	3,  // 2: core.BootstrapTokenMetadata.capabilities:type_name -> core.TokenCapability
-	16, // 3: core.TokenCapability.reference:type_name -> core.Reference
-	1,  // 4: core.BootstrapTokenList.items:type_name -> core.BootstrapToken
-	6,  // 5: core.Cluster.metadata:type_name -> core.ClusterMetadata
-	20, // 6: core.ClusterMetadata.labels:type_name -> core.ClusterMetadata.LabelsEntry
-	7,  // 7: core.ClusterMetadata.capabilities:type_name -> core.ClusterCapability
-	5,  // 8: core.ClusterList.items:type_name -> core.Cluster
-	21, // 9: core.LabelSelector.matchLabels:type_name -> core.LabelSelector.MatchLabelsEntry
-	10, // 10: core.LabelSelector.matchExpressions:type_name -> core.LabelSelectorRequirement
-	9,  // 11: core.Role.matchLabels:type_name -> core.LabelSelector
-	11, // 12: core.RoleList.items:type_name -> core.Role
-	12, // 13: core.RoleBindingList.items:type_name -> core.RoleBinding
-	16, // 14: core.ReferenceList.items:type_name -> core.Reference
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	6,  // 0: core.BootstrapToken.metadata:type_name -> core.BootstrapTokenMetadata
+	34, // 1: core.BootstrapTokenMetadata.labels:type_name -> core.BootstrapTokenMetadata.LabelsEntry
+	8,  // 2: core.BootstrapTokenMetadata.capabilities:type_name -> core.TokenCapability
+	7,  // 3: core.BootstrapTokenMetadata.limits:type_name -> core.TokenLimits
+	26, // 4: core.TokenCapability.reference:type_name -> core.Reference
+	5,  // 5: core.BootstrapTokenList.items:type_name -> core.BootstrapToken
+	11, // 6: core.Cluster.metadata:type_name -> core.ClusterMetadata
+	13, // 7: core.Cluster.status:type_name -> core.ClusterStatus
+	35, // 8: core.ClusterMetadata.labels:type_name -> core.ClusterMetadata.LabelsEntry
+	15, // 9: core.ClusterMetadata.capabilities:type_name -> core.ClusterCapability
+	37, // 10: core.ClusterMetadata.creationTimestamp:type_name -> google.protobuf.Timestamp
+	37, // 11: core.ClusterMetadata.lastModified:type_name -> google.protobuf.Timestamp
+	12, // 12: core.ClusterMetadata.approval:type_name -> core.ClusterApproval
+	0,  // 13: core.ClusterApproval.state:type_name -> core.ApprovalState
+	37, // 14: core.ClusterApproval.requestTimestamp:type_name -> google.protobuf.Timestamp
+	38, // 15: core.ClusterStatus.uptime:type_name -> google.protobuf.Duration
+	37, // 16: core.ClusterStatus.lastPushTimestamp:type_name -> google.protobuf.Timestamp
+	14, // 17: core.ClusterStatus.ruleSync:type_name -> core.RuleSyncStatus
+	38, // 18: core.ClusterStatus.heartbeatInterval:type_name -> google.protobuf.Duration
+	37, // 19: core.ClusterStatus.lastSeen:type_name -> google.protobuf.Timestamp
+	1,  // 20: core.ClusterStatus.health:type_name -> core.ClusterHealth
+	37, // 21: core.RuleSyncStatus.lastSyncTimestamp:type_name -> google.protobuf.Timestamp
+	10, // 22: core.ClusterList.items:type_name -> core.Cluster
+	36, // 23: core.LabelSelector.matchLabels:type_name -> core.LabelSelector.MatchLabelsEntry
+	18, // 24: core.LabelSelector.matchExpressions:type_name -> core.LabelSelectorRequirement
+	17, // 25: core.Role.matchLabels:type_name -> core.LabelSelector
+	20, // 26: core.Role.metadata:type_name -> core.RoleMetadata
+	37, // 27: core.RoleMetadata.creationTimestamp:type_name -> google.protobuf.Timestamp
+	37, // 28: core.RoleMetadata.lastModified:type_name -> google.protobuf.Timestamp
+	22, // 29: core.RoleBinding.metadata:type_name -> core.RoleBindingMetadata
+	37, // 30: core.RoleBindingMetadata.creationTimestamp:type_name -> google.protobuf.Timestamp
+	37, // 31: core.RoleBindingMetadata.lastModified:type_name -> google.protobuf.Timestamp
+	19, // 32: core.RoleList.items:type_name -> core.Role
+	21, // 33: core.RoleBindingList.items:type_name -> core.RoleBinding
+	26, // 34: core.ReferenceList.items:type_name -> core.Reference
+	37, // 35: core.AuditEvent.timestamp:type_name -> google.protobuf.Timestamp
+	30, // 36: core.AuditEvent.caller:type_name -> core.AuditCaller
+	31, // 37: core.AuditEvent.result:type_name -> core.AuditResult
+	29, // 38: core.AuditEventList.items:type_name -> core.AuditEvent
+	26, // 39: core.CapabilityStatus.cluster:type_name -> core.Reference
+	4,  // 40: core.CapabilityStatus.state:type_name -> core.TaskState
+	37, // 41: core.CapabilityStatus.creationTimestamp:type_name -> google.protobuf.Timestamp
+	37, // 42: core.CapabilityStatus.lastModified:type_name -> google.protobuf.Timestamp
+	43, // [43:43] is the sub-list for method output_type
+	43, // [43:43] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
 }
 
 func init() { file_pkg_core_core_proto_init() }
@@ -1298,7 +2598,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenCapability); i {
+			switch v := v.(*TokenLimits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1310,7 +2610,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BootstrapTokenList); i {
+			switch v := v.(*TokenCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1322,7 +2622,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Cluster); i {
+			switch v := v.(*BootstrapTokenList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1334,7 +2634,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterMetadata); i {
+			switch v := v.(*Cluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1346,7 +2646,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterCapability); i {
+			switch v := v.(*ClusterMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1358,7 +2658,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterList); i {
+			switch v := v.(*ClusterApproval); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1370,7 +2670,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LabelSelector); i {
+			switch v := v.(*ClusterStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1382,7 +2682,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LabelSelectorRequirement); i {
+			switch v := v.(*RuleSyncStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1394,7 +2694,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Role); i {
+			switch v := v.(*ClusterCapability); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1406,7 +2706,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleBinding); i {
+			switch v := v.(*ClusterList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1418,7 +2718,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleList); i {
+			switch v := v.(*LabelSelector); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1430,7 +2730,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RoleBindingList); i {
+			switch v := v.(*LabelSelectorRequirement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1442,7 +2742,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CertInfo); i {
+			switch v := v.(*Role); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1454,7 +2754,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Reference); i {
+			switch v := v.(*RoleMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,7 +2766,7 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReferenceList); i {
+			switch v := v.(*RoleBinding); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1478,6 +2778,78 @@ func file_pkg_core_core_proto_init() {
 			}
 		}
 		file_pkg_core_core_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleBindingList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CertInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReferenceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubjectAccessRequest); i {
 			case 0:
 				return &v.state
@@ -1489,14 +2861,74 @@ func file_pkg_core_core_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_core_core_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditCaller); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEventList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_core_core_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilityStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_core_core_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   21,
+			NumEnums:      5,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,5 +1,7 @@
 package core
 
+import "google.golang.org/protobuf/types/known/timestamppb"
+
 type Capability[T any] interface {
 	Comparator[T]
 }
@@ -11,6 +13,23 @@ type MetadataAccessor[T Capability[T]] interface {
 	SetLabels(labels map[string]string)
 }
 
+// VersionedObject is implemented by objects whose metadata contains a resource
+// version and timestamps which are managed by the storage backend.
+type VersionedObject interface {
+	GetResourceVersion() string
+	SetResourceVersion(version string)
+	GetCreationTimestamp() *timestamppb.Timestamp
+	SetCreationTimestamp(ts *timestamppb.Timestamp)
+	GetLastModified() *timestamppb.Timestamp
+	SetLastModified(ts *timestamppb.Timestamp)
+}
+
+var (
+	_ VersionedObject = (*Cluster)(nil)
+	_ VersionedObject = (*Role)(nil)
+	_ VersionedObject = (*RoleBinding)(nil)
+)
+
 func (t *BootstrapToken) GetCapabilities() []*TokenCapability {
 	return t.GetMetadata().GetCapabilities()
 }
@@ -54,3 +73,102 @@ func (c *Cluster) SetLabels(labels map[string]string) {
 	}
 	c.Metadata.Labels = labels
 }
+
+func (c *Cluster) GetResourceVersion() string {
+	return c.GetMetadata().GetResourceVersion()
+}
+
+func (r *Role) GetResourceVersion() string {
+	return r.GetMetadata().GetResourceVersion()
+}
+
+func (rb *RoleBinding) GetResourceVersion() string {
+	return rb.GetMetadata().GetResourceVersion()
+}
+
+func (c *Cluster) SetResourceVersion(version string) {
+	if c.Metadata == nil {
+		c.Metadata = &ClusterMetadata{}
+	}
+	c.Metadata.ResourceVersion = version
+}
+
+func (r *Role) SetResourceVersion(version string) {
+	if r.Metadata == nil {
+		r.Metadata = &RoleMetadata{}
+	}
+	r.Metadata.ResourceVersion = version
+}
+
+func (rb *RoleBinding) SetResourceVersion(version string) {
+	if rb.Metadata == nil {
+		rb.Metadata = &RoleBindingMetadata{}
+	}
+	rb.Metadata.ResourceVersion = version
+}
+
+func (c *Cluster) GetCreationTimestamp() *timestamppb.Timestamp {
+	return c.GetMetadata().GetCreationTimestamp()
+}
+
+func (r *Role) GetCreationTimestamp() *timestamppb.Timestamp {
+	return r.GetMetadata().GetCreationTimestamp()
+}
+
+func (rb *RoleBinding) GetCreationTimestamp() *timestamppb.Timestamp {
+	return rb.GetMetadata().GetCreationTimestamp()
+}
+
+func (c *Cluster) SetCreationTimestamp(ts *timestamppb.Timestamp) {
+	if c.Metadata == nil {
+		c.Metadata = &ClusterMetadata{}
+	}
+	c.Metadata.CreationTimestamp = ts
+}
+
+func (r *Role) SetCreationTimestamp(ts *timestamppb.Timestamp) {
+	if r.Metadata == nil {
+		r.Metadata = &RoleMetadata{}
+	}
+	r.Metadata.CreationTimestamp = ts
+}
+
+func (rb *RoleBinding) SetCreationTimestamp(ts *timestamppb.Timestamp) {
+	if rb.Metadata == nil {
+		rb.Metadata = &RoleBindingMetadata{}
+	}
+	rb.Metadata.CreationTimestamp = ts
+}
+
+func (c *Cluster) GetLastModified() *timestamppb.Timestamp {
+	return c.GetMetadata().GetLastModified()
+}
+
+func (r *Role) GetLastModified() *timestamppb.Timestamp {
+	return r.GetMetadata().GetLastModified()
+}
+
+func (rb *RoleBinding) GetLastModified() *timestamppb.Timestamp {
+	return rb.GetMetadata().GetLastModified()
+}
+
+func (c *Cluster) SetLastModified(ts *timestamppb.Timestamp) {
+	if c.Metadata == nil {
+		c.Metadata = &ClusterMetadata{}
+	}
+	c.Metadata.LastModified = ts
+}
+
+func (r *Role) SetLastModified(ts *timestamppb.Timestamp) {
+	if r.Metadata == nil {
+		r.Metadata = &RoleMetadata{}
+	}
+	r.Metadata.LastModified = ts
+}
+
+func (rb *RoleBinding) SetLastModified(ts *timestamppb.Timestamp) {
+	if rb.Metadata == nil {
+		rb.Metadata = &RoleBindingMetadata{}
+	}
+	rb.Metadata.LastModified = ts
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"net"
 
 	"github.com/rancher/opni-monitoring/pkg/validation"
 )
@@ -136,6 +137,21 @@ func (tc *TokenCapability) Validate() error {
 	return nil
 }
 
+func (l *TokenLimits) Validate() error {
+	if l.MaxUsages < 0 {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidValue, "maxUsages cannot be negative")
+	}
+	if l.OneTime && l.MaxUsages > 1 {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidValue, "one-time tokens cannot have more than one usage")
+	}
+	for _, cidr := range l.AllowedCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("%w (allowedCIDRs): %s", validation.ErrInvalidValue, err.Error())
+		}
+	}
+	return nil
+}
+
 func (cc *ClusterCapability) Validate() error {
 	if cc.Name == "" {
 		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "name")
@@ -14,11 +14,18 @@ import (
 	"github.com/gofiber/fiber/v2/middleware/limiter"
 	"github.com/gofiber/fiber/v2/middleware/monitor"
 	"github.com/prometheus/client_golang/prometheus"
+	bootstrapv1 "github.com/rancher/opni-monitoring/pkg/apis/bootstrap/v1"
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
+	"github.com/rancher/opni-monitoring/pkg/audit"
 	"github.com/rancher/opni-monitoring/pkg/auth"
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
 	"github.com/rancher/opni-monitoring/pkg/bootstrap"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/control"
+	"github.com/rancher/opni-monitoring/pkg/health"
 	"github.com/rancher/opni-monitoring/pkg/logger"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
 	"github.com/rancher/opni-monitoring/pkg/plugins/apis/apiextensions"
 	"github.com/rancher/opni-monitoring/pkg/plugins/meta"
 	"github.com/rancher/opni-monitoring/pkg/storage"
@@ -26,6 +33,9 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/util/fwd"
 	"github.com/rancher/opni-monitoring/pkg/util/waitctx"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 )
 
 var (
@@ -40,6 +50,9 @@ var (
 	}
 )
 
+// Maximum number of bootstrap requests accepted from each client address
+const bootstrapRequestsPerMinute = 60
+
 type GatewayAPIServer struct {
 	APIServerOptions
 	app            *fiber.App
@@ -48,6 +61,8 @@ type GatewayAPIServer struct {
 	tlsConfig      *tls.Config
 	wait           chan struct{}
 	metricsHandler *MetricsEndpointHandler
+	grpcServer     *grpc.Server
+	controlServer  *control.Server
 
 	reservedPrefixRoutes []string
 }
@@ -218,6 +233,23 @@ func (s *GatewayAPIServer) ListenAndServe() error {
 	if err != nil {
 		return err
 	}
+	if s.grpcServer != nil {
+		grpcListener, err := net.Listen("tcp4", s.conf.GRPCListenAddress)
+		if err != nil {
+			listener.Close()
+			return err
+		}
+		s.logger.With(
+			"address", grpcListener.Addr().String(),
+		).Info("grpc server starting")
+		go func() {
+			if err := s.grpcServer.Serve(grpcListener); err != nil {
+				s.logger.With(
+					zap.Error(err),
+				).Error("grpc server stopped")
+			}
+		}()
+	}
 	info, _ := debug.ReadBuildInfo()
 	s.logger.With(
 		"address", listener.Addr().String(),
@@ -229,6 +261,13 @@ func (s *GatewayAPIServer) ListenAndServe() error {
 }
 
 func (s *GatewayAPIServer) Shutdown() error {
+	if s.controlServer != nil {
+		// Control streams stay open until they are closed by the server
+		s.controlServer.Close()
+	}
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
 	return s.app.Shutdown()
 }
 
@@ -270,16 +309,114 @@ PREFIXES:
 func (s *GatewayAPIServer) ConfigureBootstrapRoutes(
 	storageBackend storage.Backend,
 	installer capabilities.Installer,
+	auditLog *audit.Log,
 ) {
-	limiterCfg := limiter.ConfigDefault
-	limiterCfg.Max = 60 // 60 requests per minute
-	s.app.Post("/bootstrap/*", limiter.New(limiterCfg), bootstrap.ServerConfig{
+	clusterMiddleware := s.newClusterMiddleware(storageBackend)
+	if clusterMiddleware != nil && s.conf.GRPCListenAddress != "" {
+		// Created before the rekey routes, which disconnect agents after
+		// their keys are rotated
+		s.controlServer = control.NewServer(s.logger.Named("control"))
+	}
+	if clusterMiddleware != nil {
+		// The rekey and pin routes must be registered before the bootstrap
+		// routes, which would otherwise match them.
+		s.configureRekeyRoutes(storageBackend, clusterMiddleware)
+		s.configureHeartbeatRoutes(storageBackend, clusterMiddleware)
+	}
+
+	serverConfig := bootstrap.ServerConfig{
 		Certificate:         &s.tlsConfig.Certificates[0],
 		TokenStore:          storageBackend,
 		ClusterStore:        storageBackend,
 		KeyringStoreBroker:  storageBackend,
 		CapabilityInstaller: installer,
+		AuditLog:            auditLog,
+		RequireApproval:     s.conf.Bootstrap.RequireApproval,
+	}
+	limiterCfg := limiter.ConfigDefault
+	limiterCfg.Max = bootstrapRequestsPerMinute
+	s.app.Post("/bootstrap/*", limiter.New(limiterCfg), serverConfig.Handle)
+
+	if s.conf.GRPCListenAddress != "" {
+		serverOptions := []grpc.ServerOption{
+			grpc.Creds(credentials.NewTLS(s.tlsConfig.Clone())),
+			grpc.UnaryInterceptor(bootstrap.RateLimitInterceptor(bootstrapRequestsPerMinute)),
+			// Agents send keepalive pings to keep their control streams open
+			// through NATs and proxies.
+			grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+				MinTime:             15 * time.Second,
+				PermitWithoutStream: true,
+			}),
+		}
+		if clusterMiddleware != nil {
+			serverOptions = append(serverOptions,
+				grpc.StreamInterceptor(clusterMiddleware.StreamServerInterceptor()))
+		}
+		s.grpcServer = grpc.NewServer(serverOptions...)
+		bootstrapv1.RegisterBootstrapServer(s.grpcServer, serverConfig.NewBootstrapServer())
+		if s.controlServer != nil {
+			controlv1.RegisterAgentControlServer(s.grpcServer, s.controlServer)
+		}
+	}
+}
+
+// newClusterMiddleware returns middleware which authenticates requests using
+// each cluster's shared keys, or nil if it cannot be set up.
+func (s *GatewayAPIServer) newClusterMiddleware(storageBackend storage.Backend) *cluster.ClusterMiddleware {
+	if storageBackend == nil {
+		return nil
+	}
+	options := append(cluster.ConfigOptions(s.conf.ClusterAuth),
+		cluster.WithClusterStore(storageBackend))
+	clusterMiddleware, err := cluster.New(storageBackend, "X-Opni-Cluster-ID", options...)
+	if err != nil {
+		s.logger.With(
+			zap.Error(err),
+		).Error("failed to set up cluster middleware, key rotation and agent control will not be available")
+		return nil
+	}
+	return clusterMiddleware
+}
+
+func (s *GatewayAPIServer) configureRekeyRoutes(
+	storageBackend storage.Backend,
+	clusterMiddleware *cluster.ClusterMiddleware,
+) {
+	rekeyConfig := bootstrap.RekeyServerConfig{
+		KeyringStoreBroker: storageBackend,
+	}
+	if s.controlServer != nil {
+		rekeyConfig.AgentControl = s.controlServer
+	}
+	handler := rekeyConfig.Handle
+	rekeyLimiter := limiter.New(limiter.Config{
+		SkipSuccessfulRequests: true,
+	})
+	s.app.Get(bootstrap.RekeyPath, rekeyLimiter, clusterMiddleware.Handle, handler)
+	s.app.Post(bootstrap.RekeyPath, rekeyLimiter, clusterMiddleware.Handle, handler)
+
+	pinsHandler := bootstrap.PinsServerConfig{
+		Certificates: s.tlsConfig.Certificates,
+	}.Handle
+	s.app.Get(bootstrap.PinsPath, rekeyLimiter, clusterMiddleware.Handle, pinsHandler)
+}
+
+func (s *GatewayAPIServer) configureHeartbeatRoutes(
+	storageBackend storage.Backend,
+	clusterMiddleware *cluster.ClusterMiddleware,
+) {
+	store, err := storageBackend.KeyValueStore(health.StatusNamespace)
+	if err != nil {
+		s.logger.With(
+			zap.Error(err),
+		).Error("failed to configure cluster status store, agent heartbeats will not be recorded")
+		return
+	}
+	statuses := health.NewStatusStore(store)
+	s.app.Post(health.HeartbeatPath, clusterMiddleware.Handle, health.HeartbeatServerConfig{
+		Statuses: statuses,
 	}.Handle)
+	s.metricsHandler.MustRegister(health.NewLastSeenCollector(statuses))
 }
 
 func loadTLSConfig(cfg *v1beta1.GatewayConfigSpec) (*tls.Config, error) {
@@ -287,11 +424,26 @@ func loadTLSConfig(cfg *v1beta1.GatewayConfigSpec) (*tls.Config, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &tls.Config{
+	nextServingCert, err := util.LoadNextServingCert(cfg.Certs)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
 		MinVersion:   tls.VersionTLS12,
 		RootCAs:      caPool,
 		Certificates: []tls.Certificate{*servingCertBundle},
-	}, nil
+	}
+	if nextServingCert != nil {
+		// During a certificate changeover, the current certificate is always
+		// first, followed by the next certificate. Agents which have already
+		// synced the next certificate's pins are served the next certificate.
+		tlsConfig.Certificates = append(tlsConfig.Certificates, *nextServingCert)
+		tlsConfig.GetConfigForClient, err = pkp.CertificateSelector(tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tlsConfig, nil
 }
 
 func default404Handler(c *fiber.Ctx) error {
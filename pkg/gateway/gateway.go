@@ -4,13 +4,16 @@ import (
 	"context"
 	"crypto/tls"
 	"net"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/hashicorp/go-plugin"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/rancher/opni-monitoring/pkg/audit"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/config"
 	"github.com/rancher/opni-monitoring/pkg/config/meta"
+	"github.com/rancher/opni-monitoring/pkg/control"
 	"github.com/rancher/opni-monitoring/pkg/logger"
 	"github.com/rancher/opni-monitoring/pkg/machinery"
 	"github.com/rancher/opni-monitoring/pkg/plugins"
@@ -39,6 +42,7 @@ type Gateway struct {
 
 	storageBackend  storage.Backend
 	capBackendStore capabilities.BackendStore
+	auditLog        *audit.Log
 }
 
 type GatewayOptions struct {
@@ -106,9 +110,19 @@ func NewGateway(ctx context.Context, conf *config.GatewayConfig, opts ...Gateway
 		).Fatal("failed to parse listen address")
 	}
 
+	var capBackendStoreOptions []capabilities.BackendStoreOption
+	if storageBackend != nil {
+		if store, err := storageBackend.KeyValueStore(capabilities.StatusNamespace); err != nil {
+			lg.With(
+				zap.Error(err),
+			).Error("failed to configure capability install status store")
+		} else {
+			capBackendStoreOptions = append(capBackendStoreOptions, capabilities.WithStatusStore(store))
+		}
+	}
 	capBackendStore := capabilities.NewBackendStore(capabilities.ServerInstallerTemplateSpec{
 		Address: "https://" + conf.Spec.Hostname + ":" + port,
-	}, lg)
+	}, lg, capBackendStoreOptions...)
 
 	for _, p := range options.capBackendPlugins {
 		info, err := p.Typed.Info(ctx, &emptypb.Empty{})
@@ -126,9 +140,28 @@ func NewGateway(ctx context.Context, conf *config.GatewayConfig, opts ...Gateway
 			).Error("failed to add capability backend")
 		}
 	}
+	if err := capBackendStore.ResumeInstalls(ctx); err != nil {
+		lg.With(
+			zap.Error(err),
+		).Error("failed to resume capability installations")
+	}
+
+	var auditLog *audit.Log
+	if !conf.Spec.Audit.Disabled && storageBackend != nil {
+		store, err := storageBackend.KeyValueStore(audit.Namespace)
+		if err != nil {
+			lg.With(
+				zap.Error(err),
+			).Error("failed to configure audit log")
+		} else {
+			auditLog = audit.NewLog(store,
+				audit.WithRetention(time.Duration(conf.Spec.Audit.RetentionDays)*24*time.Hour),
+			)
+		}
+	}
 
 	apiServer := NewAPIServer(ctx, &conf.Spec, lg, options.apiServerOptions...)
-	apiServer.ConfigureBootstrapRoutes(storageBackend, capBackendStore)
+	apiServer.ConfigureBootstrapRoutes(storageBackend, capBackendStore, auditLog)
 
 	g := &Gateway{
 		GatewayOptions:  options,
@@ -137,6 +170,7 @@ func NewGateway(ctx context.Context, conf *config.GatewayConfig, opts ...Gateway
 		logger:          lg,
 		storageBackend:  storageBackend,
 		capBackendStore: capBackendStore,
+		auditLog:        auditLog,
 		apiServer:       apiServer,
 	}
 
@@ -189,6 +223,12 @@ func (g *Gateway) ListenAndServe() error {
 		})
 	}
 
+	if g.auditLog != nil {
+		waitctx.Go(g.ctx, func() {
+			g.auditLog.Run(g.ctx)
+		})
+	}
+
 	g.logger.Infof("serving management api for %d system plugins", len(g.systemPlugins))
 	for _, systemPlugin := range g.systemPlugins {
 		ns := systemPlugin.Metadata.Module
@@ -219,11 +259,25 @@ func (g *Gateway) TLSConfig() *tls.Config {
 	return g.apiServer.tlsConfig
 }
 
+// AuditLog returns the gateway's audit log, or nil if auditing is disabled.
+func (g *Gateway) AuditLog() *audit.Log {
+	return g.auditLog
+}
+
 // Implements management.CapabilitiesDataSource
 func (g *Gateway) CapabilitiesStore() capabilities.BackendStore {
 	return g.capBackendStore
 }
 
+// Implements management.AgentControlDataSource
+func (g *Gateway) AgentControl() control.AgentController {
+	if g.apiServer.controlServer == nil {
+		// avoid returning a non-nil interface holding a nil pointer
+		return nil
+	}
+	return g.apiServer.controlServer
+}
+
 func (g *Gateway) MustRegisterCollector(collector prometheus.Collector) {
 	g.apiServer.metricsHandler.MustRegister(collector)
 }
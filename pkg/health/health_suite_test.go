@@ -0,0 +1,20 @@
+package health_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestHealth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Health Suite")
+}
+
+var ctrl *gomock.Controller
+
+var _ = BeforeSuite(func() {
+	ctrl = gomock.NewController(GinkgoT())
+})
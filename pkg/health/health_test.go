@@ -0,0 +1,160 @@
+package health_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/ecdh"
+	"github.com/rancher/opni-monitoring/pkg/health"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Heartbeats", Ordered, Label(test.Unit, test.Slow), func() {
+	var statuses *health.StatusStore
+	var client clients.GatewayHTTPClient
+
+	BeforeAll(func() {
+		cert, err := tls.X509KeyPair(test.TestData("self_signed_leaf.crt"), test.TestData("self_signed_leaf.key"))
+		Expect(err).NotTo(HaveOccurred())
+		cert.Leaf, err = x509.ParseCertificate(cert.Certificate[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		broker := test.NewTestKeyringStoreBroker(ctrl)
+		gatewayStore, err := broker.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: "foo",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		secret, err := ecdh.DeriveSharedSecret(ecdh.NewEphemeralKeyPair(), ecdh.PeerPublicKey{
+			PublicKey: ecdh.NewEphemeralKeyPair().PublicKey,
+			PeerType:  ecdh.PeerTypeClient,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(gatewayStore.Put(context.Background(), keyring.New(keyring.NewSharedKeys(secret)))).To(Succeed())
+
+		statuses = health.NewStatusStore(test.NewTestKeyValueStore(ctrl))
+		app := fiber.New(fiber.Config{
+			DisableStartupMessage: true,
+		})
+		cm, err := cluster.New(broker, "X-Test")
+		Expect(err).NotTo(HaveOccurred())
+		app.Post(health.HeartbeatPath, cm.Handle, health.HeartbeatServerConfig{
+			Statuses: statuses,
+		}.Handle)
+		listener, err := tls.Listen("tcp4", "127.0.0.1:0", &tls.Config{
+			Certificates: []tls.Certificate{cert},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		go app.Listener(listener)
+		DeferCleanup(app.Shutdown)
+
+		client, err = clients.NewGatewayHTTPClient("https://"+listener.Addr().String(),
+			test.NewTestIdentProvider(ctrl, "foo"), keyring.New(
+				keyring.NewSharedKeys(secret),
+				keyring.NewPKPKey([]*pkp.PublicKeyPin{pkp.NewSha256(cert.Leaf)}),
+			))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not have a status before the first heartbeat", func() {
+		_, err := statuses.Get(context.Background(), "foo")
+		Expect(errors.Is(err, storage.ErrNotFound)).To(BeTrue())
+	})
+	It("should record heartbeats as the cluster's status", func() {
+		lastPush := time.Now().Add(-time.Minute)
+		Expect(health.SendHeartbeat(context.Background(), client, &core.ClusterStatus{
+			AgentVersion:      "v1.0.0",
+			Uptime:            durationpb.New(time.Hour),
+			LastPushTimestamp: timestamppb.New(lastPush),
+			HeartbeatInterval: durationpb.New(time.Minute),
+			RuleSync: &core.RuleSyncStatus{
+				Enabled: true,
+				Groups:  2,
+			},
+			// Set by the gateway, and must be ignored
+			LastSeen: timestamppb.New(time.Now().Add(time.Hour)),
+			Health:   core.ClusterHealth_ClusterDisconnected,
+		})).To(Succeed())
+
+		status, err := statuses.Get(context.Background(), "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(status.GetAgentVersion()).To(Equal("v1.0.0"))
+		Expect(status.GetUptime().AsDuration()).To(Equal(time.Hour))
+		Expect(status.GetLastPushTimestamp().AsTime()).To(BeTemporally("==", lastPush))
+		Expect(status.GetRuleSync().GetGroups()).To(BeEquivalentTo(2))
+		Expect(status.GetLastSeen().AsTime()).To(BeTemporally("~", time.Now(), time.Second))
+		Expect(status.GetHealth()).To(Equal(core.ClusterHealth_ClusterHealthy))
+	})
+	It("should export the time each cluster was last seen", func() {
+		collector := health.NewLastSeenCollector(statuses)
+		Expect(promtestutil.CollectAndCount(collector, "opni_cluster_last_seen_timestamp")).To(Equal(1))
+
+		status, err := statuses.Get(context.Background(), "foo")
+		Expect(err).NotTo(HaveOccurred())
+		lastSeen := float64(status.GetLastSeen().AsTime().UnixNano()) / 1e9
+		Expect(promtestutil.ToFloat64(collector)).To(BeNumerically("~", lastSeen, 1e-3))
+	})
+	It("should remove statuses of deleted clusters", func() {
+		Expect(statuses.Delete(context.Background(), "foo")).To(Succeed())
+		Expect(statuses.Delete(context.Background(), "foo")).To(Succeed())
+		Expect(promtestutil.CollectAndCount(health.NewLastSeenCollector(statuses))).To(Equal(0))
+	})
+	It("should reject invalid heartbeats", func() {
+		code, body, err := client.Post(context.Background(), health.HeartbeatPath).
+			Body([]byte("invalid")).
+			Do()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(code).To(Equal(fiber.StatusBadRequest))
+		Expect(strings.TrimSpace(string(body))).To(Equal("Invalid request body"))
+	})
+})
+
+var _ = Describe("Cluster Health", Label(test.Unit), func() {
+	now := time.Now()
+	DescribeTable("evaluating cluster health",
+		func(status *core.ClusterStatus, expected core.ClusterHealth) {
+			Expect(health.Evaluate(status, now)).To(Equal(expected))
+		},
+		Entry("no heartbeats", &core.ClusterStatus{}, core.ClusterHealth_ClusterHealthUnknown),
+		Entry("recent heartbeat", &core.ClusterStatus{
+			LastSeen: timestamppb.New(now.Add(-10 * time.Second)),
+		}, core.ClusterHealth_ClusterHealthy),
+		Entry("missed heartbeats", &core.ClusterStatus{
+			LastSeen: timestamppb.New(now.Add(-2 * time.Minute)),
+		}, core.ClusterHealth_ClusterDisconnected),
+		Entry("missed heartbeats with a longer interval", &core.ClusterStatus{
+			LastSeen:          timestamppb.New(now.Add(-2 * time.Minute)),
+			HeartbeatInterval: durationpb.New(time.Minute),
+		}, core.ClusterHealth_ClusterHealthy),
+		Entry("rule sync errors", &core.ClusterStatus{
+			LastSeen: timestamppb.New(now),
+			RuleSync: &core.RuleSyncStatus{
+				Enabled:   true,
+				LastError: "error",
+			},
+		}, core.ClusterHealth_ClusterDegraded),
+		Entry("disconnected with rule sync errors", &core.ClusterStatus{
+			LastSeen: timestamppb.New(now.Add(-time.Hour)),
+			RuleSync: &core.RuleSyncStatus{
+				Enabled:   true,
+				LastError: "error",
+			},
+		}, core.ClusterHealth_ClusterDisconnected),
+	)
+})
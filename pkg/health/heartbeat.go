@@ -0,0 +1,65 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/rancher/opni-monitoring/pkg/auth/cluster"
+	"github.com/rancher/opni-monitoring/pkg/clients"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const HeartbeatPath = "/agent/heartbeat"
+
+var ErrHeartbeatFailed = errors.New("heartbeat failed")
+
+// HeartbeatServerConfig records heartbeats sent by agents as the status of
+// their cluster. Requests must be authenticated using the cluster's shared
+// keys, so the handler must be used behind the cluster auth middleware.
+type HeartbeatServerConfig struct {
+	Statuses *StatusStore
+}
+
+func (h HeartbeatServerConfig) Handle(c *fiber.Ctx) error {
+	lg := c.Context().Logger()
+	status := &core.ClusterStatus{}
+	if err := protojson.Unmarshal(c.Body(), status); err != nil {
+		return c.Status(fiber.StatusBadRequest).SendString("Invalid request body")
+	}
+	// Fields set by the gateway are never accepted from the agent
+	status.LastSeen = timestamppb.Now()
+	status.Health = core.ClusterHealth_ClusterHealthUnknown
+	if err := h.Statuses.Put(context.Background(), cluster.AuthorizedID(c), status); err != nil {
+		lg.Printf("error storing cluster status: %v", err)
+		return c.SendStatus(fiber.StatusInternalServerError)
+	}
+	return c.SendStatus(fiber.StatusOK)
+}
+
+// SendHeartbeat reports the agent's status to the gateway.
+func SendHeartbeat(
+	ctx context.Context,
+	client clients.GatewayHTTPClient,
+	status *core.ClusterStatus,
+) error {
+	body, err := protojson.Marshal(status)
+	if err != nil {
+		return err
+	}
+	code, _, err := client.Post(ctx, HeartbeatPath).
+		Set("Content-Type", "application/json").
+		Body(body).
+		Do()
+	if err != nil {
+		return err
+	}
+	if code != http.StatusOK {
+		return fmt.Errorf("%w: %s", ErrHeartbeatFailed, http.StatusText(code))
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var lastSeenDesc = prometheus.NewDesc(
+	"opni_cluster_last_seen_timestamp",
+	"Unix time at which the gateway last received a heartbeat from the cluster's agent",
+	[]string{"cluster_id"},
+	nil,
+)
+
+// lastSeenCollector reads cluster statuses from the status store on each
+// scrape, so that the metric is available after a gateway restart and is
+// removed when a cluster is deleted.
+type lastSeenCollector struct {
+	statuses *StatusStore
+}
+
+func NewLastSeenCollector(statuses *StatusStore) prometheus.Collector {
+	return &lastSeenCollector{
+		statuses: statuses,
+	}
+}
+
+func (c *lastSeenCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lastSeenDesc
+}
+
+func (c *lastSeenCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, ca := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ca()
+	statuses, err := c.statuses.List(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(lastSeenDesc, err)
+		return
+	}
+	for id, status := range statuses {
+		if status.GetLastSeen() == nil {
+			continue
+		}
+		lastSeen := float64(status.GetLastSeen().AsTime().UnixNano()) / 1e9
+		ch <- prometheus.MustNewConstMetric(lastSeenDesc, prometheus.GaugeValue, lastSeen, id)
+	}
+}
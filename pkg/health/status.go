@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/protobuf/proto"
+)
+
+// Namespace of the key-value store in which cluster statuses are persisted.
+const StatusNamespace = "cluster-status"
+
+const (
+	DefaultHeartbeatInterval = 30 * time.Second
+	// Number of consecutive heartbeats an agent can miss before its cluster
+	// is considered disconnected.
+	missedHeartbeatThreshold = 3
+)
+
+// StatusStore persists the most recent status reported by each cluster's
+// agent. Statuses are keyed by cluster ID.
+type StatusStore struct {
+	store storage.KeyValueStore
+}
+
+func NewStatusStore(store storage.KeyValueStore) *StatusStore {
+	return &StatusStore{
+		store: store,
+	}
+}
+
+func (s *StatusStore) Put(ctx context.Context, id string, status *core.ClusterStatus) error {
+	data, err := proto.Marshal(status)
+	if err != nil {
+		return err
+	}
+	return s.store.Put(ctx, id, data)
+}
+
+// Get returns the cluster's status, with its health evaluated at the current
+// time. If the cluster's agent has never sent a heartbeat, storage.ErrNotFound
+// is returned.
+func (s *StatusStore) Get(ctx context.Context, id string) (*core.ClusterStatus, error) {
+	data, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	status := &core.ClusterStatus{}
+	if err := proto.Unmarshal(data, status); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster status: %w", err)
+	}
+	status.Health = Evaluate(status, time.Now())
+	return status, nil
+}
+
+// List returns the statuses of all clusters, keyed by cluster ID.
+func (s *StatusStore) List(ctx context.Context) (map[string]*core.ClusterStatus, error) {
+	keys, err := s.store.ListKeys(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	statuses := make(map[string]*core.ClusterStatus, len(keys))
+	for _, key := range keys {
+		status, err := s.Get(ctx, key)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		statuses[key] = status
+	}
+	return statuses, nil
+}
+
+func (s *StatusStore) Delete(ctx context.Context, id string) error {
+	if err := s.store.Delete(ctx, id); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// Evaluate returns the health of a cluster given its most recent status. A
+// cluster is disconnected if its agent has missed several consecutive
+// heartbeats, and degraded if the agent is reporting errors.
+func Evaluate(status *core.ClusterStatus, now time.Time) core.ClusterHealth {
+	if status.GetLastSeen() == nil {
+		return core.ClusterHealth_ClusterHealthUnknown
+	}
+	interval := DefaultHeartbeatInterval
+	if d := status.GetHeartbeatInterval(); d != nil && d.AsDuration() > 0 {
+		interval = d.AsDuration()
+	}
+	if now.Sub(status.GetLastSeen().AsTime()) > missedHeartbeatThreshold*interval {
+		return core.ClusterHealth_ClusterDisconnected
+	}
+	if status.GetRuleSync().GetLastError() != "" {
+		return core.ClusterHealth_ClusterDegraded
+	}
+	return core.ClusterHealth_ClusterHealthy
+}
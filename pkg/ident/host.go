@@ -0,0 +1,138 @@
+package ident
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rancher/opni-monitoring/pkg/util"
+)
+
+// Application key used to derive identifiers from the host's machine ID, so
+// that the machine ID itself is never sent to the gateway.
+var hostIdentAppKey = []byte("opni-monitoring-agent")
+
+type hostProvider struct {
+	HostIdentOptions
+}
+
+type HostIdentOptions struct {
+	machineIDPaths []string
+	idFile         string
+}
+
+type HostIdentOption func(*HostIdentOptions)
+
+func (o *HostIdentOptions) Apply(opts ...HostIdentOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithMachineIDPaths sets the locations to search for the host's machine ID.
+// Defaults to /etc/machine-id and /var/lib/dbus/machine-id.
+func WithMachineIDPaths(paths ...string) HostIdentOption {
+	return func(o *HostIdentOptions) {
+		o.machineIDPaths = paths
+	}
+}
+
+// WithIDFile sets the location of the file in which a generated identifier
+// is persisted if the host has no machine ID. Defaults to
+// /var/lib/opni-monitoring/host-id.
+func WithIDFile(path string) HostIdentOption {
+	return func(o *HostIdentOptions) {
+		o.idFile = path
+	}
+}
+
+// NewHostProvider returns an ident provider for agents which are not running
+// in a Kubernetes cluster. The identifier is derived from the host's machine
+// ID if it has one, otherwise a random identifier is generated and persisted.
+func NewHostProvider(opts ...HostIdentOption) Provider {
+	options := HostIdentOptions{
+		machineIDPaths: []string{"/etc/machine-id", "/var/lib/dbus/machine-id"},
+		idFile:         "/var/lib/opni-monitoring/host-id",
+	}
+	options.Apply(opts...)
+	return &hostProvider{
+		HostIdentOptions: options,
+	}
+}
+
+func (p *hostProvider) UniqueIdentifier(context.Context) (string, error) {
+	for _, path := range p.machineIDPaths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return "", fmt.Errorf("failed to read machine id: %w", err)
+		}
+		machineID := strings.TrimSpace(string(data))
+		if machineID == "" {
+			// systemd leaves the file empty until the machine id is committed
+			continue
+		}
+		mac := hmac.New(sha256.New, hostIdentAppKey)
+		mac.Write([]byte(machineID))
+		return uuid.NewSHA1(uuid.Nil, mac.Sum(nil)).String(), nil
+	}
+	return p.persistentID()
+}
+
+func (p *hostProvider) persistentID() (string, error) {
+	data, err := os.ReadFile(p.idFile)
+	if err == nil {
+		id, err := uuid.Parse(strings.TrimSpace(string(data)))
+		if err != nil {
+			return "", fmt.Errorf("invalid identifier in %s: %w", p.idFile, err)
+		}
+		return id.String(), nil
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("failed to read identifier: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(p.idFile), 0700); err != nil {
+		return "", fmt.Errorf("failed to create identifier directory: %w", err)
+	}
+	id := uuid.New().String()
+	// Write the identifier to a temporary file first, so that other processes
+	// never observe a partially written file.
+	f, err := os.CreateTemp(filepath.Dir(p.idFile), ".host-id-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create identifier file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(id + "\n")
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to write identifier file: %w", err)
+	}
+	if err := os.Link(f.Name(), p.idFile); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			// created concurrently by another process
+			return p.persistentID()
+		}
+		return "", fmt.Errorf("failed to create identifier file: %w", err)
+	}
+	return id, nil
+}
+
+func init() {
+	util.Must(RegisterProvider("host", func() Provider {
+		return NewHostProvider()
+	}))
+}
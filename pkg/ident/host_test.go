@@ -0,0 +1,125 @@
+package ident_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/ident"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Host", Label(test.Unit), func() {
+	var dir string
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+	When("the host has a machine id", func() {
+		It("should derive a stable identifier from the machine id", func() {
+			machineID := filepath.Join(dir, "machine-id")
+			Expect(os.WriteFile(machineID, []byte("0123456789abcdef0123456789abcdef\n"), 0644)).To(Succeed())
+			provider := ident.NewHostProvider(
+				ident.WithMachineIDPaths(machineID),
+				ident.WithIDFile(filepath.Join(dir, "host-id")),
+			)
+			id, err := provider.UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			_, err = uuid.Parse(id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id).NotTo(ContainSubstring("0123456789abcdef"))
+
+			id2, err := provider.UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id2).To(Equal(id))
+
+			By("ensuring no identifier file is created")
+			Expect(filepath.Join(dir, "host-id")).NotTo(BeAnExistingFile())
+		})
+		It("should search the machine id paths in order", func() {
+			empty := filepath.Join(dir, "empty")
+			Expect(os.WriteFile(empty, nil, 0644)).To(Succeed())
+			machineID := filepath.Join(dir, "machine-id")
+			Expect(os.WriteFile(machineID, []byte("0123456789abcdef0123456789abcdef\n"), 0644)).To(Succeed())
+			other := filepath.Join(dir, "other")
+			Expect(os.WriteFile(other, []byte("fedcba9876543210fedcba9876543210\n"), 0644)).To(Succeed())
+
+			id1, err := ident.NewHostProvider(
+				ident.WithMachineIDPaths(filepath.Join(dir, "missing"), empty, machineID, other),
+			).UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			id2, err := ident.NewHostProvider(
+				ident.WithMachineIDPaths(machineID),
+			).UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			id3, err := ident.NewHostProvider(
+				ident.WithMachineIDPaths(other),
+			).UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(id1).To(Equal(id2))
+			Expect(id1).NotTo(Equal(id3))
+		})
+	})
+	When("the host does not have a machine id", func() {
+		It("should generate and persist an identifier", func() {
+			idFile := filepath.Join(dir, "opni", "host-id")
+			provider := ident.NewHostProvider(
+				ident.WithMachineIDPaths(filepath.Join(dir, "missing")),
+				ident.WithIDFile(idFile),
+			)
+			id, err := provider.UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			_, err = uuid.Parse(id)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(idFile).To(BeAnExistingFile())
+			data, err := os.ReadFile(idFile)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(id + "\n"))
+
+			id2, err := provider.UniqueIdentifier(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(id2).To(Equal(id))
+		})
+		It("should return the same identifier to concurrent callers", func() {
+			provider := ident.NewHostProvider(
+				ident.WithMachineIDPaths(),
+				ident.WithIDFile(filepath.Join(dir, "host-id")),
+			)
+			ids := make([]string, 10)
+			var wg sync.WaitGroup
+			for i := range ids {
+				i := i
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					id, err := provider.UniqueIdentifier(context.Background())
+					Expect(err).NotTo(HaveOccurred())
+					ids[i] = id
+				}()
+			}
+			wg.Wait()
+			for _, id := range ids {
+				Expect(id).To(Equal(ids[0]))
+			}
+		})
+		It("should reject an invalid identifier file", func() {
+			idFile := filepath.Join(dir, "host-id")
+			Expect(os.WriteFile(idFile, []byte("not-a-uuid\n"), 0600)).To(Succeed())
+			_, err := ident.NewHostProvider(
+				ident.WithMachineIDPaths(),
+				ident.WithIDFile(idFile),
+			).UniqueIdentifier(context.Background())
+			Expect(err).To(MatchError(ContainSubstring("invalid identifier")))
+		})
+	})
+	It("should be registered as the \"host\" provider", func() {
+		_, err := ident.GetProvider("host")
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
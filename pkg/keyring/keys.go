@@ -2,6 +2,7 @@ package keyring
 
 import (
 	"crypto/ed25519"
+	"time"
 
 	"github.com/rancher/opni-monitoring/pkg/pkp"
 )
@@ -12,6 +13,12 @@ import (
 type SharedKeys struct {
 	ClientKey ed25519.PrivateKey `json:"clientKey"`
 	ServerKey ed25519.PrivateKey `json:"serverKey"`
+	// If set, the keys are being rotated out and are no longer valid after
+	// this time.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Set once a key exchange has been performed to replace these keys.
+	// Replaced keys cannot be used to perform another key exchange.
+	Replaced bool `json:"replaced,omitempty"`
 }
 
 type PKPKey struct {
@@ -28,6 +35,11 @@ func NewSharedKeys(secret []byte) *SharedKeys {
 	}
 }
 
+// Expired returns true if the keys have an expiration time which has passed.
+func (k *SharedKeys) Expired() bool {
+	return k.ExpiresAt != nil && !time.Now().Before(*k.ExpiresAt)
+}
+
 func NewPKPKey(pinnedKeys []*pkp.PublicKeyPin) *PKPKey {
 	key := &PKPKey{
 		PinnedKeys: make([]*pkp.PublicKeyPin, len(pinnedKeys)),
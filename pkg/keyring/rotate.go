@@ -0,0 +1,108 @@
+package keyring
+
+import "time"
+
+// Default length of time for which a cluster's previous shared keys remain
+// valid after the keys are rotated.
+const DefaultRotationGracePeriod = time.Hour
+
+// Expire returns a copy of the keyring in which all shared keys expire at
+// the given time. Keys which already expire before then are unchanged, and
+// keys which have already expired are removed. Other key types are kept
+// as-is.
+func Expire(kr Keyring, expiresAt time.Time) Keyring {
+	keys := []interface{}{}
+	kr.ForEach(func(key interface{}) {
+		sk, ok := key.(*SharedKeys)
+		if !ok {
+			keys = append(keys, key)
+			return
+		}
+		if sk.Expired() {
+			return
+		}
+		if sk.ExpiresAt == nil || sk.ExpiresAt.After(expiresAt) {
+			t := expiresAt
+			sk = &SharedKeys{
+				ClientKey: sk.ClientKey,
+				ServerKey: sk.ServerKey,
+				ExpiresAt: &t,
+				Replaced:  sk.Replaced,
+			}
+		}
+		keys = append(keys, sk)
+	})
+	return New(keys...)
+}
+
+// Rotate returns a copy of the keyring containing the new shared keys. The
+// existing shared keys remain valid until the given time, after which only
+// the new keys can be used.
+func Rotate(kr Keyring, newKeys *SharedKeys, expiresAt time.Time) Keyring {
+	return Expire(kr, expiresAt).Merge(New(newKeys))
+}
+
+// Replace returns a copy of the keyring in which the given shared keys are
+// replaced by new keys. The replaced keys remain valid until the given time,
+// or until they were already due to expire if that is sooner, and are marked
+// as replaced. Other keys, such as those of other agents for the same cluster,
+// are unchanged. It also returns the time at which the replaced keys expire.
+// The last return value is false if the keys are not in the keyring, have
+// expired, or have already been replaced.
+func Replace(kr Keyring, oldKeys, newKeys *SharedKeys, expiresAt time.Time) (Keyring, time.Time, bool) {
+	found := false
+	keys := []interface{}{}
+	kr.ForEach(func(key interface{}) {
+		sk, ok := key.(*SharedKeys)
+		if !ok || !sk.ClientKey.Equal(oldKeys.ClientKey) || !sk.ServerKey.Equal(oldKeys.ServerKey) {
+			keys = append(keys, key)
+			return
+		}
+		if sk.Expired() || sk.Replaced {
+			keys = append(keys, key)
+			return
+		}
+		found = true
+		if sk.ExpiresAt != nil && sk.ExpiresAt.Before(expiresAt) {
+			expiresAt = *sk.ExpiresAt
+		}
+		t := expiresAt
+		keys = append(keys, &SharedKeys{
+			ClientKey: sk.ClientKey,
+			ServerKey: sk.ServerKey,
+			ExpiresAt: &t,
+			Replaced:  true,
+		})
+	})
+	if !found {
+		return kr, time.Time{}, false
+	}
+	return New(append(keys, newKeys)...), expiresAt, true
+}
+
+// CurrentSharedKeys returns the shared keys which should be used to sign new
+// requests: the only set of keys in the keyring which are not being rotated
+// out. If all keys are being rotated out, the keys which expire last are
+// returned, or nil if the keyring does not contain any valid shared keys.
+// The second return value is false if there is more than one set of keys
+// which is not being rotated out, in which case the choice is ambiguous.
+func CurrentSharedKeys(kr Keyring) (*SharedKeys, bool) {
+	var current *SharedKeys
+	ambiguous := false
+	kr.Try(func(sk *SharedKeys) {
+		if sk.Expired() {
+			return
+		}
+		switch {
+		case current == nil:
+			current = sk
+		case sk.ExpiresAt == nil && current.ExpiresAt == nil:
+			ambiguous = true
+		case sk.ExpiresAt == nil:
+			current = sk
+		case current.ExpiresAt != nil && sk.ExpiresAt.After(*current.ExpiresAt):
+			current = sk
+		}
+	})
+	return current, !ambiguous
+}
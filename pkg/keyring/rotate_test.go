@@ -0,0 +1,144 @@
+package keyring_test
+
+import (
+	"bytes"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/pkp"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Key Rotation", Label(test.Unit), func() {
+	oldKeys := keyring.NewSharedKeys(bytes.Repeat([]byte{1}, 64))
+	newKeys := keyring.NewSharedKeys(bytes.Repeat([]byte{2}, 64))
+	pkpKey := keyring.NewPKPKey([]*pkp.PublicKeyPin{
+		{
+			Algorithm:   "sha256",
+			Fingerprint: []byte("test"),
+		},
+	})
+
+	It("should keep old keys valid until they expire", func() {
+		expiresAt := time.Now().Add(time.Hour)
+		kr := keyring.Rotate(keyring.New(oldKeys, pkpKey), newKeys, expiresAt)
+
+		var sharedKeys []*keyring.SharedKeys
+		pkpCount := 0
+		kr.Try(func(sk *keyring.SharedKeys) {
+			sharedKeys = append(sharedKeys, sk)
+		}, func(*keyring.PKPKey) {
+			pkpCount++
+		})
+		Expect(pkpCount).To(Equal(1))
+		Expect(sharedKeys).To(HaveLen(2))
+		for _, sk := range sharedKeys {
+			Expect(sk.Expired()).To(BeFalse())
+			if sk.ClientKey.Equal(oldKeys.ClientKey) {
+				Expect(sk.ExpiresAt).NotTo(BeNil())
+				Expect(*sk.ExpiresAt).To(BeTemporally("==", expiresAt))
+			} else {
+				Expect(sk.ExpiresAt).To(BeNil())
+			}
+		}
+		By("ensuring the original keys are not modified")
+		Expect(oldKeys.ExpiresAt).To(BeNil())
+
+		By("ensuring the new keys are used to sign requests")
+		current, ok := keyring.CurrentSharedKeys(kr)
+		Expect(ok).To(BeTrue())
+		Expect(current.ClientKey.Equal(newKeys.ClientKey)).To(BeTrue())
+
+		By("ensuring the expiration time is preserved by Marshal and Unmarshal")
+		data, err := kr.Marshal()
+		Expect(err).NotTo(HaveOccurred())
+		kr2, err := keyring.Unmarshal(data)
+		Expect(err).NotTo(HaveOccurred())
+		count := 0
+		kr2.Try(func(sk *keyring.SharedKeys) {
+			if sk.ExpiresAt != nil {
+				count++
+				Expect(*sk.ExpiresAt).To(BeTemporally("==", expiresAt))
+			}
+		})
+		Expect(count).To(Equal(1))
+	})
+	It("should remove expired keys", func() {
+		kr := keyring.Rotate(keyring.New(oldKeys), newKeys, time.Now().Add(-time.Second))
+		kr = keyring.Expire(kr, time.Now().Add(time.Hour))
+		count := 0
+		kr.Try(func(sk *keyring.SharedKeys) {
+			count++
+			Expect(sk.ClientKey.Equal(newKeys.ClientKey)).To(BeTrue())
+		})
+		Expect(count).To(Equal(1))
+	})
+	It("should not extend the expiration time of keys", func() {
+		earlier := time.Now().Add(time.Minute)
+		kr := keyring.Expire(keyring.New(oldKeys), earlier)
+		kr = keyring.Expire(kr, time.Now().Add(time.Hour))
+		kr.Try(func(sk *keyring.SharedKeys) {
+			Expect(*sk.ExpiresAt).To(BeTemporally("==", earlier))
+		})
+	})
+	When("all keys are being rotated out", func() {
+		It("should use the keys which expire last", func() {
+			kr := keyring.Expire(keyring.New(oldKeys), time.Now().Add(time.Minute)).
+				Merge(keyring.Expire(keyring.New(newKeys), time.Now().Add(time.Hour)))
+			current, ok := keyring.CurrentSharedKeys(kr)
+			Expect(ok).To(BeTrue())
+			Expect(current.ClientKey.Equal(newKeys.ClientKey)).To(BeTrue())
+		})
+	})
+	When("replacing keys", func() {
+		otherKeys := keyring.NewSharedKeys(bytes.Repeat([]byte{3}, 64))
+		It("should only replace the given keys", func() {
+			expiresAt := time.Now().Add(time.Hour)
+			kr, exp, ok := keyring.Replace(keyring.New(oldKeys, otherKeys, pkpKey), oldKeys, newKeys, expiresAt)
+			Expect(ok).To(BeTrue())
+			Expect(exp).To(BeTemporally("==", expiresAt))
+
+			count := 0
+			kr.Try(func(sk *keyring.SharedKeys) {
+				count++
+				switch {
+				case sk.ClientKey.Equal(oldKeys.ClientKey):
+					Expect(sk.Replaced).To(BeTrue())
+					Expect(*sk.ExpiresAt).To(BeTemporally("==", expiresAt))
+				default:
+					Expect(sk.Replaced).To(BeFalse())
+					Expect(sk.ExpiresAt).To(BeNil())
+				}
+			})
+			Expect(count).To(Equal(3))
+
+			By("ensuring replaced keys cannot be replaced again")
+			_, _, ok = keyring.Replace(kr, oldKeys, keyring.NewSharedKeys(bytes.Repeat([]byte{4}, 64)), expiresAt)
+			Expect(ok).To(BeFalse())
+
+			By("ensuring the other keys can still be replaced")
+			_, _, ok = keyring.Replace(kr, otherKeys, keyring.NewSharedKeys(bytes.Repeat([]byte{4}, 64)), expiresAt)
+			Expect(ok).To(BeTrue())
+		})
+		It("should not extend the expiration time of the replaced keys", func() {
+			earlier := time.Now().Add(time.Minute)
+			kr := keyring.Expire(keyring.New(oldKeys), earlier)
+			_, exp, ok := keyring.Replace(kr, oldKeys, newKeys, time.Now().Add(time.Hour))
+			Expect(ok).To(BeTrue())
+			Expect(exp).To(BeTemporally("==", earlier))
+		})
+		It("should not replace keys which are not in the keyring", func() {
+			_, _, ok := keyring.Replace(keyring.New(otherKeys), oldKeys, newKeys, time.Now().Add(time.Hour))
+			Expect(ok).To(BeFalse())
+		})
+	})
+	When("the keyring contains multiple current keys", func() {
+		It("should report that the current keys are ambiguous", func() {
+			_, ok := keyring.CurrentSharedKeys(keyring.New(oldKeys, newKeys))
+			Expect(ok).To(BeFalse())
+		})
+	})
+})
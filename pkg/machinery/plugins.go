@@ -30,3 +30,23 @@ func LoadPlugins(loader *plugins.PluginLoader, conf v1beta1.PluginsSpec, reattac
 	}
 	return numLoaded
 }
+
+// DiscoverPlugins returns metadata for each plugin found in the configured
+// plugin directories, without loading the plugins.
+func DiscoverPlugins(conf v1beta1.PluginsSpec) ([]pluginmeta.PluginMeta, error) {
+	var mds []pluginmeta.PluginMeta
+	for _, dir := range conf.Dirs {
+		pluginPaths, err := plugin.Discover("plugin_*", dir)
+		if err != nil {
+			continue
+		}
+		for _, p := range pluginPaths {
+			md, err := pluginmeta.ReadMetadata(p)
+			if err != nil {
+				return nil, err
+			}
+			mds = append(mds, md)
+		}
+	}
+	return mds, nil
+}
@@ -3,15 +3,27 @@ package machinery
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/rancher/opni-monitoring/pkg/audit"
+	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/health"
+	pluginmeta "github.com/rancher/opni-monitoring/pkg/plugins/meta"
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/storage/bolt"
 	"github.com/rancher/opni-monitoring/pkg/storage/crds"
+	"github.com/rancher/opni-monitoring/pkg/storage/encryption"
 	"github.com/rancher/opni-monitoring/pkg/storage/etcd"
+	"github.com/rancher/opni-monitoring/pkg/storage/sql"
 )
 
 func ConfigureStorageBackend(ctx context.Context, cfg *v1beta1.StorageSpec) (storage.Backend, error) {
 	storageBackend := storage.CompositeBackend{}
+	vt, err := ConfigureValueTransformer(cfg.Encryption)
+	if err != nil {
+		return nil, err
+	}
 	switch cfg.Type {
 	case v1beta1.StorageTypeEtcd:
 		options := cfg.Etcd
@@ -20,19 +32,85 @@ func ConfigureStorageBackend(ctx context.Context, cfg *v1beta1.StorageSpec) (sto
 		} else {
 			store := etcd.NewEtcdStore(ctx, cfg.Etcd,
 				etcd.WithPrefix("gateway"),
+				etcd.WithValueTransformer(vt),
 			)
 			storageBackend.Use(store)
 		}
 	case v1beta1.StorageTypeCRDs:
 		options := cfg.CustomResources
-		crdOpts := []crds.CRDStoreOption{}
+		crdOpts := []crds.CRDStoreOption{
+			crds.WithValueTransformer(vt),
+		}
 		if options != nil {
 			crdOpts = append(crdOpts, crds.WithNamespace(options.Namespace))
 		}
 		crdStore := crds.NewCRDStore(crdOpts...)
 		storageBackend.Use(crdStore)
+	case v1beta1.StorageTypeBolt:
+		options := cfg.Bolt
+		if options == nil {
+			return nil, errors.New("bolt storage options are not set")
+		}
+		store, err := bolt.NewBoltStore(options, bolt.WithValueTransformer(vt))
+		if err != nil {
+			return nil, err
+		}
+		storageBackend.Use(store)
+	case v1beta1.StorageTypeSQL:
+		options := cfg.SQL
+		if options == nil {
+			return nil, errors.New("sql storage options are not set")
+		}
+		store, err := sql.NewSQLStore(ctx, options, sql.WithValueTransformer(vt))
+		if err != nil {
+			return nil, err
+		}
+		storageBackend.Use(store)
 	default:
 		return nil, errors.New("unknown storage type")
 	}
 	return storageBackend, nil
 }
+
+// ConfigureValueTransformer returns the transformer to apply to keyring and
+// key-value store data according to the given encryption config. If cfg is
+// nil, data is stored unencrypted.
+func ConfigureValueTransformer(cfg *v1beta1.StorageEncryptionSpec) (storage.ValueTransformer, error) {
+	if cfg == nil {
+		return storage.IdentityTransformer{}, nil
+	}
+	switch cfg.Provider {
+	case v1beta1.EncryptionProviderLocal:
+		if cfg.Local == nil || cfg.Local.KeyFile == "" {
+			return nil, errors.New("local encryption key file is not set")
+		}
+		provider, err := encryption.NewLocalKEKProvider(cfg.Local.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return encryption.NewEnvelopeTransformer(provider), nil
+	default:
+		return nil, fmt.Errorf("unknown encryption provider: %q", cfg.Provider)
+	}
+}
+
+// SystemKeyValueNamespaces contains the key-value store namespaces used by the
+// gateway itself, rather than by plugins.
+var SystemKeyValueNamespaces = []string{
+	audit.Namespace,
+	capabilities.StatusNamespace,
+	health.StatusNamespace,
+}
+
+// KeyValueNamespaces returns every key-value store namespace used by a gateway
+// with the given plugins: the gateway's own namespaces, and one namespace for
+// each plugin, named after the plugin's module. Tools which operate on all
+// stored data, such as storage migration and key rotation, should use this
+// list so that no namespace is missed.
+func KeyValueNamespaces(plugins []pluginmeta.PluginMeta) []string {
+	namespaces := append([]string{}, SystemKeyValueNamespaces...)
+	for _, md := range plugins {
+		namespaces = append(namespaces, md.Module)
+	}
+	return namespaces
+}
@@ -6,7 +6,6 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/storage"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type subjectAccessCapableStore struct {
@@ -23,10 +22,17 @@ func (s *subjectAccessCapableStore) ListClusters(
 	ctx context.Context,
 	matchLabels *core.LabelSelector,
 	matchOptions core.MatchOptions,
+	opts ...storage.ListOption,
 ) (*core.ClusterList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
 	return s.client.ListClusters(ctx, &management.ListClustersRequest{
 		MatchLabels:  matchLabels,
 		MatchOptions: matchOptions,
+		PageSize:     int32(options.PageSize),
+		PageToken:    options.PageToken,
+		SortOrder:    options.SortOrder,
+		Capabilities: options.Capabilities,
 	})
 }
 
@@ -39,6 +45,13 @@ func (s *subjectAccessCapableStore) GetRole(
 
 func (s *subjectAccessCapableStore) ListRoleBindings(
 	ctx context.Context,
+	opts ...storage.ListOption,
 ) (*core.RoleBindingList, error) {
-	return s.client.ListRoleBindings(ctx, &emptypb.Empty{})
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	return s.client.ListRoleBindings(ctx, &management.ListRoleBindingsRequest{
+		PageSize:  int32(options.PageSize),
+		PageToken: options.PageToken,
+		SortOrder: options.SortOrder,
+	})
 }
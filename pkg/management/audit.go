@@ -0,0 +1,139 @@
+package management
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/audit"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/validation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Management API methods which modify state, and are recorded in the audit
+// log. Backup is included since it exports all gateway state.
+var auditedMethods = map[string]struct{}{
+	"/management.Management/CreateBootstrapToken": {},
+	"/management.Management/RevokeBootstrapToken": {},
+	"/management.Management/DeleteCluster":        {},
+	"/management.Management/EditCluster":          {},
+	"/management.Management/RotateClusterKeys":    {},
+	"/management.Management/SendClusterCommand":   {},
+	"/management.Management/ApproveCluster":       {},
+	"/management.Management/RejectCluster":        {},
+	"/management.Management/UninstallCapability":  {},
+	"/management.Management/CreateRole":           {},
+	"/management.Management/DeleteRole":           {},
+	"/management.Management/UpdateRole":           {},
+	"/management.Management/CreateRoleBinding":    {},
+	"/management.Management/DeleteRoleBinding":    {},
+	"/management.Management/UpdateRoleBinding":    {},
+	"/management.Management/UpdateConfig":         {},
+	"/management.Management/Backup":               {},
+	"/management.Management/Restore":              {},
+}
+
+// auditInterceptor records an audit event for each call to an audited
+// method, including calls which fail.
+func (m *Server) auditInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if m.auditLog == nil {
+		return handler(ctx, req)
+	}
+	if _, ok := auditedMethods[info.FullMethod]; !ok {
+		return handler(ctx, req)
+	}
+	resp, err := handler(ctx, req)
+	m.recordAuditEvent(ctx, info.FullMethod, req, err)
+	return resp, err
+}
+
+// auditStreamInterceptor is the streaming equivalent of auditInterceptor.
+// The first message received from the client is recorded as the request.
+func (m *Server) auditStreamInterceptor(
+	srv any,
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if m.auditLog == nil {
+		return handler(srv, ss)
+	}
+	if _, ok := auditedMethods[info.FullMethod]; !ok {
+		return handler(srv, ss)
+	}
+	stream := &auditedServerStream{
+		ServerStream: ss,
+	}
+	err := handler(srv, stream)
+	m.recordAuditEvent(ss.Context(), info.FullMethod, stream.request, err)
+	return err
+}
+
+type auditedServerStream struct {
+	grpc.ServerStream
+	request any
+}
+
+func (s *auditedServerStream) RecvMsg(msg any) error {
+	err := s.ServerStream.RecvMsg(msg)
+	if err == nil && s.request == nil {
+		s.request = msg
+	}
+	return err
+}
+
+func (m *Server) recordAuditEvent(ctx context.Context, method string, req any, err error) {
+	event := &core.AuditEvent{
+		Operation: method,
+		Caller:    audit.CallerFromContext(ctx),
+		Result:    audit.NewResult(err),
+	}
+	if msg, ok := req.(proto.Message); ok {
+		event.Request = audit.EncodeRequest(msg)
+	}
+	// The event is recorded even if the caller has gone away
+	if err := m.auditLog.Record(m.ctx, event); err != nil {
+		m.logger.With(
+			zap.Error(err),
+			"method", method,
+		).Error("failed to record audit event")
+	}
+}
+
+func (m *Server) ListAuditEvents(
+	ctx context.Context,
+	in *ListAuditEventsRequest,
+) (*core.AuditEventList, error) {
+	if m.auditLog == nil {
+		return nil, status.Error(codes.Unavailable, "audit log not configured")
+	}
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	var since, until time.Time
+	if in.Since != nil {
+		since = in.Since.AsTime()
+	}
+	if in.Until != nil {
+		until = in.Until.AsTime()
+	}
+	list, err := m.auditLog.List(ctx, since, until, listOptions(in, nil)...)
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidPageToken) {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return list, nil
+}
@@ -0,0 +1,114 @@
+package management_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/audit"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/management"
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ = Describe("Audit", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	BeforeAll(func() {
+		tv = &testVars{
+			ctrl: gomock.NewController(GinkgoT()),
+		}
+		log := audit.NewLog(test.NewTestKeyValueStore(tv.ctrl))
+		setupManagementServer(&tv, management.WithAuditLog(log))()
+	})
+
+	It("should record mutating operations", func() {
+		_, err := tv.client.CreateBootstrapToken(context.Background(), &management.CreateBootstrapTokenRequest{
+			Ttl: durationpb.New(time.Hour),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tv.client.DeleteRole(context.Background(), &core.Reference{
+			Id: "does-not-exist",
+		})
+		Expect(err).To(HaveOccurred())
+
+		list, err := tv.client.ListAuditEvents(context.Background(), &management.ListAuditEventsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+
+		created := list.Items[0]
+		Expect(created.Operation).To(Equal("/management.Management/CreateBootstrapToken"))
+		Expect(created.Result.Code).To(Equal(codes.OK.String()))
+		Expect(created.Caller.Address).NotTo(BeEmpty())
+		Expect(created.Caller.UserAgent).To(ContainSubstring("grpc-go"))
+		Expect(created.Request).To(ContainSubstring("3600s"))
+		Expect(created.Timestamp.AsTime()).To(BeTemporally("~", time.Now(), time.Minute))
+
+		deleted := list.Items[1]
+		Expect(deleted.Operation).To(Equal("/management.Management/DeleteRole"))
+		Expect(deleted.Result.Code).NotTo(Equal(codes.OK.String()))
+		Expect(deleted.Request).To(ContainSubstring("does-not-exist"))
+	})
+	It("should not record read-only operations", func() {
+		_, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tv.client.CertsInfo(context.Background(), &emptypb.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+
+		list, err := tv.client.ListAuditEvents(context.Background(), &management.ListAuditEventsRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+	})
+	It("should filter and paginate events", func() {
+		list, err := tv.client.ListAuditEvents(context.Background(), &management.ListAuditEventsRequest{
+			PageSize:  1,
+			SortOrder: core.SortOrder_Descending,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Operation).To(Equal("/management.Management/DeleteRole"))
+		Expect(list.NextPageToken).NotTo(BeEmpty())
+
+		list, err = tv.client.ListAuditEvents(context.Background(), &management.ListAuditEventsRequest{
+			Since: timestamppb.New(time.Now().Add(time.Minute)),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(BeEmpty())
+	})
+	It("should record streaming operations", func() {
+		Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+			Id: "cluster-1",
+		})).To(Succeed())
+		stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(management.WaitForClusterDeletion(stream, nil)).To(Succeed())
+
+		list, err := tv.client.ListAuditEvents(context.Background(), &management.ListAuditEventsRequest{
+			PageSize:  1,
+			SortOrder: core.SortOrder_Descending,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Operation).To(Equal("/management.Management/DeleteCluster"))
+		Expect(list.Items[0].Result.Code).To(Equal(codes.OK.String()))
+		Expect(list.Items[0].Request).To(ContainSubstring("cluster-1"))
+	})
+})
+
+var _ = Describe("Audit (not configured)", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	BeforeAll(setupManagementServer(&tv))
+
+	It("should return an Unavailable error", func() {
+		_, err := tv.client.ListAuditEvents(context.Background(), &management.ListAuditEventsRequest{})
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+	})
+})
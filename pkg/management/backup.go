@@ -0,0 +1,110 @@
+package management
+
+import (
+	"errors"
+	"io"
+
+	"github.com/rancher/opni-monitoring/pkg/backup"
+	"github.com/rancher/opni-monitoring/pkg/validation"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (m *Server) Backup(
+	req *BackupRequest,
+	stream Management_BackupServer,
+) error {
+	ctx := stream.Context()
+	lg := m.logger
+	contents, err := backup.Create(ctx, m.coreDataSource.StorageBackend(),
+		backup.WithKeyValueNamespaces(m.keyValueNamespaces()...),
+	)
+	if err != nil {
+		lg.With(
+			zap.Error(err),
+		).Error("failed to create backup")
+		return status.Error(codes.Internal, err.Error())
+	}
+	archive, err := backup.Marshal(contents, []byte(req.GetPassphrase()))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	for len(archive) > 0 {
+		n := len(archive)
+		if n > BackupChunkSize {
+			n = BackupChunkSize
+		}
+		if err := stream.Send(&BackupResponse{
+			Chunk: archive[:n],
+		}); err != nil {
+			return err
+		}
+		archive = archive[n:]
+	}
+	lg.With(
+		"tokens", len(contents.Tokens),
+		"clusters", len(contents.Clusters),
+		"roles", len(contents.Roles),
+		"roleBindings", len(contents.RoleBindings),
+		"keyrings", len(contents.Keyrings),
+		"keyValues", len(contents.KeyValues),
+		"encrypted", req.GetPassphrase() != "",
+	).Info("created backup")
+	return nil
+}
+
+func (m *Server) Restore(stream Management_RestoreServer) error {
+	ctx := stream.Context()
+	lg := m.logger
+	// Reassemble the archive, taking the passphrase from the first message
+	req := &RestoreRequest{}
+	for first := true; ; first = false {
+		chunk, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return err
+		}
+		if first {
+			req.Passphrase = chunk.GetPassphrase()
+		}
+		req.Chunk = append(req.Chunk, chunk.GetChunk()...)
+	}
+	if err := validation.Validate(req); err != nil {
+		return err
+	}
+	contents, err := backup.Unmarshal(req.GetChunk(), []byte(req.GetPassphrase()))
+	if err != nil {
+		switch {
+		case errors.Is(err, backup.ErrPassphraseRequired):
+			return status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, backup.ErrDecryptionFailed):
+			return status.Error(codes.PermissionDenied, err.Error())
+		default:
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if err := backup.Restore(ctx, m.coreDataSource.StorageBackend(), contents); err != nil {
+		lg.With(
+			zap.Error(err),
+		).Error("failed to restore backup")
+		return status.Error(codes.Internal, err.Error())
+	}
+	lg.With(
+		"timestamp", contents.GetTimestamp().AsTime(),
+	).Info("restored backup")
+	return stream.SendAndClose(&emptypb.Empty{})
+}
+
+// keyValueNamespaces returns the key-value store namespaces used by system
+// plugins, which are named after the plugin's module.
+func (m *Server) keyValueNamespaces() []string {
+	namespaces := make([]string, 0, len(m.systemPlugins))
+	for _, plugin := range m.systemPlugins {
+		namespaces = append(namespaces, plugin.Metadata.Module)
+	}
+	return namespaces
+}
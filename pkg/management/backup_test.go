@@ -0,0 +1,121 @@
+package management_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/management"
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"github.com/rancher/opni-monitoring/pkg/validation"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ = Describe("Backup", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	BeforeAll(setupManagementServer(&tv))
+
+	createBackup := func(passphrase string) []byte {
+		stream, err := tv.client.Backup(context.Background(), &management.BackupRequest{
+			Passphrase: passphrase,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var buf bytes.Buffer
+		Expect(management.ReceiveBackupArchive(stream, &buf)).To(Succeed())
+		return buf.Bytes()
+	}
+	restoreBackup := func(archive []byte, passphrase string) error {
+		stream, err := tv.client.Restore(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		return management.SendRestoreArchive(stream, bytes.NewReader(archive), passphrase)
+	}
+
+	var token *core.BootstrapToken
+	var archive []byte
+	It("should create a backup", func() {
+		var err error
+		token, err = tv.client.CreateBootstrapToken(context.Background(), &management.CreateBootstrapTokenRequest{
+			Ttl: durationpb.New(time.Hour),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+			Id: "cluster-1",
+		})).To(Succeed())
+		_, err = tv.client.CreateRole(context.Background(), &core.Role{
+			Id: "role-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		archive = createBackup("passphrase")
+		Expect(archive).NotTo(BeEmpty())
+	})
+	It("should restore a backup", func() {
+		_, err := tv.client.RevokeBootstrapToken(context.Background(), token.Reference())
+		Expect(err).NotTo(HaveOccurred())
+		stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(management.WaitForClusterDeletion(stream, nil)).To(Succeed())
+		_, err = tv.client.DeleteRole(context.Background(), &core.Reference{Id: "role-1"})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(restoreBackup(archive, "passphrase")).To(Succeed())
+
+		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tokens.Items).To(HaveLen(1))
+		Expect(tokens.Items[0].TokenID).To(Equal(token.TokenID))
+		Expect(tokens.Items[0].Secret).To(Equal(token.Secret))
+
+		_, err = tv.client.GetCluster(context.Background(), &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = tv.client.GetRole(context.Background(), &core.Reference{Id: "role-1"})
+		Expect(err).NotTo(HaveOccurred())
+	})
+	It("should reject invalid restore requests", func() {
+		err := restoreBackup(nil, "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(validation.ErrMissingRequiredField.Error()))
+
+		err = restoreBackup(archive, "")
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+		err = restoreBackup(archive, "wrong")
+		Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+		err = restoreBackup([]byte("not an archive"), "")
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	})
+	It("should stream archives larger than the maximum message size", func() {
+		// Random data, so that the compressed archive is still large
+		data := make([]byte, 6*1024*1024)
+		_, err := rand.Read(data)
+		Expect(err).NotTo(HaveOccurred())
+		large := base64.StdEncoding.EncodeToString(data)
+		Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+			Id: "cluster-2",
+			Metadata: &core.ClusterMetadata{
+				Labels: map[string]string{
+					"large": large,
+				},
+			},
+		})).To(Succeed())
+		archive := createBackup("")
+		Expect(len(archive)).To(BeNumerically(">", 4*1024*1024))
+
+		stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{Id: "cluster-2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(management.WaitForClusterDeletion(stream, nil)).To(Succeed())
+
+		Expect(restoreBackup(archive, "")).To(Succeed())
+		cluster, err := tv.storageBackend.GetCluster(context.Background(), &core.Reference{Id: "cluster-2"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetLabels()["large"]).To(Equal(large))
+	})
+})
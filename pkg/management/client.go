@@ -2,6 +2,8 @@ package management
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -46,3 +48,80 @@ func NewClient(ctx context.Context, opts ...ManagementClientOption) (ManagementC
 	}
 	return NewManagementClient(cc), nil
 }
+
+// WaitForClusterDeletion receives progress updates from a DeleteCluster stream
+// until the deletion has finished, and returns the error which caused it to
+// fail, if any. If onProgress is not nil, it is called for each update.
+func WaitForClusterDeletion(
+	stream Management_DeleteClusterClient,
+	onProgress func(*DeleteClusterProgress),
+) error {
+	for {
+		progress, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if onProgress != nil {
+			onProgress(progress)
+		}
+	}
+}
+
+// BackupChunkSize is the maximum size of each chunk of a backup archive sent
+// by Backup and SendRestoreArchive.
+const BackupChunkSize = 1 << 20
+
+// ReceiveBackupArchive writes the chunks received from a Backup stream to w,
+// until the archive is complete.
+func ReceiveBackupArchive(stream Management_BackupClient, w io.Writer) error {
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if _, err := w.Write(resp.GetChunk()); err != nil {
+			return err
+		}
+	}
+}
+
+// SendRestoreArchive sends an archive read from r over a Restore stream in
+// chunks, and waits for the restore to complete.
+func SendRestoreArchive(stream Management_RestoreClient, r io.Reader, passphrase string) error {
+	buf := make([]byte, BackupChunkSize)
+	first := true
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 || first {
+			req := &RestoreRequest{
+				Chunk: buf[:n],
+			}
+			if first {
+				req.Passphrase = passphrase
+				first = false
+			}
+			if err := stream.Send(req); err != nil {
+				if errors.Is(err, io.EOF) {
+					// The server closed the stream, the error is returned by
+					// CloseAndRecv
+					break
+				}
+				return err
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := stream.CloseAndRecv()
+	return err
+}
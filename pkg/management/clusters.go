@@ -2,13 +2,21 @@ package management
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/health"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/validation"
+	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (m *Server) ListClusters(
@@ -18,21 +26,409 @@ func (m *Server) ListClusters(
 	if err := validation.Validate(in); err != nil {
 		return nil, err
 	}
-	clusterList, err := m.coreDataSource.StorageBackend().ListClusters(ctx, in.MatchLabels, in.MatchOptions)
+	clusterList, err := m.coreDataSource.StorageBackend().ListClusters(ctx, in.MatchLabels, in.MatchOptions,
+		listOptions(in, in.GetCapabilities())...)
 	if err != nil {
 		return nil, err
 	}
+	if statuses, err := m.clusterStatuses(); err == nil {
+		for i, cluster := range clusterList.Items {
+			if status := m.clusterStatus(ctx, statuses, cluster.Id); status != nil {
+				// The storage backend may return shared objects
+				clusterList.Items[i] = cluster.DeepCopy()
+				clusterList.Items[i].Status = status
+			}
+		}
+	}
 	return clusterList, nil
 }
 
-func (m *Server) DeleteCluster(
+// clusterStatus returns the status most recently reported by the cluster's
+// agent, or nil if it is not available. A cluster's status is informational,
+// so errors reading it (for example, if it can no longer be decrypted) are
+// logged instead of failing the request.
+func (m *Server) clusterStatus(
 	ctx context.Context,
+	statuses *health.StatusStore,
+	id string,
+) *core.ClusterStatus {
+	status, err := statuses.Get(ctx, id)
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			m.logger.With(
+				"cluster", id,
+				zap.Error(err),
+			).Warn("failed to get cluster status")
+		}
+		return nil
+	}
+	return status
+}
+
+// clusterStatuses returns the store containing the status most recently
+// reported by each cluster's agent.
+func (m *Server) clusterStatuses() (*health.StatusStore, error) {
+	store, err := m.coreDataSource.StorageBackend().KeyValueStore(health.StatusNamespace)
+	if err != nil {
+		return nil, err
+	}
+	return health.NewStatusStore(store), nil
+}
+
+// DeleteCluster revokes the cluster's keyring, uninstalls each of its
+// capabilities, and then removes the cluster itself, sending progress updates
+// for each step. If any step fails, the cluster is not removed and the
+// deletion can be retried.
+func (m *Server) DeleteCluster(
 	ref *core.Reference,
+	stream Management_DeleteClusterServer,
+) error {
+	if err := validation.Validate(ref); err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	backend := m.coreDataSource.StorageBackend()
+	cluster, err := backend.GetCluster(ctx, ref)
+	if err != nil {
+		return err
+	}
+	send := func(progress *DeleteClusterProgress) error {
+		if err := stream.Send(progress); err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+		return nil
+	}
+
+	// Revoke the keyring first, so the agent can no longer authenticate
+	// while the rest of the cluster is being cleaned up.
+	if err := send(&DeleteClusterProgress{
+		Step:  DeleteClusterStep_RevokeKeyring,
+		State: DeleteClusterStepState_StepStarted,
+	}); err != nil {
+		return err
+	}
+	if err := m.revokeKeyring(ctx, ref); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			if err := send(&DeleteClusterProgress{
+				Step:    DeleteClusterStep_RevokeKeyring,
+				State:   DeleteClusterStepState_StepSkipped,
+				Message: "keyring not found",
+			}); err != nil {
+				return err
+			}
+		} else {
+			send(&DeleteClusterProgress{
+				Step:    DeleteClusterStep_RevokeKeyring,
+				State:   DeleteClusterStepState_StepFailed,
+				Message: err.Error(),
+			})
+			return fmt.Errorf("failed to revoke keyring: %w", err)
+		}
+	} else if err := send(&DeleteClusterProgress{
+		Step:  DeleteClusterStep_RevokeKeyring,
+		State: DeleteClusterStepState_StepCompleted,
+	}); err != nil {
+		return err
+	}
+
+	// Stop any installations which are still in progress, so that they do
+	// not recreate resources after the capabilities are uninstalled
+	if m.capabilitiesDataSource != nil {
+		m.capabilitiesDataSource.CapabilitiesStore().CancelInstalls(ref)
+	}
+	for _, cap := range cluster.GetCapabilities() {
+		if err := send(&DeleteClusterProgress{
+			Step:       DeleteClusterStep_UninstallCapability,
+			State:      DeleteClusterStepState_StepStarted,
+			Capability: cap.Name,
+		}); err != nil {
+			return err
+		}
+		if m.capabilitiesDataSource == nil {
+			if err := send(&DeleteClusterProgress{
+				Step:       DeleteClusterStep_UninstallCapability,
+				State:      DeleteClusterStepState_StepSkipped,
+				Capability: cap.Name,
+				Message:    "capability backend store not configured",
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := m.capabilitiesDataSource.CapabilitiesStore().UninstallCapability(ref, cap.Name); err != nil {
+			if errors.Is(err, capabilities.ErrBackendNotFound) {
+				// The plugin providing this capability is no longer loaded, so
+				// there is nothing which can be uninstalled.
+				if err := send(&DeleteClusterProgress{
+					Step:       DeleteClusterStep_UninstallCapability,
+					State:      DeleteClusterStepState_StepSkipped,
+					Capability: cap.Name,
+					Message:    err.Error(),
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+			send(&DeleteClusterProgress{
+				Step:       DeleteClusterStep_UninstallCapability,
+				State:      DeleteClusterStepState_StepFailed,
+				Capability: cap.Name,
+				Message:    err.Error(),
+			})
+			return fmt.Errorf("failed to uninstall capability %q: %w", cap.Name, err)
+		}
+		if err := send(&DeleteClusterProgress{
+			Step:       DeleteClusterStep_UninstallCapability,
+			State:      DeleteClusterStepState_StepCompleted,
+			Capability: cap.Name,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if err := send(&DeleteClusterProgress{
+		Step:  DeleteClusterStep_RemoveCluster,
+		State: DeleteClusterStepState_StepStarted,
+	}); err != nil {
+		return err
+	}
+	if err := backend.DeleteCluster(ctx, ref); err != nil {
+		send(&DeleteClusterProgress{
+			Step:    DeleteClusterStep_RemoveCluster,
+			State:   DeleteClusterStepState_StepFailed,
+			Message: err.Error(),
+		})
+		return err
+	}
+	if statuses, err := m.clusterStatuses(); err == nil {
+		if err := statuses.Delete(ctx, ref.Id); err != nil {
+			m.logger.With(
+				"cluster", ref.Id,
+				zap.Error(err),
+			).Warn("failed to delete cluster status")
+		}
+	}
+	return send(&DeleteClusterProgress{
+		Step:  DeleteClusterStep_RemoveCluster,
+		State: DeleteClusterStepState_StepCompleted,
+	})
+}
+
+// UninstallCapability uninstalls a capability from a cluster and removes it
+// from the cluster's metadata. The cluster's keyring is kept even if no
+// capabilities remain, so that the agent can still authenticate and install
+// capabilities again; it is only revoked when the cluster is deleted.
+func (m *Server) UninstallCapability(
+	ctx context.Context,
+	in *UninstallCapabilityRequest,
 ) (*emptypb.Empty, error) {
+	if m.capabilitiesDataSource == nil {
+		return nil, status.Error(codes.Unavailable, "capability backend store not configured")
+	}
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	backend := m.coreDataSource.StorageBackend()
+	cluster, err := backend.GetCluster(ctx, in.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	if !capabilities.Has(cluster, capabilities.Cluster(in.Name)) {
+		return nil, status.Errorf(codes.NotFound, "capability %q is not installed on cluster %q",
+			in.Name, in.Cluster.Id)
+	}
+	if err := m.capabilitiesDataSource.CapabilitiesStore().UninstallCapability(in.Cluster, in.Name); err != nil {
+		if errors.Is(err, capabilities.ErrBackendNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, fmt.Errorf("failed to uninstall capability %q: %w", in.Name, err)
+	}
+	if _, err := backend.UpdateCluster(ctx, in.Cluster,
+		storage.NewRemoveCapabilityMutator[*core.Cluster](capabilities.Cluster(in.Name)),
+	); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// revokeKeyring deletes the cluster's keyring, and closes the agent's control
+// stream, which would otherwise remain open.
+func (m *Server) revokeKeyring(ctx context.Context, ref *core.Reference) error {
+	ks, err := m.coreDataSource.StorageBackend().KeyringStore(ctx, "gateway", ref)
+	if err != nil {
+		return err
+	}
+	err = ks.Delete(ctx)
+	m.disconnectAgent(ref)
+	return err
+}
+
+// disconnectAgent closes the cluster's control stream, if it is connected, so
+// that the agent must authenticate again with its current keys.
+func (m *Server) disconnectAgent(ref *core.Reference) {
+	if m.agentControlDataSource == nil {
+		return
+	}
+	if controller := m.agentControlDataSource.AgentControl(); controller != nil {
+		controller.Disconnect(ref.Id)
+	}
+}
+
+func (m *Server) RotateClusterKeys(
+	ctx context.Context,
+	in *RotateClusterKeysRequest,
+) (*RotateClusterKeysResponse, error) {
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	if _, err := m.coreDataSource.StorageBackend().GetCluster(ctx, in.Cluster); err != nil {
+		return nil, err
+	}
+	ks, err := m.coreDataSource.StorageBackend().KeyringStore(ctx, "gateway", in.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	gracePeriod := keyring.DefaultRotationGracePeriod
+	if in.GracePeriod != nil {
+		gracePeriod = in.GracePeriod.AsDuration()
+	}
+	// Each of the cluster's agents will perform a new key exchange once it
+	// sees that its keys are expiring, which replaces only that agent's keys.
+	expiresAt := time.Now().Add(gracePeriod)
+	kr, err := ks.Update(ctx, func(kr keyring.Keyring) (keyring.Keyring, error) {
+		return keyring.Expire(kr, expiresAt), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The control stream may have been opened with keys which are now being
+	// rotated out
+	m.disconnectAgent(in.Cluster)
+	// Keys which were already expiring keep their earlier expiration time
+	var latest *time.Time
+	kr.Try(func(sk *keyring.SharedKeys) {
+		if latest == nil || sk.ExpiresAt.After(*latest) {
+			latest = sk.ExpiresAt
+		}
+	})
+	if latest != nil {
+		expiresAt = *latest
+	}
+	return &RotateClusterKeysResponse{
+		ExpiresAt: timestamppb.New(expiresAt),
+	}, nil
+}
+
+// SendClusterCommand sends a command to the cluster's agent over its control
+// stream, and waits for the agent to respond.
+func (m *Server) SendClusterCommand(
+	ctx context.Context,
+	in *ClusterCommandRequest,
+) (*ClusterCommandResponse, error) {
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	if _, err := m.coreDataSource.StorageBackend().GetCluster(ctx, in.Cluster); err != nil {
+		return nil, err
+	}
+	if m.agentControlDataSource == nil {
+		return nil, status.Error(codes.Unavailable, "agent control not configured")
+	}
+	sender := m.agentControlDataSource.AgentControl()
+	if sender == nil {
+		return nil, status.Error(codes.Unavailable, "agent control not available")
+	}
+	result, err := sender.SendCommand(ctx, in.Cluster.Id, in.Type)
+	if err != nil {
+		return nil, err
+	}
+	if result.GetError() != "" {
+		return nil, status.Errorf(codes.Unknown, "command failed: %s", result.GetError())
+	}
+	return &ClusterCommandResponse{
+		Data: result.GetData(),
+	}, nil
+}
+
+// ApproveCluster approves a cluster which is pending approval, allowing its
+// agent to access the gateway, and installs the capability requested by the
+// agent when it was bootstrapped.
+func (m *Server) ApproveCluster(
+	ctx context.Context,
+	ref *core.Reference,
+) (*core.Cluster, error) {
+	if err := validation.Validate(ref); err != nil {
+		return nil, err
+	}
+	backend := m.coreDataSource.StorageBackend()
+	cluster, err := m.getPendingCluster(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	capability := cluster.GetMetadata().GetApproval().GetRequestedCapability()
+	if capability != "" && m.capabilitiesDataSource == nil {
+		return nil, status.Error(codes.Unavailable, "capability backend store not configured")
+	}
+	mutators := []storage.MutatorFunc[*core.Cluster]{
+		storage.NewCompareAndSwapMutator[*core.Cluster](cluster.GetResourceVersion()),
+		func(c *core.Cluster) {
+			c.Metadata.Approval.State = core.ApprovalState_ClusterApproved
+		},
+	}
+	if capability != "" {
+		mutators = append(mutators,
+			storage.NewAddCapabilityMutator[*core.Cluster](capabilities.Cluster(capability)))
+	}
+	cluster, err = backend.UpdateCluster(ctx, ref, storage.NewCompositeMutator(mutators...))
+	if err != nil {
+		return nil, err
+	}
+	if capability != "" {
+		m.capabilitiesDataSource.CapabilitiesStore().InstallCapabilities(ref, capability)
+	}
+	return cluster, nil
+}
+
+// RejectCluster rejects a cluster which is pending approval and revokes its
+// keyring. The cluster is kept so that it can be inspected, and must be
+// deleted before an agent with the same ID can be bootstrapped again.
+func (m *Server) RejectCluster(
+	ctx context.Context,
+	ref *core.Reference,
+) (*core.Cluster, error) {
 	if err := validation.Validate(ref); err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, m.coreDataSource.StorageBackend().DeleteCluster(ctx, ref)
+	cluster, err := m.getPendingCluster(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	cluster, err = m.coreDataSource.StorageBackend().UpdateCluster(ctx, ref,
+		storage.NewCompositeMutator(
+			storage.NewCompareAndSwapMutator[*core.Cluster](cluster.GetResourceVersion()),
+			func(c *core.Cluster) {
+				c.Metadata.Approval.State = core.ApprovalState_ClusterRejected
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.revokeKeyring(ctx, ref); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("failed to revoke keyring: %w", err)
+	}
+	return cluster, nil
+}
+
+func (m *Server) getPendingCluster(ctx context.Context, ref *core.Reference) (*core.Cluster, error) {
+	cluster, err := m.coreDataSource.StorageBackend().GetCluster(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.GetMetadata().GetApproval().GetState() != core.ApprovalState_ClusterPendingApproval {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %q is not pending approval", ref.Id)
+	}
+	return cluster, nil
 }
 
 func (m *Server) GetCluster(
@@ -42,11 +438,18 @@ func (m *Server) GetCluster(
 	if err := validation.Validate(ref); err != nil {
 		return nil, err
 	}
-	if cluster, err := m.coreDataSource.StorageBackend().GetCluster(ctx, ref); err != nil {
+	cluster, err := m.coreDataSource.StorageBackend().GetCluster(ctx, ref)
+	if err != nil {
 		return nil, err
-	} else {
-		return cluster, nil
 	}
+	if statuses, err := m.clusterStatuses(); err == nil {
+		if status := m.clusterStatus(ctx, statuses, ref.Id); status != nil {
+			// The storage backend may return shared objects
+			cluster = cluster.DeepCopy()
+			cluster.Status = status
+		}
+	}
+	return cluster, nil
 }
 
 func (m *Server) WatchClusters(
@@ -56,46 +459,31 @@ func (m *Server) WatchClusters(
 	if err := validation.Validate(in); err != nil {
 		return err
 	}
-	known := map[string]*core.Reference{}
-	for _, cluster := range in.KnownClusters.Items {
-		if _, err := m.coreDataSource.StorageBackend().GetCluster(context.Background(), cluster); err != nil {
+	known := make([]*core.Cluster, 0, len(in.KnownClusters.GetItems()))
+	for _, ref := range in.KnownClusters.GetItems() {
+		cluster, err := m.coreDataSource.StorageBackend().GetCluster(stream.Context(), ref)
+		if err != nil {
 			return err
 		}
-		known[cluster.Id] = cluster
+		known = append(known, cluster)
+	}
+	ctx, ca := context.WithCancel(stream.Context())
+	defer ca()
+	events, err := m.coreDataSource.StorageBackend().WatchClusters(ctx, known)
+	if err != nil {
+		return err
 	}
-	tick := time.NewTicker(1 * time.Second)
-	defer tick.Stop()
 	for {
 		select {
-		case <-tick.C:
-			clusters, err := m.coreDataSource.StorageBackend().ListClusters(context.Background(), nil, 0)
-			updatedIds := map[string]struct{}{}
-			if err != nil {
-				return err
-			}
-			for _, cluster := range clusters.Items {
-				updatedIds[cluster.Id] = struct{}{}
-				if _, ok := known[cluster.Id]; !ok {
-					ref := cluster.Reference()
-					known[cluster.Id] = ref
-					if err := stream.Send(&WatchEvent{
-						Cluster: ref,
-						Type:    WatchEventType_Added,
-					}); err != nil {
-						return status.Error(codes.Internal, err.Error())
-					}
+		case event, ok := <-events:
+			if !ok {
+				if err := stream.Context().Err(); err != nil {
+					return err
 				}
+				return status.Error(codes.Unavailable, "cluster watch closed unexpectedly")
 			}
-			for id, cluster := range known {
-				if _, ok := updatedIds[id]; !ok {
-					delete(known, id)
-					if err := stream.Send(&WatchEvent{
-						Cluster: cluster,
-						Type:    WatchEventType_Deleted,
-					}); err != nil {
-						return status.Error(codes.Internal, err.Error())
-					}
-				}
+			if err := stream.Send(newWatchEvent(event)); err != nil {
+				return status.Error(codes.Internal, err.Error())
 			}
 		case <-stream.Context().Done():
 			return stream.Context().Err()
@@ -110,10 +498,43 @@ func (m *Server) EditCluster(
 	if err := validation.Validate(in); err != nil {
 		return nil, err
 	}
-	return m.coreDataSource.StorageBackend().UpdateCluster(ctx, in.GetCluster(), func(cluster *core.Cluster) {
-		if cluster.Metadata == nil {
-			cluster.Metadata = &core.ClusterMetadata{}
+	return m.coreDataSource.StorageBackend().UpdateCluster(ctx, in.GetCluster(),
+		storage.NewCompositeMutator(
+			storage.NewCompareAndSwapMutator[*core.Cluster](in.GetResourceVersion()),
+			func(cluster *core.Cluster) {
+				labels := cluster.GetLabels()
+				if labels == nil {
+					labels = map[string]string{}
+				}
+				for k, v := range in.GetLabels() {
+					labels[k] = v
+				}
+				for _, k := range in.GetRemoveLabels() {
+					delete(labels, k)
+				}
+				cluster.SetLabels(labels)
+			},
+		),
+	)
+}
+
+func newWatchEvent(event storage.WatchEvent[*core.Cluster]) *WatchEvent {
+	switch event.EventType {
+	case storage.WatchEventCreate:
+		return &WatchEvent{
+			Cluster: event.Current,
+			Type:    WatchEventType_Added,
 		}
-		cluster.Metadata.Labels = in.GetLabels()
-	})
+	case storage.WatchEventUpdate:
+		return &WatchEvent{
+			Cluster:  event.Current,
+			Type:     WatchEventType_Modified,
+			Previous: event.Previous,
+		}
+	default:
+		return &WatchEvent{
+			Cluster: event.Previous,
+			Type:    WatchEventType_Deleted,
+		}
+	}
 }
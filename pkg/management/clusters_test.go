@@ -2,19 +2,29 @@ package management_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
+	"github.com/rancher/opni-monitoring/pkg/capabilities"
+	"github.com/rancher/opni-monitoring/pkg/control"
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/health"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/test"
 	"github.com/rancher/opni-monitoring/pkg/validation"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var _ = Describe("Clusters", Ordered, Label(test.Unit, test.Slow), func() {
@@ -114,6 +124,125 @@ var _ = Describe("Clusters", Ordered, Label(test.Unit, test.Slow), func() {
 		updatedQueried, err := tv.client.GetCluster(context.Background(), ref)
 		Expect(err).NotTo(HaveOccurred())
 		Expect(updatedQueried.Metadata.Labels).To(HaveKeyWithValue("i", "999"))
+
+		select {
+		case event := <-events:
+			Expect(event.Type).To(Equal(management.WatchEventType_Modified))
+			Expect(event.Cluster.Id).To(Equal(ref.Id))
+			Expect(event.Cluster.Metadata.Labels).To(HaveKeyWithValue("i", "999"))
+			Expect(event.Previous.Metadata.Labels).To(HaveKeyWithValue("i", "20"))
+		case <-time.After(1 * time.Second):
+			Fail("timed out waiting for cluster modify event")
+		}
+	})
+	It("should merge and remove cluster labels", func() {
+		clusters, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		cluster := clusters.Items[0]
+		ref := cluster.Reference()
+		_, err = tv.client.EditCluster(context.Background(), &management.EditClusterRequest{
+			Cluster: ref,
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		updated, err := tv.client.EditCluster(context.Background(), &management.EditClusterRequest{
+			Cluster: ref,
+			Labels: map[string]string{
+				"bar": "baz",
+			},
+			RemoveLabels: []string{"i"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.GetLabels()).To(Equal(map[string]string{
+			"foo": "bar",
+			"bar": "baz",
+		}))
+		Expect(updated.GetResourceVersion()).NotTo(Equal(cluster.GetResourceVersion()))
+		Expect(updated.GetCreationTimestamp().AsTime()).To(Equal(cluster.GetCreationTimestamp().AsTime()))
+		Expect(updated.GetLastModified().AsTime()).To(BeTemporally(">", cluster.GetLastModified().AsTime()))
+
+		for i := 0; i < 2; i++ {
+			select {
+			case event := <-events:
+				Expect(event.Type).To(Equal(management.WatchEventType_Modified))
+				Expect(event.Cluster.Id).To(Equal(ref.Id))
+			case <-time.After(1 * time.Second):
+				Fail("timed out waiting for cluster modify event")
+			}
+		}
+	})
+	It("should reject cluster edits with a stale resource version", func() {
+		clusters, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		cluster := clusters.Items[0]
+
+		updated, err := tv.client.EditCluster(context.Background(), &management.EditClusterRequest{
+			Cluster: cluster.Reference(),
+			Labels: map[string]string{
+				"foo": "1",
+			},
+			ResourceVersion: cluster.GetResourceVersion(),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.GetLabels()).To(HaveKeyWithValue("foo", "1"))
+
+		_, err = tv.client.EditCluster(context.Background(), &management.EditClusterRequest{
+			Cluster: cluster.Reference(),
+			Labels: map[string]string{
+				"foo": "2",
+			},
+			ResourceVersion: cluster.GetResourceVersion(),
+		})
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+
+		current, err := tv.client.GetCluster(context.Background(), cluster.Reference())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.GetLabels()).To(HaveKeyWithValue("foo", "1"))
+		Expect(current.GetResourceVersion()).To(Equal(updated.GetResourceVersion()))
+
+		select {
+		case event := <-events:
+			Expect(event.Type).To(Equal(management.WatchEventType_Modified))
+			Expect(event.Cluster.Id).To(Equal(cluster.Id))
+		case <-time.After(1 * time.Second):
+			Fail("timed out waiting for cluster modify event")
+		}
+	})
+	It("should list clusters in pages", func() {
+		all, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(all.NextPageToken).To(BeEmpty())
+		var expected []string
+		for _, cluster := range all.Items {
+			expected = append(expected, cluster.Id)
+		}
+		Expect(sort.StringsAreSorted(expected)).To(BeTrue())
+
+		var ids []string
+		token := ""
+		for {
+			page, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{
+				PageSize:  7,
+				PageToken: token,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(len(page.Items)).To(BeNumerically("<=", 7))
+			for _, cluster := range page.Items {
+				ids = append(ids, cluster.Id)
+			}
+			if page.NextPageToken == "" {
+				break
+			}
+			token = page.NextPageToken
+		}
+		Expect(ids).To(Equal(expected))
+
+		_, err = tv.client.ListClusters(context.Background(), &management.ListClustersRequest{
+			PageToken: "not a token!",
+		})
+		Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
 	})
 	It("should delete clusters", func() {
 		clusters, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
@@ -143,10 +272,11 @@ var _ = Describe("Clusters", Ordered, Label(test.Unit, test.Slow), func() {
 			}
 		}()
 		for _, cluster := range clusters.Items {
-			_, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
+			stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
 				Id: cluster.Id,
 			})
 			Expect(err).NotTo(HaveOccurred())
+			Expect(management.WaitForClusterDeletion(stream, nil)).To(Succeed())
 			// watch events should be batched every second, wait 4 seconds in total
 			// for all events to be received
 			time.Sleep(100 * time.Millisecond)
@@ -171,9 +301,11 @@ var _ = Describe("Clusters", Ordered, Label(test.Unit, test.Slow), func() {
 	})
 	When("attempting to delete a nonexistent cluster", func() {
 		It("should error", func() {
-			_, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
+			stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
 				Id: "nonexistent",
 			})
+			Expect(err).NotTo(HaveOccurred())
+			err = management.WaitForClusterDeletion(stream, nil)
 			Expect(status.Code(err)).To(Equal(codes.NotFound))
 		})
 	})
@@ -202,9 +334,11 @@ var _ = Describe("Clusters", Ordered, Label(test.Unit, test.Slow), func() {
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring(validation.ErrInvalidID.Error()))
 
-		_, err = tv.client.DeleteCluster(context.Background(), &core.Reference{
+		deleteStream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
 			Id: "\\",
 		})
+		Expect(err).NotTo(HaveOccurred())
+		err = management.WaitForClusterDeletion(deleteStream, nil)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring(validation.ErrInvalidID.Error()))
 
@@ -237,3 +371,606 @@ var _ = Describe("Clusters", Ordered, Label(test.Unit, test.Slow), func() {
 		Expect(err.Error()).To(ContainSubstring(storage.ErrNotFound.Error()))
 	})
 })
+
+var _ = Describe("Cluster Deletion", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	var uninstalled []string
+	var uninstallErr error
+	BeforeAll(func() {
+		tv = &testVars{ctrl: gomock.NewController(GinkgoT())}
+		capBackendStore := capabilities.NewBackendStore(capabilities.ServerInstallerTemplateSpec{}, test.Log)
+		capBackendStore.Add("test", test.NewTestCapabilityBackend(tv.ctrl, &test.CapabilityInfo{
+			Name:       "test",
+			CanInstall: true,
+			OnUninstall: func(cluster *core.Reference) error {
+				if uninstallErr != nil {
+					return uninstallErr
+				}
+				uninstalled = append(uninstalled, cluster.Id)
+				return nil
+			},
+		}))
+		setupManagementServer(&tv, management.WithCapabilitiesDataSource(testCapabilityDataSource{
+			store: capBackendStore,
+		}))()
+	})
+	createCluster := func(id string, caps ...string) {
+		cluster := &core.Cluster{
+			Id:       id,
+			Metadata: &core.ClusterMetadata{},
+		}
+		for _, c := range caps {
+			cluster.Metadata.Capabilities = append(cluster.Metadata.Capabilities, &core.ClusterCapability{
+				Name: c,
+			})
+		}
+		Expect(tv.storageBackend.CreateCluster(context.Background(), cluster)).To(Succeed())
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", cluster.Reference())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(context.Background(), keyring.New())).To(Succeed())
+	}
+	deleteCluster := func(id string) ([]*management.DeleteClusterProgress, error) {
+		stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
+			Id: id,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var progress []*management.DeleteClusterProgress
+		err = management.WaitForClusterDeletion(stream, func(p *management.DeleteClusterProgress) {
+			progress = append(progress, p)
+		})
+		return progress, err
+	}
+	keyringExists := func(id string) bool {
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: id,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ks.Get(context.Background())
+		return err == nil
+	}
+	It("should revoke the keyring and uninstall capabilities", func() {
+		createCluster("cluster-1", "test", "unknown")
+		progress, err := deleteCluster("cluster-1")
+		Expect(err).NotTo(HaveOccurred())
+
+		type step struct {
+			Step       management.DeleteClusterStep
+			State      management.DeleteClusterStepState
+			Capability string
+		}
+		steps := make([]step, len(progress))
+		for i, p := range progress {
+			steps[i] = step{p.Step, p.State, p.Capability}
+		}
+		Expect(steps).To(Equal([]step{
+			{management.DeleteClusterStep_RevokeKeyring, management.DeleteClusterStepState_StepStarted, ""},
+			{management.DeleteClusterStep_RevokeKeyring, management.DeleteClusterStepState_StepCompleted, ""},
+			{management.DeleteClusterStep_UninstallCapability, management.DeleteClusterStepState_StepStarted, "test"},
+			{management.DeleteClusterStep_UninstallCapability, management.DeleteClusterStepState_StepCompleted, "test"},
+			{management.DeleteClusterStep_UninstallCapability, management.DeleteClusterStepState_StepStarted, "unknown"},
+			{management.DeleteClusterStep_UninstallCapability, management.DeleteClusterStepState_StepSkipped, "unknown"},
+			{management.DeleteClusterStep_RemoveCluster, management.DeleteClusterStepState_StepStarted, ""},
+			{management.DeleteClusterStep_RemoveCluster, management.DeleteClusterStepState_StepCompleted, ""},
+		}))
+		Expect(progress[5].Message).To(ContainSubstring(capabilities.ErrBackendNotFound.Error()))
+
+		Expect(uninstalled).To(ConsistOf("cluster-1"))
+		Expect(keyringExists("cluster-1")).To(BeFalse())
+		_, err = tv.client.GetCluster(context.Background(), &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+	When("a capability cannot be uninstalled", func() {
+		It("should not remove the cluster", func() {
+			createCluster("cluster-2", "test")
+			uninstallErr = errors.New("test error")
+			progress, err := deleteCluster("cluster-2")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("test error"))
+
+			last := progress[len(progress)-1]
+			Expect(last.Step).To(Equal(management.DeleteClusterStep_UninstallCapability))
+			Expect(last.State).To(Equal(management.DeleteClusterStepState_StepFailed))
+			Expect(last.Message).To(ContainSubstring("test error"))
+
+			Expect(keyringExists("cluster-2")).To(BeFalse())
+			_, err = tv.client.GetCluster(context.Background(), &core.Reference{
+				Id: "cluster-2",
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			By("retrying the deletion")
+			uninstallErr = nil
+			progress, err = deleteCluster("cluster-2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress[1].Step).To(Equal(management.DeleteClusterStep_RevokeKeyring))
+			Expect(progress[1].State).To(Equal(management.DeleteClusterStepState_StepSkipped))
+			Expect(uninstalled).To(ContainElement("cluster-2"))
+			_, err = tv.client.GetCluster(context.Background(), &core.Reference{
+				Id: "cluster-2",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
+
+var _ = Describe("Uninstalling Capabilities", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	var uninstalled []string
+	BeforeAll(func() {
+		tv = &testVars{ctrl: gomock.NewController(GinkgoT())}
+		capBackendStore := capabilities.NewBackendStore(capabilities.ServerInstallerTemplateSpec{}, test.Log)
+		for _, name := range []string{"test1", "test2"} {
+			name := name
+			capBackendStore.Add(name, test.NewTestCapabilityBackend(tv.ctrl, &test.CapabilityInfo{
+				Name:       name,
+				CanInstall: true,
+				OnUninstall: func(cluster *core.Reference) error {
+					uninstalled = append(uninstalled, cluster.Id+"/"+name)
+					return nil
+				},
+			}))
+		}
+		setupManagementServer(&tv, management.WithCapabilitiesDataSource(testCapabilityDataSource{
+			store: capBackendStore,
+		}))()
+
+		Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+			Id: "cluster-1",
+			Metadata: &core.ClusterMetadata{
+				Capabilities: []*core.ClusterCapability{
+					{Name: "test1"},
+					{Name: "test2"},
+					{Name: "unknown"},
+				},
+			},
+		})).To(Succeed())
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(context.Background(), keyring.New())).To(Succeed())
+	})
+	uninstall := func(name string) error {
+		_, err := tv.client.UninstallCapability(context.Background(), &management.UninstallCapabilityRequest{
+			Name: name,
+			Cluster: &core.Reference{
+				Id: "cluster-1",
+			},
+		})
+		return err
+	}
+	keyringExists := func() bool {
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ks.Get(context.Background())
+		return err == nil
+	}
+	clusterCapabilities := func() []string {
+		cluster, err := tv.client.GetCluster(context.Background(), &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, c := range cluster.GetCapabilities() {
+			names = append(names, c.Name)
+		}
+		return names
+	}
+
+	It("should uninstall a capability and remove it from the cluster", func() {
+		Expect(uninstall("test1")).To(Succeed())
+		Expect(uninstalled).To(ConsistOf("cluster-1/test1"))
+		Expect(clusterCapabilities()).To(ConsistOf("test2", "unknown"))
+		Expect(keyringExists()).To(BeTrue())
+	})
+	It("should error if the capability is not installed on the cluster", func() {
+		err := uninstall("test1")
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+	It("should error if the capability backend does not exist", func() {
+		err := uninstall("unknown")
+		Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		Expect(clusterCapabilities()).To(ContainElement("unknown"))
+	})
+	It("should keep the keyring when the last capability is uninstalled", func() {
+		Expect(tv.storageBackend.UpdateCluster(context.Background(), &core.Reference{Id: "cluster-1"},
+			storage.NewRemoveCapabilityMutator[*core.Cluster](capabilities.Cluster("unknown")),
+		)).Error().NotTo(HaveOccurred())
+
+		Expect(uninstall("test2")).To(Succeed())
+		Expect(uninstalled).To(ConsistOf("cluster-1/test1", "cluster-1/test2"))
+		Expect(clusterCapabilities()).To(BeEmpty())
+		Expect(keyringExists()).To(BeTrue())
+	})
+})
+
+var _ = Describe("Rotating Cluster Keys", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	BeforeAll(func() {
+		setupManagementServer(&tv)()
+
+		Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+			Id: "cluster-1",
+		})).To(Succeed())
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(context.Background(), keyring.New(
+			keyring.NewSharedKeys(make([]byte, 64)),
+		))).To(Succeed())
+	})
+	sharedKeys := func() []*keyring.SharedKeys {
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		kr, err := ks.Get(context.Background())
+		Expect(err).NotTo(HaveOccurred())
+		var keys []*keyring.SharedKeys
+		kr.Try(func(sk *keyring.SharedKeys) {
+			keys = append(keys, sk)
+		})
+		return keys
+	}
+
+	It("should schedule the cluster's keys to expire", func() {
+		resp, err := tv.client.RotateClusterKeys(context.Background(), &management.RotateClusterKeysRequest{
+			Cluster: &core.Reference{
+				Id: "cluster-1",
+			},
+			GracePeriod: durationpb.New(10 * time.Minute),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.ExpiresAt.AsTime()).To(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+
+		keys := sharedKeys()
+		Expect(keys).To(HaveLen(1))
+		Expect(keys[0].ExpiresAt).NotTo(BeNil())
+		Expect(*keys[0].ExpiresAt).To(BeTemporally("~", resp.ExpiresAt.AsTime(), time.Second))
+		Expect(keys[0].Expired()).To(BeFalse())
+	})
+	It("should not extend the grace period of keys which are already expiring", func() {
+		resp, err := tv.client.RotateClusterKeys(context.Background(), &management.RotateClusterKeysRequest{
+			Cluster: &core.Reference{
+				Id: "cluster-1",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.ExpiresAt.AsTime()).To(BeTemporally("~", time.Now().Add(10*time.Minute), time.Minute))
+
+		keys := sharedKeys()
+		Expect(keys).To(HaveLen(1))
+		Expect(*keys[0].ExpiresAt).To(BeTemporally("~", resp.ExpiresAt.AsTime(), time.Second))
+	})
+	It("should expire the keys immediately if the grace period is zero", func() {
+		_, err := tv.client.RotateClusterKeys(context.Background(), &management.RotateClusterKeysRequest{
+			Cluster: &core.Reference{
+				Id: "cluster-1",
+			},
+			GracePeriod: durationpb.New(0),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		keys := sharedKeys()
+		Expect(keys).To(HaveLen(1))
+		Expect(keys[0].Expired()).To(BeTrue())
+	})
+	When("the cluster does not exist", func() {
+		It("should error", func() {
+			_, err := tv.client.RotateClusterKeys(context.Background(), &management.RotateClusterKeysRequest{
+				Cluster: &core.Reference{
+					Id: "does-not-exist",
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
+
+var _ = Describe("Cluster Approval", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	installed := make(chan string, 10)
+	BeforeAll(func() {
+		tv = &testVars{ctrl: gomock.NewController(GinkgoT())}
+		capBackendStore := capabilities.NewBackendStore(capabilities.ServerInstallerTemplateSpec{}, test.Log)
+		capBackendStore.Add("test", test.NewTestCapabilityBackend(tv.ctrl, &test.CapabilityInfo{
+			Name:       "test",
+			CanInstall: true,
+			OnInstall: func(_ context.Context, cluster *core.Reference) error {
+				installed <- cluster.Id
+				return nil
+			},
+		}))
+		setupManagementServer(&tv, management.WithCapabilitiesDataSource(testCapabilityDataSource{
+			store: capBackendStore,
+		}))()
+
+		for _, id := range []string{"cluster-1", "cluster-2"} {
+			Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+				Id: id,
+				Metadata: &core.ClusterMetadata{
+					Approval: &core.ClusterApproval{
+						State:               core.ApprovalState_ClusterPendingApproval,
+						RequestedCapability: "test",
+					},
+				},
+			})).To(Succeed())
+			ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+				Id: id,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ks.Put(context.Background(), keyring.New())).To(Succeed())
+		}
+	})
+	keyringExists := func(id string) bool {
+		ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+			Id: id,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ks.Get(context.Background())
+		return err == nil
+	}
+
+	It("should approve pending clusters and install the requested capability", func() {
+		cluster, err := tv.client.ApproveCluster(context.Background(), &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetMetadata().GetApproval().GetState()).To(Equal(core.ApprovalState_ClusterApproved))
+		Expect(cluster.GetCapabilities()).To(ConsistOf(BeEquivalentTo(&core.ClusterCapability{
+			Name: "test",
+		})))
+		Eventually(installed).Should(Receive(Equal("cluster-1")))
+		Expect(keyringExists("cluster-1")).To(BeTrue())
+	})
+	It("should reject pending clusters and revoke their keyring", func() {
+		cluster, err := tv.client.RejectCluster(context.Background(), &core.Reference{
+			Id: "cluster-2",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetMetadata().GetApproval().GetState()).To(Equal(core.ApprovalState_ClusterRejected))
+		Expect(cluster.GetCapabilities()).To(BeEmpty())
+		Expect(keyringExists("cluster-2")).To(BeFalse())
+		Consistently(installed).ShouldNot(Receive())
+	})
+	It("should error if the cluster is not pending approval", func() {
+		for _, id := range []string{"cluster-1", "cluster-2"} {
+			_, err := tv.client.ApproveCluster(context.Background(), &core.Reference{
+				Id: id,
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+			_, err = tv.client.RejectCluster(context.Background(), &core.Reference{
+				Id: id,
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		}
+	})
+	When("the cluster does not exist", func() {
+		It("should error", func() {
+			_, err := tv.client.ApproveCluster(context.Background(), &core.Reference{
+				Id: "does-not-exist",
+			})
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+})
+
+type testAgentControlDataSource struct {
+	sender control.AgentController
+}
+
+func (t testAgentControlDataSource) AgentControl() control.AgentController {
+	return t.sender
+}
+
+type testCommandSender map[string]*controlv1.CommandResult
+
+func (t testCommandSender) SendCommand(
+	_ context.Context,
+	clusterID string,
+	cmdType controlv1.CommandType,
+) (*controlv1.CommandResult, error) {
+	result, ok := t[clusterID]
+	if !ok {
+		return nil, control.ErrAgentNotConnected
+	}
+	return result, nil
+}
+
+func (t testCommandSender) Disconnect(clusterID string) {
+	delete(t, clusterID)
+}
+
+func (t testCommandSender) DisconnectAt(clusterID string, _ time.Time) {
+	delete(t, clusterID)
+}
+
+var _ = Describe("Cluster Commands", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	sender := testCommandSender{
+		"cluster-1": {
+			Data: map[string]string{
+				"foo": "bar",
+			},
+		},
+		"cluster-2": {
+			Error: "command failed",
+		},
+		"cluster-4": {},
+		"cluster-5": {},
+	}
+	BeforeAll(func() {
+		setupManagementServer(&tv, management.WithAgentControlDataSource(testAgentControlDataSource{
+			sender: sender,
+		}))()
+
+		for _, id := range []string{"cluster-1", "cluster-2", "cluster-3", "cluster-4", "cluster-5"} {
+			Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+				Id: id,
+			})).To(Succeed())
+			ks, err := tv.storageBackend.KeyringStore(context.Background(), "gateway", &core.Reference{
+				Id: id,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ks.Put(context.Background(), keyring.New(
+				keyring.NewSharedKeys(make([]byte, 64)),
+			))).To(Succeed())
+		}
+	})
+	sendCommand := func(id string, cmdType controlv1.CommandType) (*management.ClusterCommandResponse, error) {
+		return tv.client.SendClusterCommand(context.Background(), &management.ClusterCommandRequest{
+			Cluster: &core.Reference{
+				Id: id,
+			},
+			Type: cmdType,
+		})
+	}
+
+	It("should return the command's result", func() {
+		resp, err := sendCommand("cluster-1", controlv1.CommandType_Diagnostics)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(resp.GetData()).To(HaveKeyWithValue("foo", "bar"))
+	})
+	It("should return an error if the command failed", func() {
+		_, err := sendCommand("cluster-2", controlv1.CommandType_SyncRules)
+		Expect(status.Code(err)).To(Equal(codes.Unknown))
+		Expect(status.Convert(err).Message()).To(ContainSubstring("command failed"))
+	})
+	It("should return an error if the agent is not connected", func() {
+		_, err := sendCommand("cluster-3", controlv1.CommandType_RotateKeys)
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+	})
+	It("should handle validation errors", func() {
+		_, err := sendCommand("cluster-1", controlv1.CommandType_Unknown)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(validation.ErrInvalidValue.Error()))
+		_, err = sendCommand("cluster-1", controlv1.CommandType(100))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(validation.ErrInvalidValue.Error()))
+		_, err = tv.client.SendClusterCommand(context.Background(), &management.ClusterCommandRequest{
+			Type: controlv1.CommandType_Diagnostics,
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(validation.ErrMissingRequiredField.Error()))
+	})
+	When("the cluster does not exist", func() {
+		It("should error", func() {
+			_, err := sendCommand("does-not-exist", controlv1.CommandType_Diagnostics)
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+	It("should disconnect the agent when the cluster's keys are rotated", func() {
+		_, err := sendCommand("cluster-4", controlv1.CommandType_Diagnostics)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tv.client.RotateClusterKeys(context.Background(), &management.RotateClusterKeysRequest{
+			Cluster: &core.Reference{
+				Id: "cluster-4",
+			},
+			GracePeriod: durationpb.New(10 * time.Minute),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = sendCommand("cluster-4", controlv1.CommandType_Diagnostics)
+		Expect(status.Code(err)).To(Equal(codes.Unavailable))
+	})
+	It("should disconnect the agent when the cluster is deleted", func() {
+		Expect(sender).To(HaveKey("cluster-5"))
+
+		stream, err := tv.client.DeleteCluster(context.Background(), &core.Reference{
+			Id: "cluster-5",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(management.WaitForClusterDeletion(stream, nil)).To(Succeed())
+
+		Expect(sender).NotTo(HaveKey("cluster-5"))
+	})
+})
+
+var _ = Describe("Cluster Status", Ordered, Label(test.Unit, test.Slow), func() {
+	var tv *testVars
+	BeforeAll(func() {
+		setupManagementServer(&tv)()
+
+		for _, id := range []string{"cluster-1", "cluster-2"} {
+			Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+				Id: id,
+			})).To(Succeed())
+		}
+		kv, err := tv.storageBackend.KeyValueStore(health.StatusNamespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(health.NewStatusStore(kv).Put(context.Background(), "cluster-1", &core.ClusterStatus{
+			AgentVersion: "v1.0.0",
+			LastSeen:     timestamppb.Now(),
+		})).To(Succeed())
+	})
+
+	It("should include the status reported by the cluster's agent", func() {
+		cluster, err := tv.client.GetCluster(context.Background(), &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetStatus().GetAgentVersion()).To(Equal("v1.0.0"))
+		Expect(cluster.GetStatus().GetHealth()).To(Equal(core.ClusterHealth_ClusterHealthy))
+
+		cluster, err = tv.client.GetCluster(context.Background(), &core.Reference{
+			Id: "cluster-2",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetStatus()).To(BeNil())
+	})
+	It("should include statuses when listing clusters", func() {
+		list, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.Items).To(HaveLen(2))
+		for _, cluster := range list.Items {
+			switch cluster.GetId() {
+			case "cluster-1":
+				Expect(cluster.GetStatus().GetHealth()).To(Equal(core.ClusterHealth_ClusterHealthy))
+			case "cluster-2":
+				Expect(cluster.GetStatus()).To(BeNil())
+			}
+		}
+	})
+	It("should not persist statuses with the cluster", func() {
+		cluster, err := tv.storageBackend.GetCluster(context.Background(), &core.Reference{
+			Id: "cluster-1",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cluster.GetStatus()).To(BeNil())
+	})
+	When("a cluster's status cannot be read", func() {
+		BeforeAll(func() {
+			Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+				Id: "cluster-3",
+			})).To(Succeed())
+			kv, err := tv.storageBackend.KeyValueStore(health.StatusNamespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kv.Put(context.Background(), "cluster-3", []byte("not a status"))).To(Succeed())
+		})
+		It("should omit the status when getting the cluster", func() {
+			cluster, err := tv.client.GetCluster(context.Background(), &core.Reference{
+				Id: "cluster-3",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cluster.GetStatus()).To(BeNil())
+		})
+		It("should omit the status when listing clusters", func() {
+			list, err := tv.client.ListClusters(context.Background(), &management.ListClustersRequest{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(list.Items).To(HaveLen(3))
+			for _, cluster := range list.Items {
+				switch cluster.GetId() {
+				case "cluster-1":
+					Expect(cluster.GetStatus().GetHealth()).To(Equal(core.ClusterHealth_ClusterHealthy))
+				case "cluster-2", "cluster-3":
+					Expect(cluster.GetStatus()).To(BeNil())
+				}
+			}
+		})
+	})
+})
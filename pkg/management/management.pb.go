@@ -7,6 +7,7 @@
 package management
 
 import (
+	v1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
 	core "github.com/rancher/opni-monitoring/pkg/core"
 	annotations "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
@@ -14,6 +15,7 @@ import (
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -28,19 +30,22 @@ const (
 type WatchEventType int32
 
 const (
-	WatchEventType_Added   WatchEventType = 0
-	WatchEventType_Deleted WatchEventType = 2
+	WatchEventType_Added    WatchEventType = 0
+	WatchEventType_Modified WatchEventType = 1
+	WatchEventType_Deleted  WatchEventType = 2
 )
 
 // Enum value maps for WatchEventType.
 var (
 	WatchEventType_name = map[int32]string{
 		0: "Added",
+		1: "Modified",
 		2: "Deleted",
 	}
 	WatchEventType_value = map[string]int32{
-		"Added":   0,
-		"Deleted": 2,
+		"Added":    0,
+		"Modified": 1,
+		"Deleted":  2,
 	}
 )
 
@@ -71,6 +76,107 @@ func (WatchEventType) EnumDescriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{0}
 }
 
+type DeleteClusterStep int32
+
+const (
+	DeleteClusterStep_RevokeKeyring       DeleteClusterStep = 0
+	DeleteClusterStep_UninstallCapability DeleteClusterStep = 1
+	DeleteClusterStep_RemoveCluster       DeleteClusterStep = 2
+)
+
+// Enum value maps for DeleteClusterStep.
+var (
+	DeleteClusterStep_name = map[int32]string{
+		0: "RevokeKeyring",
+		1: "UninstallCapability",
+		2: "RemoveCluster",
+	}
+	DeleteClusterStep_value = map[string]int32{
+		"RevokeKeyring":       0,
+		"UninstallCapability": 1,
+		"RemoveCluster":       2,
+	}
+)
+
+func (x DeleteClusterStep) Enum() *DeleteClusterStep {
+	p := new(DeleteClusterStep)
+	*p = x
+	return p
+}
+
+func (x DeleteClusterStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteClusterStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_management_management_proto_enumTypes[1].Descriptor()
+}
+
+func (DeleteClusterStep) Type() protoreflect.EnumType {
+	return &file_pkg_management_management_proto_enumTypes[1]
+}
+
+func (x DeleteClusterStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteClusterStep.Descriptor instead.
+func (DeleteClusterStep) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{1}
+}
+
+type DeleteClusterStepState int32
+
+const (
+	DeleteClusterStepState_StepStarted   DeleteClusterStepState = 0
+	DeleteClusterStepState_StepCompleted DeleteClusterStepState = 1
+	DeleteClusterStepState_StepSkipped   DeleteClusterStepState = 2
+	DeleteClusterStepState_StepFailed    DeleteClusterStepState = 3
+)
+
+// Enum value maps for DeleteClusterStepState.
+var (
+	DeleteClusterStepState_name = map[int32]string{
+		0: "StepStarted",
+		1: "StepCompleted",
+		2: "StepSkipped",
+		3: "StepFailed",
+	}
+	DeleteClusterStepState_value = map[string]int32{
+		"StepStarted":   0,
+		"StepCompleted": 1,
+		"StepSkipped":   2,
+		"StepFailed":    3,
+	}
+)
+
+func (x DeleteClusterStepState) Enum() *DeleteClusterStepState {
+	p := new(DeleteClusterStepState)
+	*p = x
+	return p
+}
+
+func (x DeleteClusterStepState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteClusterStepState) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_management_management_proto_enumTypes[2].Descriptor()
+}
+
+func (DeleteClusterStepState) Type() protoreflect.EnumType {
+	return &file_pkg_management_management_proto_enumTypes[2]
+}
+
+func (x DeleteClusterStepState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteClusterStepState.Descriptor instead.
+func (DeleteClusterStepState) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateBootstrapTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -79,6 +185,9 @@ type CreateBootstrapTokenRequest struct {
 	Ttl          *durationpb.Duration    `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
 	Labels       map[string]string       `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Capabilities []*core.TokenCapability `protobuf:"bytes,3,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
+	MaxUsages    int64                   `protobuf:"varint,4,opt,name=maxUsages,proto3" json:"maxUsages,omitempty"`
+	AllowedCIDRs []string                `protobuf:"bytes,5,rep,name=allowedCIDRs,proto3" json:"allowedCIDRs,omitempty"`
+	OneTime      bool                    `protobuf:"varint,6,opt,name=oneTime,proto3" json:"oneTime,omitempty"`
 }
 
 func (x *CreateBootstrapTokenRequest) Reset() {
@@ -134,12 +243,34 @@ func (x *CreateBootstrapTokenRequest) GetCapabilities() []*core.TokenCapability
 	return nil
 }
 
+func (x *CreateBootstrapTokenRequest) GetMaxUsages() int64 {
+	if x != nil {
+		return x.MaxUsages
+	}
+	return 0
+}
+
+func (x *CreateBootstrapTokenRequest) GetAllowedCIDRs() []string {
+	if x != nil {
+		return x.AllowedCIDRs
+	}
+	return nil
+}
+
+func (x *CreateBootstrapTokenRequest) GetOneTime() bool {
+	if x != nil {
+		return x.OneTime
+	}
+	return false
+}
+
 type CertsInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Chain []*core.CertInfo `protobuf:"bytes,1,rep,name=chain,proto3" json:"chain,omitempty"`
+	Chain        []*core.CertInfo `protobuf:"bytes,1,rep,name=chain,proto3" json:"chain,omitempty"`
+	PendingChain []*core.CertInfo `protobuf:"bytes,2,rep,name=pendingChain,proto3" json:"pendingChain,omitempty"`
 }
 
 func (x *CertsInfoResponse) Reset() {
@@ -181,6 +312,13 @@ func (x *CertsInfoResponse) GetChain() []*core.CertInfo {
 	return nil
 }
 
+func (x *CertsInfoResponse) GetPendingChain() []*core.CertInfo {
+	if x != nil {
+		return x.PendingChain
+	}
+	return nil
+}
+
 type ListClustersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -188,6 +326,10 @@ type ListClustersRequest struct {
 
 	MatchLabels  *core.LabelSelector `protobuf:"bytes,1,opt,name=matchLabels,proto3" json:"matchLabels,omitempty"`
 	MatchOptions core.MatchOptions   `protobuf:"varint,2,opt,name=matchOptions,proto3,enum=core.MatchOptions" json:"matchOptions,omitempty"`
+	PageSize     int32               `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken    string              `protobuf:"bytes,4,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortOrder    core.SortOrder      `protobuf:"varint,5,opt,name=sortOrder,proto3,enum=core.SortOrder" json:"sortOrder,omitempty"`
+	Capabilities []string            `protobuf:"bytes,6,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (x *ListClustersRequest) Reset() {
@@ -236,17 +378,47 @@ func (x *ListClustersRequest) GetMatchOptions() core.MatchOptions {
 	return core.MatchOptions(0)
 }
 
-type EditClusterRequest struct {
+func (x *ListClustersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListClustersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListClustersRequest) GetSortOrder() core.SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return core.SortOrder(0)
+}
+
+func (x *ListClustersRequest) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type ListBootstrapTokensRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cluster *core.Reference   `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	Labels  map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PageSize     int32          `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken    string         `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortOrder    core.SortOrder `protobuf:"varint,3,opt,name=sortOrder,proto3,enum=core.SortOrder" json:"sortOrder,omitempty"`
+	Capabilities []string       `protobuf:"bytes,4,rep,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
-func (x *EditClusterRequest) Reset() {
-	*x = EditClusterRequest{}
+func (x *ListBootstrapTokensRequest) Reset() {
+	*x = ListBootstrapTokensRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -254,13 +426,13 @@ func (x *EditClusterRequest) Reset() {
 	}
 }
 
-func (x *EditClusterRequest) String() string {
+func (x *ListBootstrapTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EditClusterRequest) ProtoMessage() {}
+func (*ListBootstrapTokensRequest) ProtoMessage() {}
 
-func (x *EditClusterRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBootstrapTokensRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -272,35 +444,51 @@ func (x *EditClusterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EditClusterRequest.ProtoReflect.Descriptor instead.
-func (*EditClusterRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBootstrapTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListBootstrapTokensRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *EditClusterRequest) GetCluster() *core.Reference {
+func (x *ListBootstrapTokensRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Cluster
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *EditClusterRequest) GetLabels() map[string]string {
+func (x *ListBootstrapTokensRequest) GetPageToken() string {
 	if x != nil {
-		return x.Labels
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListBootstrapTokensRequest) GetSortOrder() core.SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return core.SortOrder(0)
+}
+
+func (x *ListBootstrapTokensRequest) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
 	}
 	return nil
 }
 
-type WatchClustersRequest struct {
+type ListRolesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	KnownClusters *core.ReferenceList `protobuf:"bytes,1,opt,name=knownClusters,proto3" json:"knownClusters,omitempty"`
+	PageSize  int32          `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string         `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortOrder core.SortOrder `protobuf:"varint,3,opt,name=sortOrder,proto3,enum=core.SortOrder" json:"sortOrder,omitempty"`
 }
 
-func (x *WatchClustersRequest) Reset() {
-	*x = WatchClustersRequest{}
+func (x *ListRolesRequest) Reset() {
+	*x = ListRolesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -308,13 +496,13 @@ func (x *WatchClustersRequest) Reset() {
 	}
 }
 
-func (x *WatchClustersRequest) String() string {
+func (x *ListRolesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchClustersRequest) ProtoMessage() {}
+func (*ListRolesRequest) ProtoMessage() {}
 
-func (x *WatchClustersRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRolesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -326,29 +514,44 @@ func (x *WatchClustersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchClustersRequest.ProtoReflect.Descriptor instead.
-func (*WatchClustersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRolesRequest.ProtoReflect.Descriptor instead.
+func (*ListRolesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *WatchClustersRequest) GetKnownClusters() *core.ReferenceList {
+func (x *ListRolesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.KnownClusters
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-type WatchEvent struct {
+func (x *ListRolesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListRolesRequest) GetSortOrder() core.SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return core.SortOrder(0)
+}
+
+type ListRoleBindingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cluster *core.Reference `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
-	Type    WatchEventType  `protobuf:"varint,2,opt,name=type,proto3,enum=management.WatchEventType" json:"type,omitempty"`
+	PageSize  int32          `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string         `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortOrder core.SortOrder `protobuf:"varint,3,opt,name=sortOrder,proto3,enum=core.SortOrder" json:"sortOrder,omitempty"`
 }
 
-func (x *WatchEvent) Reset() {
-	*x = WatchEvent{}
+func (x *ListRoleBindingsRequest) Reset() {
+	*x = ListRoleBindingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -356,13 +559,13 @@ func (x *WatchEvent) Reset() {
 	}
 }
 
-func (x *WatchEvent) String() string {
+func (x *ListRoleBindingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WatchEvent) ProtoMessage() {}
+func (*ListRoleBindingsRequest) ProtoMessage() {}
 
-func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+func (x *ListRoleBindingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -374,35 +577,45 @@ func (x *WatchEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
-func (*WatchEvent) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoleBindingsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoleBindingsRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *WatchEvent) GetCluster() *core.Reference {
+func (x *ListRoleBindingsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Cluster
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *WatchEvent) GetType() WatchEventType {
+func (x *ListRoleBindingsRequest) GetPageToken() string {
 	if x != nil {
-		return x.Type
+		return x.PageToken
 	}
-	return WatchEventType_Added
+	return ""
 }
 
-type APIExtensionInfoList struct {
+func (x *ListRoleBindingsRequest) GetSortOrder() core.SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return core.SortOrder(0)
+}
+
+type EditClusterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items []*APIExtensionInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Cluster         *core.Reference   `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Labels          map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RemoveLabels    []string          `protobuf:"bytes,3,rep,name=removeLabels,proto3" json:"removeLabels,omitempty"`
+	ResourceVersion string            `protobuf:"bytes,4,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
 }
 
-func (x *APIExtensionInfoList) Reset() {
-	*x = APIExtensionInfoList{}
+func (x *EditClusterRequest) Reset() {
+	*x = EditClusterRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -410,13 +623,13 @@ func (x *APIExtensionInfoList) Reset() {
 	}
 }
 
-func (x *APIExtensionInfoList) String() string {
+func (x *EditClusterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIExtensionInfoList) ProtoMessage() {}
+func (*EditClusterRequest) ProtoMessage() {}
 
-func (x *APIExtensionInfoList) ProtoReflect() protoreflect.Message {
+func (x *EditClusterRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -428,29 +641,50 @@ func (x *APIExtensionInfoList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIExtensionInfoList.ProtoReflect.Descriptor instead.
-func (*APIExtensionInfoList) Descriptor() ([]byte, []int) {
+// Deprecated: Use EditClusterRequest.ProtoReflect.Descriptor instead.
+func (*EditClusterRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *APIExtensionInfoList) GetItems() []*APIExtensionInfo {
+func (x *EditClusterRequest) GetCluster() *core.Reference {
 	if x != nil {
-		return x.Items
+		return x.Cluster
 	}
 	return nil
 }
 
-type APIExtensionInfo struct {
+func (x *EditClusterRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *EditClusterRequest) GetRemoveLabels() []string {
+	if x != nil {
+		return x.RemoveLabels
+	}
+	return nil
+}
+
+func (x *EditClusterRequest) GetResourceVersion() string {
+	if x != nil {
+		return x.ResourceVersion
+	}
+	return ""
+}
+
+type RotateClusterKeysRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServiceDesc *descriptorpb.ServiceDescriptorProto `protobuf:"bytes,1,opt,name=serviceDesc,proto3" json:"serviceDesc,omitempty"`
-	Rules       []*HTTPRuleDescriptor                `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	Cluster     *core.Reference      `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	GracePeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=gracePeriod,proto3" json:"gracePeriod,omitempty"`
 }
 
-func (x *APIExtensionInfo) Reset() {
-	*x = APIExtensionInfo{}
+func (x *RotateClusterKeysRequest) Reset() {
+	*x = RotateClusterKeysRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -458,13 +692,13 @@ func (x *APIExtensionInfo) Reset() {
 	}
 }
 
-func (x *APIExtensionInfo) String() string {
+func (x *RotateClusterKeysRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*APIExtensionInfo) ProtoMessage() {}
+func (*RotateClusterKeysRequest) ProtoMessage() {}
 
-func (x *APIExtensionInfo) ProtoReflect() protoreflect.Message {
+func (x *RotateClusterKeysRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -476,36 +710,35 @@ func (x *APIExtensionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use APIExtensionInfo.ProtoReflect.Descriptor instead.
-func (*APIExtensionInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateClusterKeysRequest.ProtoReflect.Descriptor instead.
+func (*RotateClusterKeysRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *APIExtensionInfo) GetServiceDesc() *descriptorpb.ServiceDescriptorProto {
+func (x *RotateClusterKeysRequest) GetCluster() *core.Reference {
 	if x != nil {
-		return x.ServiceDesc
+		return x.Cluster
 	}
 	return nil
 }
 
-func (x *APIExtensionInfo) GetRules() []*HTTPRuleDescriptor {
+func (x *RotateClusterKeysRequest) GetGracePeriod() *durationpb.Duration {
 	if x != nil {
-		return x.Rules
+		return x.GracePeriod
 	}
 	return nil
 }
 
-type HTTPRuleDescriptor struct {
+type RotateClusterKeysResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Http   *annotations.HttpRule               `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
-	Method *descriptorpb.MethodDescriptorProto `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
-func (x *HTTPRuleDescriptor) Reset() {
-	*x = HTTPRuleDescriptor{}
+func (x *RotateClusterKeysResponse) Reset() {
+	*x = RotateClusterKeysResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -513,13 +746,13 @@ func (x *HTTPRuleDescriptor) Reset() {
 	}
 }
 
-func (x *HTTPRuleDescriptor) String() string {
+func (x *RotateClusterKeysResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HTTPRuleDescriptor) ProtoMessage() {}
+func (*RotateClusterKeysResponse) ProtoMessage() {}
 
-func (x *HTTPRuleDescriptor) ProtoReflect() protoreflect.Message {
+func (x *RotateClusterKeysResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -531,35 +764,29 @@ func (x *HTTPRuleDescriptor) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HTTPRuleDescriptor.ProtoReflect.Descriptor instead.
-func (*HTTPRuleDescriptor) Descriptor() ([]byte, []int) {
+// Deprecated: Use RotateClusterKeysResponse.ProtoReflect.Descriptor instead.
+func (*RotateClusterKeysResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *HTTPRuleDescriptor) GetHttp() *annotations.HttpRule {
-	if x != nil {
-		return x.Http
-	}
-	return nil
-}
-
-func (x *HTTPRuleDescriptor) GetMethod() *descriptorpb.MethodDescriptorProto {
+func (x *RotateClusterKeysResponse) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Method
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-type GatewayConfig struct {
+type ClusterCommandRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Documents []*ConfigDocumentWithSchema `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Cluster *core.Reference `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Type    v1.CommandType  `protobuf:"varint,2,opt,name=type,proto3,enum=control.v1.CommandType" json:"type,omitempty"`
 }
 
-func (x *GatewayConfig) Reset() {
-	*x = GatewayConfig{}
+func (x *ClusterCommandRequest) Reset() {
+	*x = ClusterCommandRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,13 +794,13 @@ func (x *GatewayConfig) Reset() {
 	}
 }
 
-func (x *GatewayConfig) String() string {
+func (x *ClusterCommandRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GatewayConfig) ProtoMessage() {}
+func (*ClusterCommandRequest) ProtoMessage() {}
 
-func (x *GatewayConfig) ProtoReflect() protoreflect.Message {
+func (x *ClusterCommandRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -585,30 +812,35 @@ func (x *GatewayConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GatewayConfig.ProtoReflect.Descriptor instead.
-func (*GatewayConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterCommandRequest.ProtoReflect.Descriptor instead.
+func (*ClusterCommandRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GatewayConfig) GetDocuments() []*ConfigDocumentWithSchema {
+func (x *ClusterCommandRequest) GetCluster() *core.Reference {
 	if x != nil {
-		return x.Documents
+		return x.Cluster
 	}
 	return nil
 }
 
-type ConfigDocumentWithSchema struct {
+func (x *ClusterCommandRequest) GetType() v1.CommandType {
+	if x != nil {
+		return x.Type
+	}
+	return v1.CommandType(0)
+}
+
+type ClusterCommandResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Json   []byte `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
-	Yaml   []byte `protobuf:"bytes,2,opt,name=yaml,proto3" json:"yaml,omitempty"`
-	Schema []byte `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+	Data map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *ConfigDocumentWithSchema) Reset() {
-	*x = ConfigDocumentWithSchema{}
+func (x *ClusterCommandResponse) Reset() {
+	*x = ClusterCommandResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_management_management_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -616,13 +848,13 @@ func (x *ConfigDocumentWithSchema) Reset() {
 	}
 }
 
-func (x *ConfigDocumentWithSchema) String() string {
+func (x *ClusterCommandResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConfigDocumentWithSchema) ProtoMessage() {}
+func (*ClusterCommandResponse) ProtoMessage() {}
 
-func (x *ConfigDocumentWithSchema) ProtoReflect() protoreflect.Message {
+func (x *ClusterCommandResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_management_management_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -634,19 +866,453 @@ func (x *ConfigDocumentWithSchema) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConfigDocumentWithSchema.ProtoReflect.Descriptor instead.
-func (*ConfigDocumentWithSchema) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClusterCommandResponse.ProtoReflect.Descriptor instead.
+func (*ClusterCommandResponse) Descriptor() ([]byte, []int) {
 	return file_pkg_management_management_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ConfigDocumentWithSchema) GetJson() []byte {
+func (x *ClusterCommandResponse) GetData() map[string]string {
 	if x != nil {
-		return x.Json
+		return x.Data
 	}
 	return nil
 }
 
-func (x *ConfigDocumentWithSchema) GetYaml() []byte {
+type WatchClustersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	KnownClusters *core.ReferenceList `protobuf:"bytes,1,opt,name=knownClusters,proto3" json:"knownClusters,omitempty"`
+}
+
+func (x *WatchClustersRequest) Reset() {
+	*x = WatchClustersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchClustersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchClustersRequest) ProtoMessage() {}
+
+func (x *WatchClustersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchClustersRequest.ProtoReflect.Descriptor instead.
+func (*WatchClustersRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *WatchClustersRequest) GetKnownClusters() *core.ReferenceList {
+	if x != nil {
+		return x.KnownClusters
+	}
+	return nil
+}
+
+type WatchEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster  *core.Cluster  `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Type     WatchEventType `protobuf:"varint,2,opt,name=type,proto3,enum=management.WatchEventType" json:"type,omitempty"`
+	Previous *core.Cluster  `protobuf:"bytes,3,opt,name=previous,proto3" json:"previous,omitempty"`
+}
+
+func (x *WatchEvent) Reset() {
+	*x = WatchEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEvent) ProtoMessage() {}
+
+func (x *WatchEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEvent.ProtoReflect.Descriptor instead.
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchEvent) GetCluster() *core.Cluster {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (x *WatchEvent) GetType() WatchEventType {
+	if x != nil {
+		return x.Type
+	}
+	return WatchEventType_Added
+}
+
+func (x *WatchEvent) GetPrevious() *core.Cluster {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+type DeleteClusterProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Step       DeleteClusterStep      `protobuf:"varint,1,opt,name=step,proto3,enum=management.DeleteClusterStep" json:"step,omitempty"`
+	State      DeleteClusterStepState `protobuf:"varint,2,opt,name=state,proto3,enum=management.DeleteClusterStepState" json:"state,omitempty"`
+	Capability string                 `protobuf:"bytes,3,opt,name=capability,proto3" json:"capability,omitempty"`
+	Message    string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *DeleteClusterProgress) Reset() {
+	*x = DeleteClusterProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteClusterProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteClusterProgress) ProtoMessage() {}
+
+func (x *DeleteClusterProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteClusterProgress.ProtoReflect.Descriptor instead.
+func (*DeleteClusterProgress) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteClusterProgress) GetStep() DeleteClusterStep {
+	if x != nil {
+		return x.Step
+	}
+	return DeleteClusterStep_RevokeKeyring
+}
+
+func (x *DeleteClusterProgress) GetState() DeleteClusterStepState {
+	if x != nil {
+		return x.State
+	}
+	return DeleteClusterStepState_StepStarted
+}
+
+func (x *DeleteClusterProgress) GetCapability() string {
+	if x != nil {
+		return x.Capability
+	}
+	return ""
+}
+
+func (x *DeleteClusterProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type APIExtensionInfoList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*APIExtensionInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *APIExtensionInfoList) Reset() {
+	*x = APIExtensionInfoList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIExtensionInfoList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIExtensionInfoList) ProtoMessage() {}
+
+func (x *APIExtensionInfoList) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIExtensionInfoList.ProtoReflect.Descriptor instead.
+func (*APIExtensionInfoList) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *APIExtensionInfoList) GetItems() []*APIExtensionInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type APIExtensionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServiceDesc *descriptorpb.ServiceDescriptorProto `protobuf:"bytes,1,opt,name=serviceDesc,proto3" json:"serviceDesc,omitempty"`
+	Rules       []*HTTPRuleDescriptor                `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *APIExtensionInfo) Reset() {
+	*x = APIExtensionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIExtensionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIExtensionInfo) ProtoMessage() {}
+
+func (x *APIExtensionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIExtensionInfo.ProtoReflect.Descriptor instead.
+func (*APIExtensionInfo) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *APIExtensionInfo) GetServiceDesc() *descriptorpb.ServiceDescriptorProto {
+	if x != nil {
+		return x.ServiceDesc
+	}
+	return nil
+}
+
+func (x *APIExtensionInfo) GetRules() []*HTTPRuleDescriptor {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type HTTPRuleDescriptor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Http   *annotations.HttpRule               `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
+	Method *descriptorpb.MethodDescriptorProto `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *HTTPRuleDescriptor) Reset() {
+	*x = HTTPRuleDescriptor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HTTPRuleDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HTTPRuleDescriptor) ProtoMessage() {}
+
+func (x *HTTPRuleDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HTTPRuleDescriptor.ProtoReflect.Descriptor instead.
+func (*HTTPRuleDescriptor) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HTTPRuleDescriptor) GetHttp() *annotations.HttpRule {
+	if x != nil {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *HTTPRuleDescriptor) GetMethod() *descriptorpb.MethodDescriptorProto {
+	if x != nil {
+		return x.Method
+	}
+	return nil
+}
+
+type GatewayConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Documents []*ConfigDocumentWithSchema `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *GatewayConfig) Reset() {
+	*x = GatewayConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayConfig) ProtoMessage() {}
+
+func (x *GatewayConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayConfig.ProtoReflect.Descriptor instead.
+func (*GatewayConfig) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GatewayConfig) GetDocuments() []*ConfigDocumentWithSchema {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type ConfigDocumentWithSchema struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Json   []byte `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"`
+	Yaml   []byte `protobuf:"bytes,2,opt,name=yaml,proto3" json:"yaml,omitempty"`
+	Schema []byte `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (x *ConfigDocumentWithSchema) Reset() {
+	*x = ConfigDocumentWithSchema{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigDocumentWithSchema) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDocumentWithSchema) ProtoMessage() {}
+
+func (x *ConfigDocumentWithSchema) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDocumentWithSchema.ProtoReflect.Descriptor instead.
+func (*ConfigDocumentWithSchema) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConfigDocumentWithSchema) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *ConfigDocumentWithSchema) GetYaml() []byte {
 	if x != nil {
 		return x.Yaml
 	}
@@ -671,7 +1337,7 @@ type ConfigDocument struct {
 func (x *ConfigDocument) Reset() {
 	*x = ConfigDocument{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_management_management_proto_msgTypes[11]
+		mi := &file_pkg_management_management_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -684,7 +1350,7 @@ func (x *ConfigDocument) String() string {
 func (*ConfigDocument) ProtoMessage() {}
 
 func (x *ConfigDocument) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_management_management_proto_msgTypes[11]
+	mi := &file_pkg_management_management_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -697,7 +1363,7 @@ func (x *ConfigDocument) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigDocument.ProtoReflect.Descriptor instead.
 func (*ConfigDocument) Descriptor() ([]byte, []int) {
-	return file_pkg_management_management_proto_rawDescGZIP(), []int{11}
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ConfigDocument) GetJson() []byte {
@@ -718,7 +1384,7 @@ type UpdateConfigRequest struct {
 func (x *UpdateConfigRequest) Reset() {
 	*x = UpdateConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_management_management_proto_msgTypes[12]
+		mi := &file_pkg_management_management_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -731,7 +1397,7 @@ func (x *UpdateConfigRequest) String() string {
 func (*UpdateConfigRequest) ProtoMessage() {}
 
 func (x *UpdateConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_management_management_proto_msgTypes[12]
+	mi := &file_pkg_management_management_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +1410,7 @@ func (x *UpdateConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdateConfigRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_management_management_proto_rawDescGZIP(), []int{12}
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UpdateConfigRequest) GetDocuments() []*ConfigDocument {
@@ -765,7 +1431,7 @@ type CapabilityList struct {
 func (x *CapabilityList) Reset() {
 	*x = CapabilityList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_management_management_proto_msgTypes[13]
+		mi := &file_pkg_management_management_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -778,7 +1444,7 @@ func (x *CapabilityList) String() string {
 func (*CapabilityList) ProtoMessage() {}
 
 func (x *CapabilityList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_management_management_proto_msgTypes[13]
+	mi := &file_pkg_management_management_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -791,7 +1457,7 @@ func (x *CapabilityList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CapabilityList.ProtoReflect.Descriptor instead.
 func (*CapabilityList) Descriptor() ([]byte, []int) {
-	return file_pkg_management_management_proto_rawDescGZIP(), []int{13}
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *CapabilityList) GetItems() []string {
@@ -814,7 +1480,7 @@ type CapabilityInstallerRequest struct {
 func (x *CapabilityInstallerRequest) Reset() {
 	*x = CapabilityInstallerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_management_management_proto_msgTypes[14]
+		mi := &file_pkg_management_management_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -827,7 +1493,7 @@ func (x *CapabilityInstallerRequest) String() string {
 func (*CapabilityInstallerRequest) ProtoMessage() {}
 
 func (x *CapabilityInstallerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_management_management_proto_msgTypes[14]
+	mi := &file_pkg_management_management_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -840,7 +1506,7 @@ func (x *CapabilityInstallerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CapabilityInstallerRequest.ProtoReflect.Descriptor instead.
 func (*CapabilityInstallerRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_management_management_proto_rawDescGZIP(), []int{14}
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *CapabilityInstallerRequest) GetName() string {
@@ -850,45 +1516,355 @@ func (x *CapabilityInstallerRequest) GetName() string {
 	return ""
 }
 
-func (x *CapabilityInstallerRequest) GetToken() string {
+func (x *CapabilityInstallerRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CapabilityInstallerRequest) GetPin() string {
+	if x != nil {
+		return x.Pin
+	}
+	return ""
+}
+
+type CapabilityInstallerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+}
+
+func (x *CapabilityInstallerResponse) Reset() {
+	*x = CapabilityInstallerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapabilityInstallerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityInstallerResponse) ProtoMessage() {}
+
+func (x *CapabilityInstallerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityInstallerResponse.ProtoReflect.Descriptor instead.
+func (*CapabilityInstallerResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CapabilityInstallerResponse) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+type CapabilityStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cluster *core.Reference `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *CapabilityStatusRequest) Reset() {
+	*x = CapabilityStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CapabilityStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapabilityStatusRequest) ProtoMessage() {}
+
+func (x *CapabilityStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapabilityStatusRequest.ProtoReflect.Descriptor instead.
+func (*CapabilityStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CapabilityStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CapabilityStatusRequest) GetCluster() *core.Reference {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+type UninstallCapabilityRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cluster *core.Reference `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *UninstallCapabilityRequest) Reset() {
+	*x = UninstallCapabilityRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallCapabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallCapabilityRequest) ProtoMessage() {}
+
+func (x *UninstallCapabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallCapabilityRequest.ProtoReflect.Descriptor instead.
+func (*UninstallCapabilityRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UninstallCapabilityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UninstallCapabilityRequest) GetCluster() *core.Reference {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
+type BackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (x *BackupRequest) Reset() {
+	*x = BackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupRequest) ProtoMessage() {}
+
+func (x *BackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupRequest.ProtoReflect.Descriptor instead.
+func (*BackupRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BackupRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type BackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *BackupResponse) Reset() {
+	*x = BackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupResponse) ProtoMessage() {}
+
+func (x *BackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupResponse.ProtoReflect.Descriptor instead.
+func (*BackupResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BackupResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type RestoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Chunk      []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (x *RestoreRequest) Reset() {
+	*x = RestoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_management_management_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreRequest) ProtoMessage() {}
+
+func (x *RestoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreRequest.ProtoReflect.Descriptor instead.
+func (*RestoreRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreRequest) GetChunk() []byte {
 	if x != nil {
-		return x.Token
+		return x.Chunk
 	}
-	return ""
+	return nil
 }
 
-func (x *CapabilityInstallerRequest) GetPin() string {
+func (x *RestoreRequest) GetPassphrase() string {
 	if x != nil {
-		return x.Pin
+		return x.Passphrase
 	}
 	return ""
 }
 
-type CapabilityInstallerResponse struct {
+type ListAuditEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	SortOrder core.SortOrder         `protobuf:"varint,3,opt,name=sortOrder,proto3,enum=core.SortOrder" json:"sortOrder,omitempty"`
+	Since     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=until,proto3" json:"until,omitempty"`
 }
 
-func (x *CapabilityInstallerResponse) Reset() {
-	*x = CapabilityInstallerResponse{}
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_management_management_proto_msgTypes[15]
+		mi := &file_pkg_management_management_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CapabilityInstallerResponse) String() string {
+func (x *ListAuditEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CapabilityInstallerResponse) ProtoMessage() {}
+func (*ListAuditEventsRequest) ProtoMessage() {}
 
-func (x *CapabilityInstallerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_management_management_proto_msgTypes[15]
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_management_management_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -899,18 +1875,46 @@ func (x *CapabilityInstallerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CapabilityInstallerResponse.ProtoReflect.Descriptor instead.
-func (*CapabilityInstallerResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_management_management_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_management_management_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *CapabilityInstallerResponse) GetCommand() string {
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Command
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
 	}
 	return ""
 }
 
+func (x *ListAuditEventsRequest) GetSortOrder() core.SortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return core.SortOrder(0)
+}
+
+func (x *ListAuditEventsRequest) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
 var File_pkg_management_management_proto protoreflect.FileDescriptor
 
 var file_pkg_management_management_proto_rawDesc = []byte{
@@ -918,218 +1922,397 @@ var file_pkg_management_management_proto_rawDesc = []byte{
 	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x0a, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x1a, 0x1e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x13, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x72, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf8, 0x01, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x00,
-	0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x33, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x42, 0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a,
-	0x00, 0x22, 0x36, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x65, 0x72,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x6f, 0x0a, 0x13, 0x4c, 0x69, 0x73,
-	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x2a, 0x0a, 0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x00, 0x12, 0x2a, 0x0a, 0x0c,
-	0x6d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xb1, 0x01, 0x0a, 0x12, 0x45,
-	0x64, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x42, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x13, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x70, 0x6b, 0x67, 0x2f, 0x61, 0x70, 0x69,
+	0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb8, 0x02, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x00, 0x12, 0x43, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2d, 0x0a, 0x0c, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x00, 0x12, 0x16, 0x0a,
+	0x0c, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x43, 0x49, 0x44, 0x52, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x5e, 0x0a, 0x11, 0x43, 0x65, 0x72, 0x74, 0x73, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x43, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x00, 0x12, 0x26, 0x0a, 0x0c, 0x70,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x49, 0x6e, 0x66,
+	0x6f, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xd6, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x0b, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x00, 0x12, 0x2a, 0x0a, 0x0c, 0x6d, 0x61, 0x74,
+	0x63, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x4d, 0x61, 0x74, 0x63, 0x68, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x00, 0x12, 0x12, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x24,
+	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x00, 0x12, 0x16, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x85,
+	0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x00, 0x12, 0x13, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x00, 0x12, 0x16, 0x0a, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x63, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x00, 0x12, 0x13,
+	0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x00, 0x12, 0x24, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6f,
+	0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x6a, 0x0a, 0x17, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12,
+	0x24, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xe4, 0x01, 0x0a, 0x12, 0x45, 0x64, 0x69, 0x74,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x42, 0x00, 0x12, 0x3a, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x12, 0x19, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x00, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
 	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x46,
-	0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
-	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x5e, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x47, 0x0a, 0x14, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d,
-	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x50, 0x49, 0x45, 0x78,
-	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x00, 0x3a, 0x00, 0x22,
-	0x85, 0x01, 0x0a, 0x10, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44,
-	0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x42, 0x00, 0x12, 0x2f, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x6f, 0x72, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x76, 0x0a, 0x12, 0x48, 0x54, 0x54, 0x50, 0x52,
-	0x75, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a,
-	0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x75, 0x6c,
-	0x65, 0x42, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x00, 0x3a, 0x00, 0x22,
-	0x4c, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x39, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x4e, 0x0a,
-	0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x0e, 0x0a, 0x04, 0x6a, 0x73, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x00, 0x12, 0x0e, 0x0a, 0x04, 0x79, 0x61, 0x6d,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x00, 0x12, 0x10, 0x0a, 0x06, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x22, 0x0a,
-	0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x0e, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x00, 0x3a,
-	0x00, 0x22, 0x48, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x72,
+	0x0a, 0x18, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x12, 0x30,
+	0x0a, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x00,
+	0x3a, 0x00, 0x22, 0x4e, 0x0a, 0x19, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00,
+	0x3a, 0x00, 0x22, 0x66, 0x0a, 0x15, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x12,
+	0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x8f, 0x01, 0x0a, 0x16, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x00, 0x22, 0x46, 0x0a, 0x14,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x0d, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x42, 0x00, 0x3a, 0x00, 0x22, 0x7f, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x20, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x42, 0x00, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x42, 0x00,
+	0x12, 0x21, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xa6, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2d, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x42, 0x00, 0x12, 0x33,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x42, 0x00, 0x12, 0x14, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x11, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x47,
+	0x0a, 0x14, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x85, 0x01, 0x0a, 0x10, 0x41, 0x50, 0x49, 0x45,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3e, 0x0a, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x00, 0x12, 0x2f, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x75, 0x6c,
+	0x65, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x42, 0x00, 0x3a, 0x00, 0x22,
+	0x76, 0x0a, 0x12, 0x48, 0x54, 0x54, 0x50, 0x52, 0x75, 0x6c, 0x65, 0x44, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x42, 0x00, 0x12, 0x38, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x4c, 0x0a, 0x0d, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61,
 	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
-	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x23, 0x0a, 0x0e, 0x43,
-	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x0a,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00,
-	0x22, 0x4e, 0x0a, 0x1a, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
-	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x0f,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12,
-	0x0d, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00,
-	0x22, 0x32, 0x0a, 0x1b, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x11, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x00, 0x3a, 0x00, 0x2a, 0x2a, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x1a, 0x00,
-	0x32, 0x8b, 0x1f, 0x0a, 0x0a, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0xb6, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
-	0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74,
-	0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
-	0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a,
-	0x01, 0x2a, 0x22, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xba, 0x3e, 0x3b, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x24,
-	0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a,
-	0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa0, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x76,
-	0x6f, 0x6b, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
-	0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x2a, 0x17, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x39, 0x12, 0x13,
-	0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74,
-	0x70, 0x10, 0x01, 0x42, 0x22, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x9b, 0x01, 0x0a, 0x13,
-	0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x6f,
-	0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x4e, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x53, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x12, 0x0e, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x00, 0x12, 0x0e, 0x0a, 0x04, 0x79, 0x61, 0x6d, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x00, 0x12, 0x10, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x22, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x48, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x42,
+	0x00, 0x3a, 0x00, 0x22, 0x23, 0x0a, 0x0e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x0f, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x4e, 0x0a, 0x1a, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x0f, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x0d, 0x0a, 0x03, 0x70, 0x69, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x32, 0x0a, 0x1b, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x11, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x4f, 0x0a, 0x17,
+	0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x52, 0x0a,
+	0x1a, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x22, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a,
+	0x00, 0x22, 0x27, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x23, 0x0a, 0x0e, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0f, 0x0a, 0x05,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x00, 0x3a, 0x00, 0x22,
+	0x39, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0f, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x00, 0x12, 0x14, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x22, 0xc3, 0x01, 0x0a, 0x16, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x00, 0x12, 0x13, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x12, 0x24,
+	0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x42, 0x00, 0x12, 0x2b, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x00, 0x12, 0x2b, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x00, 0x3a, 0x00,
+	0x2a, 0x38, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x64, 0x64, 0x65, 0x64, 0x10, 0x00, 0x12, 0x0c, 0x0a,
+	0x08, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x02, 0x1a, 0x00, 0x2a, 0x54, 0x0a, 0x11, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x12,
+	0x11, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4b, 0x65, 0x79, 0x72, 0x69, 0x6e, 0x67,
+	0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43,
+	0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x02, 0x1a, 0x00,
+	0x2a, 0x5f, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x53, 0x74,
+	0x65, 0x70, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x53, 0x74, 0x65, 0x70, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x10, 0x02, 0x12,
+	0x0e, 0x0a, 0x0a, 0x53, 0x74, 0x65, 0x70, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x1a,
+	0x00, 0x32, 0x88, 0x30, 0x0a, 0x0a, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0xb6, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73,
+	0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x27, 0x2e, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x6f, 0x6f,
+	0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74,
+	0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17,
+	0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0xba, 0x3e, 0x3b, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
+	0x24, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79,
+	0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa0, 0x01, 0x0a, 0x14, 0x52, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x19, 0x2a, 0x17, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x39, 0x12,
+	0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x10, 0x01, 0x42, 0x22, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f,
 	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0xba, 0x3e, 0x31, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x1a, 0x7b, 0x67, 0x65, 0x74, 0x3a,
-	0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xab, 0x01, 0x0a,
+	0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12,
 	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x36, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
-	0x1f, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d,
-	0x28, 0x00, 0x30, 0x00, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x16, 0x12, 0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0xba, 0x3e, 0x33, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
-	0x1c, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30,
-	0x00, 0x12, 0xba, 0x01, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x6b, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x77,
-	0x61, 0x74, 0x63, 0x68, 0xba, 0x3e, 0x43, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x2c, 0x7b, 0x70,
-	0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x22,
-	0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x01, 0x12, 0x9d,
-	0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x6e, 0x73, 0xba, 0x3e, 0x31, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x1a, 0x7b, 0x67, 0x65, 0x74,
+	0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x98, 0x01, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
 	0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1b, 0x2a, 0x19, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3b, 0x12,
-	0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74,
-	0x74, 0x70, 0x10, 0x01, 0x42, 0x24, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f,
+	0x65, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72,
+	0x61, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
+	0x17, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x36, 0x12, 0x13, 0x0a, 0x0f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01,
+	0x42, 0x1f, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22,
+	0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x9a, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x52, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x12, 0x14, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0xba, 0x3e, 0x33, 0x12, 0x13, 0x0a, 0x0f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01,
+	0x42, 0x1c, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x22, 0x7d, 0x28, 0x00,
+	0x30, 0x00, 0x12, 0xba, 0x01, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x6b,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x3a, 0x01, 0x2a, 0x22, 0x1a, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0xba, 0x3e, 0x43, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x2c, 0x7b,
+	0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68,
+	0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x01, 0x12,
+	0xa8, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x2a, 0x19, 0x2f,
 	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x94,
-	0x01, 0x0a, 0x09, 0x43, 0x65, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x73, 0xba, 0x3e,
-	0x30, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x19, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x22,
-	0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x8e, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6d,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3b, 0x12, 0x13, 0x0a, 0x0f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01,
+	0x42, 0x24, 0x7b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x01, 0x12, 0x94, 0x01, 0x0a, 0x09, 0x43,
+	0x65, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x1d, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x65,
+	0x72, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x73, 0xba, 0x3e, 0x30, 0x12, 0x13, 0x0a,
+	0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70,
+	0x10, 0x01, 0x42, 0x19, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30,
+	0x00, 0x12, 0x8e, 0x01, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b,
+	0x69, 0x64, 0x7d, 0xba, 0x3e, 0x38, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x21, 0x7b, 0x67, 0x65,
+	0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00,
+	0x30, 0x00, 0x12, 0xba, 0x01, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e,
+	0x45, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x22, 0x78, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x1a, 0x21, 0x2f, 0x6d,
 	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x38, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
-	0x21, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d, 0xba,
+	0x3e, 0x49, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x32, 0x7b, 0x70, 0x75, 0x74, 0x3a, 0x22, 0x2f,
+	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d,
+	0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12,
+	0xf8, 0x01, 0x0a, 0x11, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x24, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x91, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x32, 0x3a, 0x01, 0x2a, 0x22, 0x2d,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64,
+	0x7d, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x2d, 0x6b, 0x65, 0x79, 0x73, 0xba, 0x3e, 0x56,
+	0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x3f, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d, 0x2f,
+	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x2d, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x0a, 0x62, 0x6f, 0x64,
+	0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xed, 0x01, 0x0a, 0x12, 0x53,
+	0x65, 0x6e, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x12, 0x21, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8b, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2f, 0x3a, 0x01, 0x2a, 0x22, 0x2a, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73,
+	0xba, 0x3e, 0x53, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x3c, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a,
+	0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x0a, 0x62, 0x6f, 0x64,
+	0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa3, 0x01, 0x0a, 0x0e, 0x41,
+	0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x0f, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x0d,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x6d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x23, 0x22, 0x21, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
 	0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d,
-	0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xba, 0x01, 0x0a, 0x0b, 0x45, 0x64, 0x69, 0x74, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x78, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a,
-	0x1a, 0x21, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x69, 0x64, 0x7d, 0xba, 0x3e, 0x49, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x32, 0x7b, 0x70, 0x75,
-	0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x69, 0x64, 0x7d, 0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28,
+	0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0xba, 0x3e, 0x41, 0x12, 0x13, 0x0a, 0x0f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01,
+	0x42, 0x2a, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x69,
+	0x64, 0x7d, 0x2f, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00,
+	0x12, 0xa0, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x1a, 0x0d, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x22, 0x6b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x20, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0xba, 0x3e, 0x40, 0x12,
+	0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x10, 0x01, 0x42, 0x29, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x2f, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x7d, 0x28,
 	0x00, 0x30, 0x00, 0x12, 0x8f, 0x01, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
 	0x6c, 0x65, 0x12, 0x0a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
@@ -1157,150 +2340,233 @@ var file_pkg_management_management_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x1e, 0x7b,
 	0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
 	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30,
-	0x00, 0x12, 0xab, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62,
-	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0xba, 0x3e, 0x41, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
-	0x2a, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12,
-	0xa9, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x67,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3f, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x28, 0x7b,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
-	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x9e, 0x01, 0x0a, 0x0e,
-	0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0f,
-	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a,
-	0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69,
-	0x6e, 0x67, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3c, 0x12, 0x13, 0x0a, 0x0f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10,
-	0x01, 0x42, 0x25, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x85, 0x01, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0xba, 0x3e, 0x30,
-	0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
-	0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x19, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x7d,
-	0x28, 0x00, 0x30, 0x00, 0x12, 0xa1, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a,
-	0x12, 0x18, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f,
-	0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0xba, 0x3e, 0x37, 0x12, 0x13, 0x0a,
-	0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70,
-	0x10, 0x01, 0x42, 0x20, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
-	0x67, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa2, 0x01, 0x0a, 0x0d, 0x53, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x6f, 0x72,
-	0x65, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65,
-	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5c, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0xba, 0x3e,
-	0x38, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x21, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
-	0x74, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xab, 0x01,
-	0x0a, 0x0d, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x1b, 0x12, 0x19, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61,
-	0x70, 0x69, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0xba, 0x3e, 0x38, 0x12,
-	0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74,
-	0x74, 0x70, 0x10, 0x01, 0x42, 0x21, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e,
-	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x65, 0x78, 0x74, 0x65, 0x6e,
-	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x92, 0x01, 0x0a, 0x09,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x00, 0x12, 0x8c, 0x01, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x0a, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x1a, 0x0a, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x62, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b,
+	0x3a, 0x01, 0x2a, 0x1a, 0x16, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3e, 0x12, 0x13,
+	0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74,
+	0x70, 0x10, 0x01, 0x42, 0x27, 0x7b, 0x70, 0x75, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00,
+	0x12, 0xab, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42,
+	0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x47,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x4e, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0xba, 0x3e, 0x31, 0x12, 0x13, 0x0a, 0x0f, 0x67,
+	0x79, 0x22, 0x67, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x3a, 0x01, 0x2a, 0x22, 0x18, 0x2f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0xba, 0x3e, 0x41, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x2a,
+	0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22,
+	0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa9,
+	0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x67, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x2a, 0x1d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3f, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x28, 0x7b, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f,
+	0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x9e, 0x01, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x0f, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x11,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x22, 0x64, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0xba, 0x3e, 0x3c, 0x12, 0x13, 0x0a, 0x0f, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01,
-	0x42, 0x1a, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00,
-	0x12, 0xa7, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0xba, 0x3e, 0x3a, 0x12, 0x13, 0x0a, 0x0f,
+	0x42, 0x25, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xaf, 0x01, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x12, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x1a, 0x11, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
+	0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x70, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a,
+	0x01, 0x2a, 0x1a, 0x1d, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64,
+	0x7d, 0xba, 0x3e, 0x45, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x2e, 0x7b, 0x70, 0x75, 0x74, 0x3a,
+	0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c,
+	0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x2f, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x0a,
+	0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0x8b, 0x01,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x13, 0x12, 0x11, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0xba, 0x3e, 0x30, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x19, 0x7b, 0x67,
+	0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xae, 0x01, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x23, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x42, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0xba, 0x3e,
+	0x37, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x20, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x72, 0x6f, 0x6c, 0x65, 0x62, 0x69,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa2, 0x01, 0x0a,
+	0x0d, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x1a,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x22,
+	0x5c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0xba, 0x3e, 0x38, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x21, 0x7b, 0x67, 0x65, 0x74,
+	0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30,
+	0x00, 0x12, 0xab, 0x01, 0x0a, 0x0d, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x41, 0x50, 0x49, 0x45, 0x78, 0x74, 0x65,
+	0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0xba, 0x3e, 0x38, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x21, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12,
+	0x92, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x4e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0xba, 0x3e, 0x31, 0x12,
+	0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x10, 0x01, 0x42, 0x1a, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x7d,
+	0x28, 0x00, 0x30, 0x00, 0x12, 0xa7, 0x01, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1f, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x1a, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61,
+	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0xba, 0x3e, 0x3a,
+	0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68,
+	0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x23, 0x7b, 0x70, 0x75, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22,
+	0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa6,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
+	0x69, 0x74, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12,
+	0x18, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0xba, 0x3e, 0x37, 0x12, 0x13, 0x0a, 0x0f,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10,
-	0x01, 0x42, 0x23, 0x7b, 0x70, 0x75, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x0a, 0x62, 0x6f, 0x64,
-	0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xa6, 0x01, 0x0a, 0x10, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x01, 0x42, 0x20, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65,
+	0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xf6, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x26, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x89, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0xba, 0x3e, 0x52, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
+	0x3b, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73,
+	0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65,
+	0x72, 0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00,
+	0x12, 0xfb, 0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
+	0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xa2, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3e, 0x12, 0x3c,
+	0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64,
+	0x7d, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0xba, 0x3e, 0x5b, 0x12,
+	0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74,
+	0x74, 0x70, 0x10, 0x01, 0x42, 0x44, 0x7b, 0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73,
+	0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x12, 0xf3,
+	0x01, 0x0a, 0x13, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2e, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x43, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x97, 0x01, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x2a,
+	0x35, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f,
+	0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0xba, 0x3e, 0x57, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x40,
+	0x7b, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x2f, 0x7b, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x22, 0x7d,
+	0x28, 0x00, 0x30, 0x00, 0x12, 0xa0, 0x01, 0x0a, 0x06, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12,
+	0x19, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x6e,
+	0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x5b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01,
+	0x2a, 0x22, 0x12, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0xba, 0x3e, 0x3b, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x24, 0x7b,
+	0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x3a, 0x22,
+	0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x01, 0x12, 0xa0, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x4c,
-	0x69, 0x73, 0x74, 0x22, 0x5a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18, 0x2f, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0xba, 0x3e, 0x37, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x20, 0x7b,
-	0x67, 0x65, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x7d, 0x28,
-	0x00, 0x30, 0x00, 0x12, 0xf6, 0x01, 0x0a, 0x13, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69,
-	0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x6d, 0x61,
-	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c,
-	0x69, 0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x2e, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x49, 0x6e, 0x73, 0x74, 0x61,
-	0x6c, 0x6c, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x89, 0x01, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x3a, 0x01, 0x2a, 0x22, 0x29, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69,
-	0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c,
-	0x6c, 0x65, 0x72, 0xba, 0x3e, 0x52, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x3b, 0x7b, 0x70, 0x6f,
-	0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
-	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61,
-	0x6d, 0x65, 0x7d, 0x2f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x0a, 0x62,
-	0x6f, 0x64, 0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x1a, 0x00, 0x42, 0x90,
-	0x03, 0x92, 0x41, 0x73, 0x12, 0x71, 0x0a, 0x1e, 0x4f, 0x70, 0x6e, 0x69, 0x20, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x20, 0x41, 0x50, 0x49, 0x2a, 0x4a, 0x0a, 0x0a, 0x41, 0x70, 0x61, 0x63, 0x68, 0x65,
-	0x20, 0x32, 0x2e, 0x30, 0x12, 0x3c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72,
-	0x2f, 0x6f, 0x70, 0x6e, 0x69, 0x2d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
-	0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e,
-	0x53, 0x45, 0x32, 0x03, 0x31, 0x2e, 0x30, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x6e, 0x69,
-	0x2d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f,
-	0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0xba, 0x3e, 0xe3, 0x01, 0x12, 0x3f,
-	0x0a, 0x3b, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
-	0x69, 0x76, 0x32, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e,
-	0x61, 0x70, 0x69, 0x76, 0x32, 0x5f, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x10, 0x01, 0x42,
-	0x9f, 0x01, 0x7b, 0x69, 0x6e, 0x66, 0x6f, 0x3a, 0x7b, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3a, 0x22,
-	0x4f, 0x70, 0x6e, 0x69, 0x20, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x20,
-	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x41, 0x50, 0x49, 0x22, 0x3b,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x22, 0x31, 0x2e, 0x30, 0x22, 0x3b, 0x6c, 0x69,
-	0x63, 0x65, 0x6e, 0x73, 0x65, 0x3a, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x22, 0x41, 0x70, 0x61,
-	0x63, 0x68, 0x65, 0x20, 0x32, 0x2e, 0x30, 0x22, 0x3b, 0x75, 0x72, 0x6c, 0x3a, 0x22, 0x68, 0x74,
-	0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x6e, 0x69, 0x2d, 0x6d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61,
-	0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x22, 0x3b, 0x7d, 0x3b, 0x7d, 0x3b,
-	0x7d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x5d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x3a,
+	0x01, 0x2a, 0x22, 0x13, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0xba, 0x3e, 0x3c, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42,
+	0x25, 0x7b, 0x70, 0x6f, 0x73, 0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x22, 0x0a, 0x62, 0x6f, 0x64,
+	0x79, 0x3a, 0x22, 0x2a, 0x22, 0x7d, 0x28, 0x01, 0x30, 0x00, 0x12, 0x9d, 0x01, 0x0a, 0x0f, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22,
+	0x2e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x4c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13,
+	0x12, 0x11, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61, 0x75,
+	0x64, 0x69, 0x74, 0xba, 0x3e, 0x30, 0x12, 0x13, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x10, 0x01, 0x42, 0x19, 0x7b, 0x67, 0x65,
+	0x74, 0x3a, 0x22, 0x2f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x2f, 0x61,
+	0x75, 0x64, 0x69, 0x74, 0x22, 0x7d, 0x28, 0x00, 0x30, 0x00, 0x1a, 0x00, 0x42, 0x90, 0x03, 0x92,
+	0x41, 0x73, 0x12, 0x71, 0x0a, 0x1e, 0x4f, 0x70, 0x6e, 0x69, 0x20, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x6f, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x20, 0x41, 0x50, 0x49, 0x2a, 0x4a, 0x0a, 0x0a, 0x41, 0x70, 0x61, 0x63, 0x68, 0x65, 0x20, 0x32,
+	0x2e, 0x30, 0x12, 0x3c, 0x68, 0x74, 0x74, 0x70, 0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x6f,
+	0x70, 0x6e, 0x69, 0x2d, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x62,
+	0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45,
+	0x32, 0x03, 0x31, 0x2e, 0x30, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x6e, 0x69, 0x2d, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0xba, 0x3e, 0xe3, 0x01, 0x12, 0x3f, 0x0a, 0x3b,
+	0x67, 0x72, 0x70, 0x63, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x5f, 0x67, 0x65, 0x6e, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
+	0x32, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70,
+	0x69, 0x76, 0x32, 0x5f, 0x73, 0x77, 0x61, 0x67, 0x67, 0x65, 0x72, 0x10, 0x01, 0x42, 0x9f, 0x01,
+	0x7b, 0x69, 0x6e, 0x66, 0x6f, 0x3a, 0x7b, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x3a, 0x22, 0x4f, 0x70,
+	0x6e, 0x69, 0x20, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x41, 0x50, 0x49, 0x22, 0x3b, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x3a, 0x22, 0x31, 0x2e, 0x30, 0x22, 0x3b, 0x6c, 0x69, 0x63, 0x65,
+	0x6e, 0x73, 0x65, 0x3a, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x22, 0x41, 0x70, 0x61, 0x63, 0x68,
+	0x65, 0x20, 0x32, 0x2e, 0x30, 0x22, 0x3b, 0x75, 0x72, 0x6c, 0x3a, 0x22, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x3a, 0x2f, 0x2f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x65, 0x72, 0x2f, 0x6f, 0x70, 0x6e, 0x69, 0x2d, 0x6d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x2f, 0x4c, 0x49, 0x43, 0x45, 0x4e, 0x53, 0x45, 0x22, 0x3b, 0x7d, 0x3b, 0x7d, 0x3b, 0x7d, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1315,121 +2581,184 @@ func file_pkg_management_management_proto_rawDescGZIP() []byte {
 	return file_pkg_management_management_proto_rawDescData
 }
 
-var file_pkg_management_management_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pkg_management_management_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_pkg_management_management_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_pkg_management_management_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_pkg_management_management_proto_goTypes = []interface{}{
 	(WatchEventType)(0),                         // 0: management.WatchEventType
-	(*CreateBootstrapTokenRequest)(nil),         // 1: management.CreateBootstrapTokenRequest
-	(*CertsInfoResponse)(nil),                   // 2: management.CertsInfoResponse
-	(*ListClustersRequest)(nil),                 // 3: management.ListClustersRequest
-	(*EditClusterRequest)(nil),                  // 4: management.EditClusterRequest
-	(*WatchClustersRequest)(nil),                // 5: management.WatchClustersRequest
-	(*WatchEvent)(nil),                          // 6: management.WatchEvent
-	(*APIExtensionInfoList)(nil),                // 7: management.APIExtensionInfoList
-	(*APIExtensionInfo)(nil),                    // 8: management.APIExtensionInfo
-	(*HTTPRuleDescriptor)(nil),                  // 9: management.HTTPRuleDescriptor
-	(*GatewayConfig)(nil),                       // 10: management.GatewayConfig
-	(*ConfigDocumentWithSchema)(nil),            // 11: management.ConfigDocumentWithSchema
-	(*ConfigDocument)(nil),                      // 12: management.ConfigDocument
-	(*UpdateConfigRequest)(nil),                 // 13: management.UpdateConfigRequest
-	(*CapabilityList)(nil),                      // 14: management.CapabilityList
-	(*CapabilityInstallerRequest)(nil),          // 15: management.CapabilityInstallerRequest
-	(*CapabilityInstallerResponse)(nil),         // 16: management.CapabilityInstallerResponse
-	nil,                                         // 17: management.CreateBootstrapTokenRequest.LabelsEntry
-	nil,                                         // 18: management.EditClusterRequest.LabelsEntry
-	(*durationpb.Duration)(nil),                 // 19: google.protobuf.Duration
-	(*core.TokenCapability)(nil),                // 20: core.TokenCapability
-	(*core.CertInfo)(nil),                       // 21: core.CertInfo
-	(*core.LabelSelector)(nil),                  // 22: core.LabelSelector
-	(core.MatchOptions)(0),                      // 23: core.MatchOptions
-	(*core.Reference)(nil),                      // 24: core.Reference
-	(*core.ReferenceList)(nil),                  // 25: core.ReferenceList
-	(*descriptorpb.ServiceDescriptorProto)(nil), // 26: google.protobuf.ServiceDescriptorProto
-	(*annotations.HttpRule)(nil),                // 27: google.api.HttpRule
-	(*descriptorpb.MethodDescriptorProto)(nil),  // 28: google.protobuf.MethodDescriptorProto
-	(*emptypb.Empty)(nil),                       // 29: google.protobuf.Empty
-	(*core.Role)(nil),                           // 30: core.Role
-	(*core.RoleBinding)(nil),                    // 31: core.RoleBinding
-	(*core.SubjectAccessRequest)(nil),           // 32: core.SubjectAccessRequest
-	(*core.BootstrapToken)(nil),                 // 33: core.BootstrapToken
-	(*core.BootstrapTokenList)(nil),             // 34: core.BootstrapTokenList
-	(*core.ClusterList)(nil),                    // 35: core.ClusterList
-	(*core.Cluster)(nil),                        // 36: core.Cluster
-	(*core.RoleList)(nil),                       // 37: core.RoleList
-	(*core.RoleBindingList)(nil),                // 38: core.RoleBindingList
+	(DeleteClusterStep)(0),                      // 1: management.DeleteClusterStep
+	(DeleteClusterStepState)(0),                 // 2: management.DeleteClusterStepState
+	(*CreateBootstrapTokenRequest)(nil),         // 3: management.CreateBootstrapTokenRequest
+	(*CertsInfoResponse)(nil),                   // 4: management.CertsInfoResponse
+	(*ListClustersRequest)(nil),                 // 5: management.ListClustersRequest
+	(*ListBootstrapTokensRequest)(nil),          // 6: management.ListBootstrapTokensRequest
+	(*ListRolesRequest)(nil),                    // 7: management.ListRolesRequest
+	(*ListRoleBindingsRequest)(nil),             // 8: management.ListRoleBindingsRequest
+	(*EditClusterRequest)(nil),                  // 9: management.EditClusterRequest
+	(*RotateClusterKeysRequest)(nil),            // 10: management.RotateClusterKeysRequest
+	(*RotateClusterKeysResponse)(nil),           // 11: management.RotateClusterKeysResponse
+	(*ClusterCommandRequest)(nil),               // 12: management.ClusterCommandRequest
+	(*ClusterCommandResponse)(nil),              // 13: management.ClusterCommandResponse
+	(*WatchClustersRequest)(nil),                // 14: management.WatchClustersRequest
+	(*WatchEvent)(nil),                          // 15: management.WatchEvent
+	(*DeleteClusterProgress)(nil),               // 16: management.DeleteClusterProgress
+	(*APIExtensionInfoList)(nil),                // 17: management.APIExtensionInfoList
+	(*APIExtensionInfo)(nil),                    // 18: management.APIExtensionInfo
+	(*HTTPRuleDescriptor)(nil),                  // 19: management.HTTPRuleDescriptor
+	(*GatewayConfig)(nil),                       // 20: management.GatewayConfig
+	(*ConfigDocumentWithSchema)(nil),            // 21: management.ConfigDocumentWithSchema
+	(*ConfigDocument)(nil),                      // 22: management.ConfigDocument
+	(*UpdateConfigRequest)(nil),                 // 23: management.UpdateConfigRequest
+	(*CapabilityList)(nil),                      // 24: management.CapabilityList
+	(*CapabilityInstallerRequest)(nil),          // 25: management.CapabilityInstallerRequest
+	(*CapabilityInstallerResponse)(nil),         // 26: management.CapabilityInstallerResponse
+	(*CapabilityStatusRequest)(nil),             // 27: management.CapabilityStatusRequest
+	(*UninstallCapabilityRequest)(nil),          // 28: management.UninstallCapabilityRequest
+	(*BackupRequest)(nil),                       // 29: management.BackupRequest
+	(*BackupResponse)(nil),                      // 30: management.BackupResponse
+	(*RestoreRequest)(nil),                      // 31: management.RestoreRequest
+	(*ListAuditEventsRequest)(nil),              // 32: management.ListAuditEventsRequest
+	nil,                                         // 33: management.CreateBootstrapTokenRequest.LabelsEntry
+	nil,                                         // 34: management.EditClusterRequest.LabelsEntry
+	nil,                                         // 35: management.ClusterCommandResponse.DataEntry
+	(*durationpb.Duration)(nil),                 // 36: google.protobuf.Duration
+	(*core.TokenCapability)(nil),                // 37: core.TokenCapability
+	(*core.CertInfo)(nil),                       // 38: core.CertInfo
+	(*core.LabelSelector)(nil),                  // 39: core.LabelSelector
+	(core.MatchOptions)(0),                      // 40: core.MatchOptions
+	(core.SortOrder)(0),                         // 41: core.SortOrder
+	(*core.Reference)(nil),                      // 42: core.Reference
+	(*timestamppb.Timestamp)(nil),               // 43: google.protobuf.Timestamp
+	(v1.CommandType)(0),                         // 44: control.v1.CommandType
+	(*core.ReferenceList)(nil),                  // 45: core.ReferenceList
+	(*core.Cluster)(nil),                        // 46: core.Cluster
+	(*descriptorpb.ServiceDescriptorProto)(nil), // 47: google.protobuf.ServiceDescriptorProto
+	(*annotations.HttpRule)(nil),                // 48: google.api.HttpRule
+	(*descriptorpb.MethodDescriptorProto)(nil),  // 49: google.protobuf.MethodDescriptorProto
+	(*emptypb.Empty)(nil),                       // 50: google.protobuf.Empty
+	(*core.Role)(nil),                           // 51: core.Role
+	(*core.RoleBinding)(nil),                    // 52: core.RoleBinding
+	(*core.SubjectAccessRequest)(nil),           // 53: core.SubjectAccessRequest
+	(*core.BootstrapToken)(nil),                 // 54: core.BootstrapToken
+	(*core.BootstrapTokenList)(nil),             // 55: core.BootstrapTokenList
+	(*core.ClusterList)(nil),                    // 56: core.ClusterList
+	(*core.RoleList)(nil),                       // 57: core.RoleList
+	(*core.RoleBindingList)(nil),                // 58: core.RoleBindingList
+	(*core.CapabilityStatus)(nil),               // 59: core.CapabilityStatus
+	(*core.AuditEventList)(nil),                 // 60: core.AuditEventList
 }
 var file_pkg_management_management_proto_depIdxs = []int32{
-	19, // 0: management.CreateBootstrapTokenRequest.ttl:type_name -> google.protobuf.Duration
-	17, // 1: management.CreateBootstrapTokenRequest.labels:type_name -> management.CreateBootstrapTokenRequest.LabelsEntry
-	20, // 2: management.CreateBootstrapTokenRequest.capabilities:type_name -> core.TokenCapability
-	21, // 3: management.CertsInfoResponse.chain:type_name -> core.CertInfo
-	22, // 4: management.ListClustersRequest.matchLabels:type_name -> core.LabelSelector
-	23, // 5: management.ListClustersRequest.matchOptions:type_name -> core.MatchOptions
-	24, // 6: management.EditClusterRequest.cluster:type_name -> core.Reference
-	18, // 7: management.EditClusterRequest.labels:type_name -> management.EditClusterRequest.LabelsEntry
-	25, // 8: management.WatchClustersRequest.knownClusters:type_name -> core.ReferenceList
-	24, // 9: management.WatchEvent.cluster:type_name -> core.Reference
-	0,  // 10: management.WatchEvent.type:type_name -> management.WatchEventType
-	8,  // 11: management.APIExtensionInfoList.items:type_name -> management.APIExtensionInfo
-	26, // 12: management.APIExtensionInfo.serviceDesc:type_name -> google.protobuf.ServiceDescriptorProto
-	9,  // 13: management.APIExtensionInfo.rules:type_name -> management.HTTPRuleDescriptor
-	27, // 14: management.HTTPRuleDescriptor.http:type_name -> google.api.HttpRule
-	28, // 15: management.HTTPRuleDescriptor.method:type_name -> google.protobuf.MethodDescriptorProto
-	11, // 16: management.GatewayConfig.documents:type_name -> management.ConfigDocumentWithSchema
-	12, // 17: management.UpdateConfigRequest.documents:type_name -> management.ConfigDocument
-	1,  // 18: management.Management.CreateBootstrapToken:input_type -> management.CreateBootstrapTokenRequest
-	24, // 19: management.Management.RevokeBootstrapToken:input_type -> core.Reference
-	29, // 20: management.Management.ListBootstrapTokens:input_type -> google.protobuf.Empty
-	24, // 21: management.Management.GetBootstrapToken:input_type -> core.Reference
-	3,  // 22: management.Management.ListClusters:input_type -> management.ListClustersRequest
-	5,  // 23: management.Management.WatchClusters:input_type -> management.WatchClustersRequest
-	24, // 24: management.Management.DeleteCluster:input_type -> core.Reference
-	29, // 25: management.Management.CertsInfo:input_type -> google.protobuf.Empty
-	24, // 26: management.Management.GetCluster:input_type -> core.Reference
-	4,  // 27: management.Management.EditCluster:input_type -> management.EditClusterRequest
-	30, // 28: management.Management.CreateRole:input_type -> core.Role
-	24, // 29: management.Management.DeleteRole:input_type -> core.Reference
-	24, // 30: management.Management.GetRole:input_type -> core.Reference
-	31, // 31: management.Management.CreateRoleBinding:input_type -> core.RoleBinding
-	24, // 32: management.Management.DeleteRoleBinding:input_type -> core.Reference
-	24, // 33: management.Management.GetRoleBinding:input_type -> core.Reference
-	29, // 34: management.Management.ListRoles:input_type -> google.protobuf.Empty
-	29, // 35: management.Management.ListRoleBindings:input_type -> google.protobuf.Empty
-	32, // 36: management.Management.SubjectAccess:input_type -> core.SubjectAccessRequest
-	29, // 37: management.Management.APIExtensions:input_type -> google.protobuf.Empty
-	29, // 38: management.Management.GetConfig:input_type -> google.protobuf.Empty
-	13, // 39: management.Management.UpdateConfig:input_type -> management.UpdateConfigRequest
-	29, // 40: management.Management.ListCapabilities:input_type -> google.protobuf.Empty
-	15, // 41: management.Management.CapabilityInstaller:input_type -> management.CapabilityInstallerRequest
-	33, // 42: management.Management.CreateBootstrapToken:output_type -> core.BootstrapToken
-	29, // 43: management.Management.RevokeBootstrapToken:output_type -> google.protobuf.Empty
-	34, // 44: management.Management.ListBootstrapTokens:output_type -> core.BootstrapTokenList
-	33, // 45: management.Management.GetBootstrapToken:output_type -> core.BootstrapToken
-	35, // 46: management.Management.ListClusters:output_type -> core.ClusterList
-	6,  // 47: management.Management.WatchClusters:output_type -> management.WatchEvent
-	29, // 48: management.Management.DeleteCluster:output_type -> google.protobuf.Empty
-	2,  // 49: management.Management.CertsInfo:output_type -> management.CertsInfoResponse
-	36, // 50: management.Management.GetCluster:output_type -> core.Cluster
-	36, // 51: management.Management.EditCluster:output_type -> core.Cluster
-	29, // 52: management.Management.CreateRole:output_type -> google.protobuf.Empty
-	29, // 53: management.Management.DeleteRole:output_type -> google.protobuf.Empty
-	30, // 54: management.Management.GetRole:output_type -> core.Role
-	29, // 55: management.Management.CreateRoleBinding:output_type -> google.protobuf.Empty
-	29, // 56: management.Management.DeleteRoleBinding:output_type -> google.protobuf.Empty
-	31, // 57: management.Management.GetRoleBinding:output_type -> core.RoleBinding
-	37, // 58: management.Management.ListRoles:output_type -> core.RoleList
-	38, // 59: management.Management.ListRoleBindings:output_type -> core.RoleBindingList
-	25, // 60: management.Management.SubjectAccess:output_type -> core.ReferenceList
-	7,  // 61: management.Management.APIExtensions:output_type -> management.APIExtensionInfoList
-	10, // 62: management.Management.GetConfig:output_type -> management.GatewayConfig
-	29, // 63: management.Management.UpdateConfig:output_type -> google.protobuf.Empty
-	14, // 64: management.Management.ListCapabilities:output_type -> management.CapabilityList
-	16, // 65: management.Management.CapabilityInstaller:output_type -> management.CapabilityInstallerResponse
-	42, // [42:66] is the sub-list for method output_type
-	18, // [18:42] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	36, // 0: management.CreateBootstrapTokenRequest.ttl:type_name -> google.protobuf.Duration
+	33, // 1: management.CreateBootstrapTokenRequest.labels:type_name -> management.CreateBootstrapTokenRequest.LabelsEntry
+	37, // 2: management.CreateBootstrapTokenRequest.capabilities:type_name -> core.TokenCapability
+	38, // 3: management.CertsInfoResponse.chain:type_name -> core.CertInfo
+	38, // 4: management.CertsInfoResponse.pendingChain:type_name -> core.CertInfo
+	39, // 5: management.ListClustersRequest.matchLabels:type_name -> core.LabelSelector
+	40, // 6: management.ListClustersRequest.matchOptions:type_name -> core.MatchOptions
+	41, // 7: management.ListClustersRequest.sortOrder:type_name -> core.SortOrder
+	41, // 8: management.ListBootstrapTokensRequest.sortOrder:type_name -> core.SortOrder
+	41, // 9: management.ListRolesRequest.sortOrder:type_name -> core.SortOrder
+	41, // 10: management.ListRoleBindingsRequest.sortOrder:type_name -> core.SortOrder
+	42, // 11: management.EditClusterRequest.cluster:type_name -> core.Reference
+	34, // 12: management.EditClusterRequest.labels:type_name -> management.EditClusterRequest.LabelsEntry
+	42, // 13: management.RotateClusterKeysRequest.cluster:type_name -> core.Reference
+	36, // 14: management.RotateClusterKeysRequest.gracePeriod:type_name -> google.protobuf.Duration
+	43, // 15: management.RotateClusterKeysResponse.expiresAt:type_name -> google.protobuf.Timestamp
+	42, // 16: management.ClusterCommandRequest.cluster:type_name -> core.Reference
+	44, // 17: management.ClusterCommandRequest.type:type_name -> control.v1.CommandType
+	35, // 18: management.ClusterCommandResponse.data:type_name -> management.ClusterCommandResponse.DataEntry
+	45, // 19: management.WatchClustersRequest.knownClusters:type_name -> core.ReferenceList
+	46, // 20: management.WatchEvent.cluster:type_name -> core.Cluster
+	0,  // 21: management.WatchEvent.type:type_name -> management.WatchEventType
+	46, // 22: management.WatchEvent.previous:type_name -> core.Cluster
+	1,  // 23: management.DeleteClusterProgress.step:type_name -> management.DeleteClusterStep
+	2,  // 24: management.DeleteClusterProgress.state:type_name -> management.DeleteClusterStepState
+	18, // 25: management.APIExtensionInfoList.items:type_name -> management.APIExtensionInfo
+	47, // 26: management.APIExtensionInfo.serviceDesc:type_name -> google.protobuf.ServiceDescriptorProto
+	19, // 27: management.APIExtensionInfo.rules:type_name -> management.HTTPRuleDescriptor
+	48, // 28: management.HTTPRuleDescriptor.http:type_name -> google.api.HttpRule
+	49, // 29: management.HTTPRuleDescriptor.method:type_name -> google.protobuf.MethodDescriptorProto
+	21, // 30: management.GatewayConfig.documents:type_name -> management.ConfigDocumentWithSchema
+	22, // 31: management.UpdateConfigRequest.documents:type_name -> management.ConfigDocument
+	42, // 32: management.CapabilityStatusRequest.cluster:type_name -> core.Reference
+	42, // 33: management.UninstallCapabilityRequest.cluster:type_name -> core.Reference
+	41, // 34: management.ListAuditEventsRequest.sortOrder:type_name -> core.SortOrder
+	43, // 35: management.ListAuditEventsRequest.since:type_name -> google.protobuf.Timestamp
+	43, // 36: management.ListAuditEventsRequest.until:type_name -> google.protobuf.Timestamp
+	3,  // 37: management.Management.CreateBootstrapToken:input_type -> management.CreateBootstrapTokenRequest
+	42, // 38: management.Management.RevokeBootstrapToken:input_type -> core.Reference
+	6,  // 39: management.Management.ListBootstrapTokens:input_type -> management.ListBootstrapTokensRequest
+	42, // 40: management.Management.GetBootstrapToken:input_type -> core.Reference
+	5,  // 41: management.Management.ListClusters:input_type -> management.ListClustersRequest
+	14, // 42: management.Management.WatchClusters:input_type -> management.WatchClustersRequest
+	42, // 43: management.Management.DeleteCluster:input_type -> core.Reference
+	50, // 44: management.Management.CertsInfo:input_type -> google.protobuf.Empty
+	42, // 45: management.Management.GetCluster:input_type -> core.Reference
+	9,  // 46: management.Management.EditCluster:input_type -> management.EditClusterRequest
+	10, // 47: management.Management.RotateClusterKeys:input_type -> management.RotateClusterKeysRequest
+	12, // 48: management.Management.SendClusterCommand:input_type -> management.ClusterCommandRequest
+	42, // 49: management.Management.ApproveCluster:input_type -> core.Reference
+	42, // 50: management.Management.RejectCluster:input_type -> core.Reference
+	51, // 51: management.Management.CreateRole:input_type -> core.Role
+	42, // 52: management.Management.DeleteRole:input_type -> core.Reference
+	42, // 53: management.Management.GetRole:input_type -> core.Reference
+	51, // 54: management.Management.UpdateRole:input_type -> core.Role
+	52, // 55: management.Management.CreateRoleBinding:input_type -> core.RoleBinding
+	42, // 56: management.Management.DeleteRoleBinding:input_type -> core.Reference
+	42, // 57: management.Management.GetRoleBinding:input_type -> core.Reference
+	52, // 58: management.Management.UpdateRoleBinding:input_type -> core.RoleBinding
+	7,  // 59: management.Management.ListRoles:input_type -> management.ListRolesRequest
+	8,  // 60: management.Management.ListRoleBindings:input_type -> management.ListRoleBindingsRequest
+	53, // 61: management.Management.SubjectAccess:input_type -> core.SubjectAccessRequest
+	50, // 62: management.Management.APIExtensions:input_type -> google.protobuf.Empty
+	50, // 63: management.Management.GetConfig:input_type -> google.protobuf.Empty
+	23, // 64: management.Management.UpdateConfig:input_type -> management.UpdateConfigRequest
+	50, // 65: management.Management.ListCapabilities:input_type -> google.protobuf.Empty
+	25, // 66: management.Management.CapabilityInstaller:input_type -> management.CapabilityInstallerRequest
+	27, // 67: management.Management.GetCapabilityStatus:input_type -> management.CapabilityStatusRequest
+	28, // 68: management.Management.UninstallCapability:input_type -> management.UninstallCapabilityRequest
+	29, // 69: management.Management.Backup:input_type -> management.BackupRequest
+	31, // 70: management.Management.Restore:input_type -> management.RestoreRequest
+	32, // 71: management.Management.ListAuditEvents:input_type -> management.ListAuditEventsRequest
+	54, // 72: management.Management.CreateBootstrapToken:output_type -> core.BootstrapToken
+	50, // 73: management.Management.RevokeBootstrapToken:output_type -> google.protobuf.Empty
+	55, // 74: management.Management.ListBootstrapTokens:output_type -> core.BootstrapTokenList
+	54, // 75: management.Management.GetBootstrapToken:output_type -> core.BootstrapToken
+	56, // 76: management.Management.ListClusters:output_type -> core.ClusterList
+	15, // 77: management.Management.WatchClusters:output_type -> management.WatchEvent
+	16, // 78: management.Management.DeleteCluster:output_type -> management.DeleteClusterProgress
+	4,  // 79: management.Management.CertsInfo:output_type -> management.CertsInfoResponse
+	46, // 80: management.Management.GetCluster:output_type -> core.Cluster
+	46, // 81: management.Management.EditCluster:output_type -> core.Cluster
+	11, // 82: management.Management.RotateClusterKeys:output_type -> management.RotateClusterKeysResponse
+	13, // 83: management.Management.SendClusterCommand:output_type -> management.ClusterCommandResponse
+	46, // 84: management.Management.ApproveCluster:output_type -> core.Cluster
+	46, // 85: management.Management.RejectCluster:output_type -> core.Cluster
+	50, // 86: management.Management.CreateRole:output_type -> google.protobuf.Empty
+	50, // 87: management.Management.DeleteRole:output_type -> google.protobuf.Empty
+	51, // 88: management.Management.GetRole:output_type -> core.Role
+	51, // 89: management.Management.UpdateRole:output_type -> core.Role
+	50, // 90: management.Management.CreateRoleBinding:output_type -> google.protobuf.Empty
+	50, // 91: management.Management.DeleteRoleBinding:output_type -> google.protobuf.Empty
+	52, // 92: management.Management.GetRoleBinding:output_type -> core.RoleBinding
+	52, // 93: management.Management.UpdateRoleBinding:output_type -> core.RoleBinding
+	57, // 94: management.Management.ListRoles:output_type -> core.RoleList
+	58, // 95: management.Management.ListRoleBindings:output_type -> core.RoleBindingList
+	45, // 96: management.Management.SubjectAccess:output_type -> core.ReferenceList
+	17, // 97: management.Management.APIExtensions:output_type -> management.APIExtensionInfoList
+	20, // 98: management.Management.GetConfig:output_type -> management.GatewayConfig
+	50, // 99: management.Management.UpdateConfig:output_type -> google.protobuf.Empty
+	24, // 100: management.Management.ListCapabilities:output_type -> management.CapabilityList
+	26, // 101: management.Management.CapabilityInstaller:output_type -> management.CapabilityInstallerResponse
+	59, // 102: management.Management.GetCapabilityStatus:output_type -> core.CapabilityStatus
+	50, // 103: management.Management.UninstallCapability:output_type -> google.protobuf.Empty
+	30, // 104: management.Management.Backup:output_type -> management.BackupResponse
+	50, // 105: management.Management.Restore:output_type -> google.protobuf.Empty
+	60, // 106: management.Management.ListAuditEvents:output_type -> core.AuditEventList
+	72, // [72:107] is the sub-list for method output_type
+	37, // [37:72] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_pkg_management_management_proto_init() }
@@ -1475,7 +2804,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EditClusterRequest); i {
+			switch v := v.(*ListBootstrapTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1487,7 +2816,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchClustersRequest); i {
+			switch v := v.(*ListRolesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1499,7 +2828,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WatchEvent); i {
+			switch v := v.(*ListRoleBindingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1511,7 +2840,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIExtensionInfoList); i {
+			switch v := v.(*EditClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1523,7 +2852,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*APIExtensionInfo); i {
+			switch v := v.(*RotateClusterKeysRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1535,7 +2864,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HTTPRuleDescriptor); i {
+			switch v := v.(*RotateClusterKeysResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1547,7 +2876,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GatewayConfig); i {
+			switch v := v.(*ClusterCommandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1559,7 +2888,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigDocumentWithSchema); i {
+			switch v := v.(*ClusterCommandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1571,7 +2900,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConfigDocument); i {
+			switch v := v.(*WatchClustersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1583,7 +2912,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateConfigRequest); i {
+			switch v := v.(*WatchEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1595,7 +2924,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CapabilityList); i {
+			switch v := v.(*DeleteClusterProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1607,7 +2936,7 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CapabilityInstallerRequest); i {
+			switch v := v.(*APIExtensionInfoList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1619,6 +2948,102 @@ func file_pkg_management_management_proto_init() {
 			}
 		}
 		file_pkg_management_management_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIExtensionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HTTPRuleDescriptor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDocumentWithSchema); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDocument); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilityList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilityInstallerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CapabilityInstallerResponse); i {
 			case 0:
 				return &v.state
@@ -1630,14 +3055,86 @@ func file_pkg_management_management_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_management_management_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CapabilityStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallCapabilityRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_management_management_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_management_management_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   18,
+			NumEnums:      3,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,28 +26,39 @@ const _ = grpc.SupportPackageIsVersion7
 type ManagementClient interface {
 	CreateBootstrapToken(ctx context.Context, in *CreateBootstrapTokenRequest, opts ...grpc.CallOption) (*core.BootstrapToken, error)
 	RevokeBootstrapToken(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	ListBootstrapTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*core.BootstrapTokenList, error)
+	ListBootstrapTokens(ctx context.Context, in *ListBootstrapTokensRequest, opts ...grpc.CallOption) (*core.BootstrapTokenList, error)
 	GetBootstrapToken(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.BootstrapToken, error)
 	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*core.ClusterList, error)
 	WatchClusters(ctx context.Context, in *WatchClustersRequest, opts ...grpc.CallOption) (Management_WatchClustersClient, error)
-	DeleteCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (Management_DeleteClusterClient, error)
 	CertsInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CertsInfoResponse, error)
 	GetCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.Cluster, error)
 	EditCluster(ctx context.Context, in *EditClusterRequest, opts ...grpc.CallOption) (*core.Cluster, error)
+	RotateClusterKeys(ctx context.Context, in *RotateClusterKeysRequest, opts ...grpc.CallOption) (*RotateClusterKeysResponse, error)
+	SendClusterCommand(ctx context.Context, in *ClusterCommandRequest, opts ...grpc.CallOption) (*ClusterCommandResponse, error)
+	ApproveCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.Cluster, error)
+	RejectCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.Cluster, error)
 	CreateRole(ctx context.Context, in *core.Role, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRole(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetRole(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.Role, error)
+	UpdateRole(ctx context.Context, in *core.Role, opts ...grpc.CallOption) (*core.Role, error)
 	CreateRoleBinding(ctx context.Context, in *core.RoleBinding, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteRoleBinding(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetRoleBinding(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.RoleBinding, error)
-	ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*core.RoleList, error)
-	ListRoleBindings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*core.RoleBindingList, error)
+	UpdateRoleBinding(ctx context.Context, in *core.RoleBinding, opts ...grpc.CallOption) (*core.RoleBinding, error)
+	ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*core.RoleList, error)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*core.RoleBindingList, error)
 	SubjectAccess(ctx context.Context, in *core.SubjectAccessRequest, opts ...grpc.CallOption) (*core.ReferenceList, error)
 	APIExtensions(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*APIExtensionInfoList, error)
 	GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GatewayConfig, error)
 	UpdateConfig(ctx context.Context, in *UpdateConfigRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CapabilityList, error)
 	CapabilityInstaller(ctx context.Context, in *CapabilityInstallerRequest, opts ...grpc.CallOption) (*CapabilityInstallerResponse, error)
+	GetCapabilityStatus(ctx context.Context, in *CapabilityStatusRequest, opts ...grpc.CallOption) (*core.CapabilityStatus, error)
+	UninstallCapability(ctx context.Context, in *UninstallCapabilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (Management_BackupClient, error)
+	Restore(ctx context.Context, opts ...grpc.CallOption) (Management_RestoreClient, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*core.AuditEventList, error)
 }
 
 type managementClient struct {
@@ -76,7 +87,7 @@ func (c *managementClient) RevokeBootstrapToken(ctx context.Context, in *core.Re
 	return out, nil
 }
 
-func (c *managementClient) ListBootstrapTokens(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*core.BootstrapTokenList, error) {
+func (c *managementClient) ListBootstrapTokens(ctx context.Context, in *ListBootstrapTokensRequest, opts ...grpc.CallOption) (*core.BootstrapTokenList, error) {
 	out := new(core.BootstrapTokenList)
 	err := c.cc.Invoke(ctx, "/management.Management/ListBootstrapTokens", in, out, opts...)
 	if err != nil {
@@ -135,13 +146,36 @@ func (x *managementWatchClustersClient) Recv() (*WatchEvent, error) {
 	return m, nil
 }
 
-func (c *managementClient) DeleteCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
-	err := c.cc.Invoke(ctx, "/management.Management/DeleteCluster", in, out, opts...)
+func (c *managementClient) DeleteCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (Management_DeleteClusterClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Management_ServiceDesc.Streams[1], "/management.Management/DeleteCluster", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &managementDeleteClusterClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Management_DeleteClusterClient interface {
+	Recv() (*DeleteClusterProgress, error)
+	grpc.ClientStream
+}
+
+type managementDeleteClusterClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementDeleteClusterClient) Recv() (*DeleteClusterProgress, error) {
+	m := new(DeleteClusterProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *managementClient) CertsInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CertsInfoResponse, error) {
@@ -171,6 +205,42 @@ func (c *managementClient) EditCluster(ctx context.Context, in *EditClusterReque
 	return out, nil
 }
 
+func (c *managementClient) RotateClusterKeys(ctx context.Context, in *RotateClusterKeysRequest, opts ...grpc.CallOption) (*RotateClusterKeysResponse, error) {
+	out := new(RotateClusterKeysResponse)
+	err := c.cc.Invoke(ctx, "/management.Management/RotateClusterKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) SendClusterCommand(ctx context.Context, in *ClusterCommandRequest, opts ...grpc.CallOption) (*ClusterCommandResponse, error) {
+	out := new(ClusterCommandResponse)
+	err := c.cc.Invoke(ctx, "/management.Management/SendClusterCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) ApproveCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.Cluster, error) {
+	out := new(core.Cluster)
+	err := c.cc.Invoke(ctx, "/management.Management/ApproveCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) RejectCluster(ctx context.Context, in *core.Reference, opts ...grpc.CallOption) (*core.Cluster, error) {
+	out := new(core.Cluster)
+	err := c.cc.Invoke(ctx, "/management.Management/RejectCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementClient) CreateRole(ctx context.Context, in *core.Role, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/management.Management/CreateRole", in, out, opts...)
@@ -198,6 +268,15 @@ func (c *managementClient) GetRole(ctx context.Context, in *core.Reference, opts
 	return out, nil
 }
 
+func (c *managementClient) UpdateRole(ctx context.Context, in *core.Role, opts ...grpc.CallOption) (*core.Role, error) {
+	out := new(core.Role)
+	err := c.cc.Invoke(ctx, "/management.Management/UpdateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementClient) CreateRoleBinding(ctx context.Context, in *core.RoleBinding, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/management.Management/CreateRoleBinding", in, out, opts...)
@@ -225,7 +304,16 @@ func (c *managementClient) GetRoleBinding(ctx context.Context, in *core.Referenc
 	return out, nil
 }
 
-func (c *managementClient) ListRoles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*core.RoleList, error) {
+func (c *managementClient) UpdateRoleBinding(ctx context.Context, in *core.RoleBinding, opts ...grpc.CallOption) (*core.RoleBinding, error) {
+	out := new(core.RoleBinding)
+	err := c.cc.Invoke(ctx, "/management.Management/UpdateRoleBinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...grpc.CallOption) (*core.RoleList, error) {
 	out := new(core.RoleList)
 	err := c.cc.Invoke(ctx, "/management.Management/ListRoles", in, out, opts...)
 	if err != nil {
@@ -234,7 +322,7 @@ func (c *managementClient) ListRoles(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
-func (c *managementClient) ListRoleBindings(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*core.RoleBindingList, error) {
+func (c *managementClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*core.RoleBindingList, error) {
 	out := new(core.RoleBindingList)
 	err := c.cc.Invoke(ctx, "/management.Management/ListRoleBindings", in, out, opts...)
 	if err != nil {
@@ -297,34 +385,138 @@ func (c *managementClient) CapabilityInstaller(ctx context.Context, in *Capabili
 	return out, nil
 }
 
+func (c *managementClient) GetCapabilityStatus(ctx context.Context, in *CapabilityStatusRequest, opts ...grpc.CallOption) (*core.CapabilityStatus, error) {
+	out := new(core.CapabilityStatus)
+	err := c.cc.Invoke(ctx, "/management.Management/GetCapabilityStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) UninstallCapability(ctx context.Context, in *UninstallCapabilityRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/management.Management/UninstallCapability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *managementClient) Backup(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (Management_BackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Management_ServiceDesc.Streams[2], "/management.Management/Backup", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementBackupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Management_BackupClient interface {
+	Recv() (*BackupResponse, error)
+	grpc.ClientStream
+}
+
+type managementBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementBackupClient) Recv() (*BackupResponse, error) {
+	m := new(BackupResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementClient) Restore(ctx context.Context, opts ...grpc.CallOption) (Management_RestoreClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Management_ServiceDesc.Streams[3], "/management.Management/Restore", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &managementRestoreClient{stream}
+	return x, nil
+}
+
+type Management_RestoreClient interface {
+	Send(*RestoreRequest) error
+	CloseAndRecv() (*emptypb.Empty, error)
+	grpc.ClientStream
+}
+
+type managementRestoreClient struct {
+	grpc.ClientStream
+}
+
+func (x *managementRestoreClient) Send(m *RestoreRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *managementRestoreClient) CloseAndRecv() (*emptypb.Empty, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(emptypb.Empty)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *managementClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*core.AuditEventList, error) {
+	out := new(core.AuditEventList)
+	err := c.cc.Invoke(ctx, "/management.Management/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ManagementServer is the server API for Management service.
 // All implementations must embed UnimplementedManagementServer
 // for forward compatibility
 type ManagementServer interface {
 	CreateBootstrapToken(context.Context, *CreateBootstrapTokenRequest) (*core.BootstrapToken, error)
 	RevokeBootstrapToken(context.Context, *core.Reference) (*emptypb.Empty, error)
-	ListBootstrapTokens(context.Context, *emptypb.Empty) (*core.BootstrapTokenList, error)
+	ListBootstrapTokens(context.Context, *ListBootstrapTokensRequest) (*core.BootstrapTokenList, error)
 	GetBootstrapToken(context.Context, *core.Reference) (*core.BootstrapToken, error)
 	ListClusters(context.Context, *ListClustersRequest) (*core.ClusterList, error)
 	WatchClusters(*WatchClustersRequest, Management_WatchClustersServer) error
-	DeleteCluster(context.Context, *core.Reference) (*emptypb.Empty, error)
+	DeleteCluster(*core.Reference, Management_DeleteClusterServer) error
 	CertsInfo(context.Context, *emptypb.Empty) (*CertsInfoResponse, error)
 	GetCluster(context.Context, *core.Reference) (*core.Cluster, error)
 	EditCluster(context.Context, *EditClusterRequest) (*core.Cluster, error)
+	RotateClusterKeys(context.Context, *RotateClusterKeysRequest) (*RotateClusterKeysResponse, error)
+	SendClusterCommand(context.Context, *ClusterCommandRequest) (*ClusterCommandResponse, error)
+	ApproveCluster(context.Context, *core.Reference) (*core.Cluster, error)
+	RejectCluster(context.Context, *core.Reference) (*core.Cluster, error)
 	CreateRole(context.Context, *core.Role) (*emptypb.Empty, error)
 	DeleteRole(context.Context, *core.Reference) (*emptypb.Empty, error)
 	GetRole(context.Context, *core.Reference) (*core.Role, error)
+	UpdateRole(context.Context, *core.Role) (*core.Role, error)
 	CreateRoleBinding(context.Context, *core.RoleBinding) (*emptypb.Empty, error)
 	DeleteRoleBinding(context.Context, *core.Reference) (*emptypb.Empty, error)
 	GetRoleBinding(context.Context, *core.Reference) (*core.RoleBinding, error)
-	ListRoles(context.Context, *emptypb.Empty) (*core.RoleList, error)
-	ListRoleBindings(context.Context, *emptypb.Empty) (*core.RoleBindingList, error)
+	UpdateRoleBinding(context.Context, *core.RoleBinding) (*core.RoleBinding, error)
+	ListRoles(context.Context, *ListRolesRequest) (*core.RoleList, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*core.RoleBindingList, error)
 	SubjectAccess(context.Context, *core.SubjectAccessRequest) (*core.ReferenceList, error)
 	APIExtensions(context.Context, *emptypb.Empty) (*APIExtensionInfoList, error)
 	GetConfig(context.Context, *emptypb.Empty) (*GatewayConfig, error)
 	UpdateConfig(context.Context, *UpdateConfigRequest) (*emptypb.Empty, error)
 	ListCapabilities(context.Context, *emptypb.Empty) (*CapabilityList, error)
 	CapabilityInstaller(context.Context, *CapabilityInstallerRequest) (*CapabilityInstallerResponse, error)
+	GetCapabilityStatus(context.Context, *CapabilityStatusRequest) (*core.CapabilityStatus, error)
+	UninstallCapability(context.Context, *UninstallCapabilityRequest) (*emptypb.Empty, error)
+	Backup(*BackupRequest, Management_BackupServer) error
+	Restore(Management_RestoreServer) error
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*core.AuditEventList, error)
 	mustEmbedUnimplementedManagementServer()
 }
 
@@ -338,7 +530,7 @@ func (UnimplementedManagementServer) CreateBootstrapToken(context.Context, *Crea
 func (UnimplementedManagementServer) RevokeBootstrapToken(context.Context, *core.Reference) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeBootstrapToken not implemented")
 }
-func (UnimplementedManagementServer) ListBootstrapTokens(context.Context, *emptypb.Empty) (*core.BootstrapTokenList, error) {
+func (UnimplementedManagementServer) ListBootstrapTokens(context.Context, *ListBootstrapTokensRequest) (*core.BootstrapTokenList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBootstrapTokens not implemented")
 }
 func (UnimplementedManagementServer) GetBootstrapToken(context.Context, *core.Reference) (*core.BootstrapToken, error) {
@@ -350,8 +542,8 @@ func (UnimplementedManagementServer) ListClusters(context.Context, *ListClusters
 func (UnimplementedManagementServer) WatchClusters(*WatchClustersRequest, Management_WatchClustersServer) error {
 	return status.Errorf(codes.Unimplemented, "method WatchClusters not implemented")
 }
-func (UnimplementedManagementServer) DeleteCluster(context.Context, *core.Reference) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
+func (UnimplementedManagementServer) DeleteCluster(*core.Reference, Management_DeleteClusterServer) error {
+	return status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
 func (UnimplementedManagementServer) CertsInfo(context.Context, *emptypb.Empty) (*CertsInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CertsInfo not implemented")
@@ -362,6 +554,18 @@ func (UnimplementedManagementServer) GetCluster(context.Context, *core.Reference
 func (UnimplementedManagementServer) EditCluster(context.Context, *EditClusterRequest) (*core.Cluster, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EditCluster not implemented")
 }
+func (UnimplementedManagementServer) RotateClusterKeys(context.Context, *RotateClusterKeysRequest) (*RotateClusterKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateClusterKeys not implemented")
+}
+func (UnimplementedManagementServer) SendClusterCommand(context.Context, *ClusterCommandRequest) (*ClusterCommandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendClusterCommand not implemented")
+}
+func (UnimplementedManagementServer) ApproveCluster(context.Context, *core.Reference) (*core.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveCluster not implemented")
+}
+func (UnimplementedManagementServer) RejectCluster(context.Context, *core.Reference) (*core.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectCluster not implemented")
+}
 func (UnimplementedManagementServer) CreateRole(context.Context, *core.Role) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
 }
@@ -371,6 +575,9 @@ func (UnimplementedManagementServer) DeleteRole(context.Context, *core.Reference
 func (UnimplementedManagementServer) GetRole(context.Context, *core.Reference) (*core.Role, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
 }
+func (UnimplementedManagementServer) UpdateRole(context.Context, *core.Role) (*core.Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
+}
 func (UnimplementedManagementServer) CreateRoleBinding(context.Context, *core.RoleBinding) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
 }
@@ -380,10 +587,13 @@ func (UnimplementedManagementServer) DeleteRoleBinding(context.Context, *core.Re
 func (UnimplementedManagementServer) GetRoleBinding(context.Context, *core.Reference) (*core.RoleBinding, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRoleBinding not implemented")
 }
-func (UnimplementedManagementServer) ListRoles(context.Context, *emptypb.Empty) (*core.RoleList, error) {
+func (UnimplementedManagementServer) UpdateRoleBinding(context.Context, *core.RoleBinding) (*core.RoleBinding, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoleBinding not implemented")
+}
+func (UnimplementedManagementServer) ListRoles(context.Context, *ListRolesRequest) (*core.RoleList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoles not implemented")
 }
-func (UnimplementedManagementServer) ListRoleBindings(context.Context, *emptypb.Empty) (*core.RoleBindingList, error) {
+func (UnimplementedManagementServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*core.RoleBindingList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
 }
 func (UnimplementedManagementServer) SubjectAccess(context.Context, *core.SubjectAccessRequest) (*core.ReferenceList, error) {
@@ -404,6 +614,21 @@ func (UnimplementedManagementServer) ListCapabilities(context.Context, *emptypb.
 func (UnimplementedManagementServer) CapabilityInstaller(context.Context, *CapabilityInstallerRequest) (*CapabilityInstallerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CapabilityInstaller not implemented")
 }
+func (UnimplementedManagementServer) GetCapabilityStatus(context.Context, *CapabilityStatusRequest) (*core.CapabilityStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilityStatus not implemented")
+}
+func (UnimplementedManagementServer) UninstallCapability(context.Context, *UninstallCapabilityRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UninstallCapability not implemented")
+}
+func (UnimplementedManagementServer) Backup(*BackupRequest, Management_BackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedManagementServer) Restore(Management_RestoreServer) error {
+	return status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedManagementServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*core.AuditEventList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
 func (UnimplementedManagementServer) mustEmbedUnimplementedManagementServer() {}
 
 // UnsafeManagementServer may be embedded to opt out of forward compatibility for this service.
@@ -454,7 +679,7 @@ func _Management_RevokeBootstrapToken_Handler(srv interface{}, ctx context.Conte
 }
 
 func _Management_ListBootstrapTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListBootstrapTokensRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -466,7 +691,7 @@ func _Management_ListBootstrapTokens_Handler(srv interface{}, ctx context.Contex
 		FullMethod: "/management.Management/ListBootstrapTokens",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).ListBootstrapTokens(ctx, req.(*emptypb.Empty))
+		return srv.(ManagementServer).ListBootstrapTokens(ctx, req.(*ListBootstrapTokensRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -528,22 +753,25 @@ func (x *managementWatchClustersServer) Send(m *WatchEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Management_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(core.Reference)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ManagementServer).DeleteCluster(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/management.Management/DeleteCluster",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).DeleteCluster(ctx, req.(*core.Reference))
+func _Management_DeleteCluster_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(core.Reference)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(ManagementServer).DeleteCluster(m, &managementDeleteClusterServer{stream})
+}
+
+type Management_DeleteClusterServer interface {
+	Send(*DeleteClusterProgress) error
+	grpc.ServerStream
+}
+
+type managementDeleteClusterServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementDeleteClusterServer) Send(m *DeleteClusterProgress) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 func _Management_CertsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -600,6 +828,78 @@ func _Management_EditCluster_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Management_RotateClusterKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateClusterKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).RotateClusterKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/RotateClusterKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).RotateClusterKeys(ctx, req.(*RotateClusterKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_SendClusterCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).SendClusterCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/SendClusterCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).SendClusterCommand(ctx, req.(*ClusterCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_ApproveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(core.Reference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ApproveCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/ApproveCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ApproveCluster(ctx, req.(*core.Reference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_RejectCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(core.Reference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).RejectCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/RejectCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).RejectCluster(ctx, req.(*core.Reference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Management_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(core.Role)
 	if err := dec(in); err != nil {
@@ -654,6 +954,24 @@ func _Management_GetRole_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Management_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(core.Role)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).UpdateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/UpdateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).UpdateRole(ctx, req.(*core.Role))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Management_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(core.RoleBinding)
 	if err := dec(in); err != nil {
@@ -708,8 +1026,26 @@ func _Management_GetRoleBinding_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Management_UpdateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(core.RoleBinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).UpdateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/UpdateRoleBinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).UpdateRoleBinding(ctx, req.(*core.RoleBinding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Management_ListRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -721,13 +1057,13 @@ func _Management_ListRoles_Handler(srv interface{}, ctx context.Context, dec fun
 		FullMethod: "/management.Management/ListRoles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).ListRoles(ctx, req.(*emptypb.Empty))
+		return srv.(ManagementServer).ListRoles(ctx, req.(*ListRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _Management_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(ListRoleBindingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -739,7 +1075,7 @@ func _Management_ListRoleBindings_Handler(srv interface{}, ctx context.Context,
 		FullMethod: "/management.Management/ListRoleBindings",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ManagementServer).ListRoleBindings(ctx, req.(*emptypb.Empty))
+		return srv.(ManagementServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -852,6 +1188,107 @@ func _Management_CapabilityInstaller_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Management_GetCapabilityStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapabilityStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).GetCapabilityStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/GetCapabilityStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).GetCapabilityStatus(ctx, req.(*CapabilityStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_UninstallCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UninstallCapabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).UninstallCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/UninstallCapability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).UninstallCapability(ctx, req.(*UninstallCapabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Management_Backup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ManagementServer).Backup(m, &managementBackupServer{stream})
+}
+
+type Management_BackupServer interface {
+	Send(*BackupResponse) error
+	grpc.ServerStream
+}
+
+type managementBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementBackupServer) Send(m *BackupResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Management_Restore_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ManagementServer).Restore(&managementRestoreServer{stream})
+}
+
+type Management_RestoreServer interface {
+	SendAndClose(*emptypb.Empty) error
+	Recv() (*RestoreRequest, error)
+	grpc.ServerStream
+}
+
+type managementRestoreServer struct {
+	grpc.ServerStream
+}
+
+func (x *managementRestoreServer) SendAndClose(m *emptypb.Empty) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *managementRestoreServer) Recv() (*RestoreRequest, error) {
+	m := new(RestoreRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Management_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/management.Management/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Management_ServiceDesc is the grpc.ServiceDesc for Management service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -879,10 +1316,6 @@ var Management_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListClusters",
 			Handler:    _Management_ListClusters_Handler,
 		},
-		{
-			MethodName: "DeleteCluster",
-			Handler:    _Management_DeleteCluster_Handler,
-		},
 		{
 			MethodName: "CertsInfo",
 			Handler:    _Management_CertsInfo_Handler,
@@ -895,6 +1328,22 @@ var Management_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EditCluster",
 			Handler:    _Management_EditCluster_Handler,
 		},
+		{
+			MethodName: "RotateClusterKeys",
+			Handler:    _Management_RotateClusterKeys_Handler,
+		},
+		{
+			MethodName: "SendClusterCommand",
+			Handler:    _Management_SendClusterCommand_Handler,
+		},
+		{
+			MethodName: "ApproveCluster",
+			Handler:    _Management_ApproveCluster_Handler,
+		},
+		{
+			MethodName: "RejectCluster",
+			Handler:    _Management_RejectCluster_Handler,
+		},
 		{
 			MethodName: "CreateRole",
 			Handler:    _Management_CreateRole_Handler,
@@ -907,6 +1356,10 @@ var Management_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRole",
 			Handler:    _Management_GetRole_Handler,
 		},
+		{
+			MethodName: "UpdateRole",
+			Handler:    _Management_UpdateRole_Handler,
+		},
 		{
 			MethodName: "CreateRoleBinding",
 			Handler:    _Management_CreateRoleBinding_Handler,
@@ -919,6 +1372,10 @@ var Management_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRoleBinding",
 			Handler:    _Management_GetRoleBinding_Handler,
 		},
+		{
+			MethodName: "UpdateRoleBinding",
+			Handler:    _Management_UpdateRoleBinding_Handler,
+		},
 		{
 			MethodName: "ListRoles",
 			Handler:    _Management_ListRoles_Handler,
@@ -951,6 +1408,18 @@ var Management_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CapabilityInstaller",
 			Handler:    _Management_CapabilityInstaller_Handler,
 		},
+		{
+			MethodName: "GetCapabilityStatus",
+			Handler:    _Management_GetCapabilityStatus_Handler,
+		},
+		{
+			MethodName: "UninstallCapability",
+			Handler:    _Management_UninstallCapability_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _Management_ListAuditEvents_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -958,6 +1427,21 @@ var Management_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Management_WatchClusters_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DeleteCluster",
+			Handler:       _Management_DeleteCluster_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Backup",
+			Handler:       _Management_Backup_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Restore",
+			Handler:       _Management_Restore_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "pkg/management/management.proto",
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/validation"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
@@ -30,6 +31,21 @@ func (s *Server) GetRole(ctx context.Context, in *core.Reference) (*core.Role, e
 	return role, err
 }
 
+func (s *Server) UpdateRole(ctx context.Context, in *core.Role) (*core.Role, error) {
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	return s.coreDataSource.StorageBackend().UpdateRole(ctx, in.Reference(),
+		storage.NewCompositeMutator(
+			storage.NewCompareAndSwapMutator[*core.Role](in.GetResourceVersion()),
+			func(role *core.Role) {
+				role.ClusterIDs = in.GetClusterIDs()
+				role.MatchLabels = in.GetMatchLabels()
+			},
+		),
+	)
+}
+
 func (s *Server) CreateRoleBinding(ctx context.Context, in *core.RoleBinding) (*emptypb.Empty, error) {
 	if err := validation.Validate(in); err != nil {
 		return nil, err
@@ -55,13 +71,37 @@ func (s *Server) GetRoleBinding(ctx context.Context, in *core.Reference) (*core.
 	return rb, err
 }
 
-func (s *Server) ListRoles(ctx context.Context, _ *emptypb.Empty) (*core.RoleList, error) {
-	rl, err := s.coreDataSource.StorageBackend().ListRoles(ctx)
+func (s *Server) UpdateRoleBinding(ctx context.Context, in *core.RoleBinding) (*core.RoleBinding, error) {
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	if len(in.Taints) > 0 {
+		return nil, validation.ErrReadOnlyField
+	}
+	return s.coreDataSource.StorageBackend().UpdateRoleBinding(ctx, in.Reference(),
+		storage.NewCompositeMutator(
+			storage.NewCompareAndSwapMutator[*core.RoleBinding](in.GetResourceVersion()),
+			func(rb *core.RoleBinding) {
+				rb.RoleId = in.GetRoleId()
+				rb.Subjects = in.GetSubjects()
+			},
+		),
+	)
+}
+
+func (s *Server) ListRoles(ctx context.Context, in *ListRolesRequest) (*core.RoleList, error) {
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	rl, err := s.coreDataSource.StorageBackend().ListRoles(ctx, listOptions(in, nil)...)
 	return rl, err
 }
 
-func (s *Server) ListRoleBindings(ctx context.Context, _ *emptypb.Empty) (*core.RoleBindingList, error) {
-	rbl, err := s.coreDataSource.StorageBackend().ListRoleBindings(ctx)
+func (s *Server) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest) (*core.RoleBindingList, error) {
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	rbl, err := s.coreDataSource.StorageBackend().ListRoleBindings(ctx, listOptions(in, nil)...)
 	return rbl, err
 }
 
@@ -7,11 +7,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/test"
 	"github.com/rancher/opni-monitoring/pkg/validation"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
@@ -19,10 +19,10 @@ var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
 	BeforeAll(setupManagementServer(&tv))
 
 	It("should initially have no RBAC objects", func() {
-		roles, err := tv.client.ListRoles(context.Background(), &emptypb.Empty{})
+		roles, err := tv.client.ListRoles(context.Background(), &management.ListRolesRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(roles.Items).To(BeEmpty())
-		rbs, err := tv.client.ListRoleBindings(context.Background(), &emptypb.Empty{})
+		rbs, err := tv.client.ListRoleBindings(context.Background(), &management.ListRoleBindingsRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rbs.Items).To(BeEmpty())
 	})
@@ -40,7 +40,7 @@ var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
 			Expect(created.Id).To(Equal(role.Id))
 		}
 
-		roles, err := tv.client.ListRoles(context.Background(), &emptypb.Empty{})
+		roles, err := tv.client.ListRoles(context.Background(), &management.ListRolesRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(roles.Items).To(HaveLen(100))
 	})
@@ -59,7 +59,7 @@ var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
 			Expect(created.Id).To(Equal(rb.Id))
 		}
 
-		rbs, err := tv.client.ListRoleBindings(context.Background(), &emptypb.Empty{})
+		rbs, err := tv.client.ListRoleBindings(context.Background(), &management.ListRoleBindingsRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(rbs.Items).To(HaveLen(100))
 	})
@@ -75,6 +75,63 @@ var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
 		}
 	})
 
+	It("should update roles", func() {
+		role, err := tv.client.GetRole(context.Background(), &core.Reference{
+			Id: "role-0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		role.ClusterIDs = []string{"cluster-0", "cluster-1"}
+		updated, err := tv.client.UpdateRole(context.Background(), role)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.ClusterIDs).To(ConsistOf("cluster-0", "cluster-1"))
+		Expect(updated.GetResourceVersion()).NotTo(Equal(role.GetResourceVersion()))
+
+		refList, err := tv.client.SubjectAccess(context.Background(), &core.SubjectAccessRequest{
+			Subject: "user-0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(refList.Items).To(HaveLen(2))
+
+		By("using a stale resource version")
+		role.ClusterIDs = []string{"cluster-2"}
+		_, err = tv.client.UpdateRole(context.Background(), role)
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+
+		current, err := tv.client.GetRole(context.Background(), role.Reference())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.ClusterIDs).To(ConsistOf("cluster-0", "cluster-1"))
+	})
+
+	It("should update role bindings", func() {
+		rb, err := tv.client.GetRoleBinding(context.Background(), &core.Reference{
+			Id: "rb-0",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		rb.Taints = nil
+		rb.Subjects = []string{"user-0", "user-100"}
+		updated, err := tv.client.UpdateRoleBinding(context.Background(), rb)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated.Subjects).To(ConsistOf("user-0", "user-100"))
+
+		refList, err := tv.client.SubjectAccess(context.Background(), &core.SubjectAccessRequest{
+			Subject: "user-100",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(refList.Items).To(HaveLen(2))
+
+		By("using a stale resource version")
+		rb.Subjects = []string{"user-0"}
+		_, err = tv.client.UpdateRoleBinding(context.Background(), rb)
+		Expect(status.Code(err)).To(Equal(codes.Aborted))
+
+		By("updating a nonexistent role binding")
+		_, err = tv.client.UpdateRoleBinding(context.Background(), &core.RoleBinding{
+			Id:     "nonexistent",
+			RoleId: "role-0",
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+	})
+
 	It("should delete roles", func() {
 		for i := 0; i < 100; i++ {
 			role := &core.Role{
@@ -86,7 +143,7 @@ var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
 			_, err = tv.client.GetRole(context.Background(), role.Reference())
 			Expect(status.Code(err)).To(Equal(codes.NotFound))
 
-			roles, err := tv.client.ListRoles(context.Background(), &emptypb.Empty{})
+			roles, err := tv.client.ListRoles(context.Background(), &management.ListRolesRequest{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(roles.Items).To(HaveLen(100 - i - 1))
 		}
@@ -103,7 +160,7 @@ var _ = Describe("RBAC", Ordered, Label(test.Unit, test.Slow), func() {
 			_, err = tv.client.GetRoleBinding(context.Background(), rb.Reference())
 			Expect(status.Code(err)).To(Equal(codes.NotFound))
 
-			rbs, err := tv.client.ListRoleBindings(context.Background(), &emptypb.Empty{})
+			rbs, err := tv.client.ListRoleBindings(context.Background(), &management.ListRoleBindingsRequest{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(rbs.Items).To(HaveLen(100 - i - 1))
 		}
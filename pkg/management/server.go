@@ -12,10 +12,12 @@ import (
 
 	"github.com/jhump/protoreflect/desc"
 	"github.com/kralicky/grpc-gateway/v2/runtime"
+	"github.com/rancher/opni-monitoring/pkg/audit"
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/config"
 	"github.com/rancher/opni-monitoring/pkg/config/meta"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/control"
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/logger"
 	"github.com/rancher/opni-monitoring/pkg/pkp"
@@ -25,6 +27,7 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/util"
 	"github.com/rancher/opni-monitoring/pkg/util/waitctx"
+	"github.com/rancher/opni-monitoring/pkg/validation"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -55,6 +58,13 @@ type CapabilitiesDataSource interface {
 	CapabilitiesStore() capabilities.BackendStore
 }
 
+// AgentControlDataSource provides a way to send commands to agents which are
+// connected to the gateway, and to disconnect them
+type AgentControlDataSource interface {
+	// Returns nil if agent control streams are not available.
+	AgentControl() control.AgentController
+}
+
 type apiExtension struct {
 	client      apiextensions.ManagementAPIExtensionClient
 	clientConn  *grpc.ClientConn
@@ -83,6 +93,8 @@ type ManagementServerOptions struct {
 	apiExtPlugins          []APIExtensionPlugin
 	systemPlugins          []plugins.ActivePlugin
 	capabilitiesDataSource CapabilitiesDataSource
+	agentControlDataSource AgentControlDataSource
+	auditLog               *audit.Log
 }
 
 type ManagementServerOption func(*ManagementServerOptions)
@@ -117,6 +129,20 @@ func WithCapabilitiesDataSource(src CapabilitiesDataSource) ManagementServerOpti
 	}
 }
 
+func WithAgentControlDataSource(src AgentControlDataSource) ManagementServerOption {
+	return func(o *ManagementServerOptions) {
+		o.agentControlDataSource = src
+	}
+}
+
+// WithAuditLog records mutating management API calls in the given audit log,
+// and enables the ListAuditEvents API.
+func WithAuditLog(log *audit.Log) ManagementServerOption {
+	return func(o *ManagementServerOptions) {
+		o.auditLog = log
+	}
+}
+
 func NewServer(
 	ctx context.Context,
 	conf *v1beta1.ManagementSpec,
@@ -159,6 +185,8 @@ func (m *Server) ListenAndServe() error {
 	srv := grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
 		grpc.UnknownServiceHandler(unknownServiceHandler(director)),
+		grpc.ChainUnaryInterceptor(m.auditInterceptor),
+		grpc.ChainStreamInterceptor(m.auditStreamInterceptor),
 	)
 	RegisterManagementServer(srv, m)
 
@@ -224,25 +252,41 @@ func (m *Server) CertsInfo(ctx context.Context, _ *emptypb.Empty) (*CertsInfoRes
 	resp := &CertsInfoResponse{
 		Chain: []*core.CertInfo{},
 	}
-	for _, tlsCert := range m.coreDataSource.TLSConfig().Certificates[:1] {
-		for _, der := range tlsCert.Certificate {
-			cert, err := x509.ParseCertificate(der)
-			if err != nil {
-				return nil, status.Error(codes.Internal, err.Error())
-			}
-			resp.Chain = append(resp.Chain, &core.CertInfo{
-				Issuer:      cert.Issuer.String(),
-				Subject:     cert.Subject.String(),
-				IsCA:        cert.IsCA,
-				NotBefore:   cert.NotBefore.Format(time.RFC3339),
-				NotAfter:    cert.NotAfter.Format(time.RFC3339),
-				Fingerprint: pkp.NewSha256(cert).Encode(),
-			})
+	// The first certificate is the one currently in use. Any others are
+	// pending certificates which will replace it.
+	for i, tlsCert := range m.coreDataSource.TLSConfig().Certificates {
+		chain, err := certInfoChain(tlsCert)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if i == 0 {
+			resp.Chain = chain
+		} else {
+			resp.PendingChain = append(resp.PendingChain, chain...)
 		}
 	}
 	return resp, nil
 }
 
+func certInfoChain(tlsCert tls.Certificate) ([]*core.CertInfo, error) {
+	chain := []*core.CertInfo{}
+	for _, der := range tlsCert.Certificate {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, &core.CertInfo{
+			Issuer:      cert.Issuer.String(),
+			Subject:     cert.Subject.String(),
+			IsCA:        cert.IsCA,
+			NotBefore:   cert.NotBefore.Format(time.RFC3339),
+			NotAfter:    cert.NotAfter.Format(time.RFC3339),
+			Fingerprint: pkp.NewSha256(cert).Encode(),
+		})
+	}
+	return chain, nil
+}
+
 func (m *Server) ListCapabilities(ctx context.Context, _ *emptypb.Empty) (*CapabilityList, error) {
 	if m.capabilitiesDataSource == nil {
 		return nil, status.Error(codes.Unavailable, "capability backend store not configured")
@@ -274,3 +318,21 @@ func (m *Server) CapabilityInstaller(
 		Command: cmd,
 	}, nil
 }
+
+func (m *Server) GetCapabilityStatus(
+	ctx context.Context,
+	req *CapabilityStatusRequest,
+) (*core.CapabilityStatus, error) {
+	if m.capabilitiesDataSource == nil {
+		return nil, status.Error(codes.Unavailable, "capability backend store not configured")
+	}
+	if err := validation.Validate(req); err != nil {
+		return nil, err
+	}
+	if _, err := m.coreDataSource.StorageBackend().GetCluster(ctx, req.Cluster); err != nil {
+		return nil, err
+	}
+	return m.capabilitiesDataSource.
+		CapabilitiesStore().
+		InstallStatus(req.Cluster, req.Name)
+}
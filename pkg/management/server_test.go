@@ -3,13 +3,17 @@ package management_test
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"net/http"
 
 	"github.com/rancher/opni-monitoring/pkg/capabilities"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/test"
 	"github.com/rancher/opni-monitoring/pkg/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -39,6 +43,23 @@ var _ = Describe("Server", Ordered, Label(test.Unit, test.Slow), func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(info.Chain).To(HaveLen(1))
 		Expect(info.Chain[0].Subject).To(Equal("CN=leaf"))
+		Expect(info.PendingChain).To(BeEmpty())
+	})
+	It("should return cert info for pending certificates", func() {
+		next, err := tls.X509KeyPair(test.TestData("self_signed_leaf.crt"), test.TestData("self_signed_leaf.key"))
+		Expect(err).NotTo(HaveOccurred())
+		tlsConfig := tv.coreDataSource.TLSConfig()
+		tlsConfig.Certificates = append(tlsConfig.Certificates, next)
+		DeferCleanup(func() {
+			tlsConfig.Certificates = tlsConfig.Certificates[:1]
+		})
+
+		info, err := tv.client.CertsInfo(context.Background(), &emptypb.Empty{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Chain).To(HaveLen(1))
+		Expect(info.Chain[0].Subject).To(Equal("CN=leaf"))
+		Expect(info.PendingChain).To(HaveLen(1))
+		Expect(info.PendingChain[0].Fingerprint).NotTo(Equal(info.Chain[0].Fingerprint))
 	})
 	It("should serve the swagger.json endpoint", func() {
 		resp, err := http.Get(tv.httpEndpoint + "/swagger.json")
@@ -105,4 +126,33 @@ var _ = Describe("Server", Ordered, Label(test.Unit, test.Slow), func() {
 		})
 		Expect(err).NotTo(HaveOccurred())
 	})
+	It("should report capability install status", func() {
+		ref := &core.Reference{Id: "cluster-1"}
+		_, err := tv.client.GetCapabilityStatus(context.Background(), &management.CapabilityStatusRequest{
+			Name:    "capability1",
+			Cluster: ref,
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		Expect(tv.storageBackend.CreateCluster(context.Background(), &core.Cluster{
+			Id: ref.Id,
+		})).To(Succeed())
+		_, err = tv.client.GetCapabilityStatus(context.Background(), &management.CapabilityStatusRequest{
+			Name:    "capability1",
+			Cluster: ref,
+		})
+		Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+		capBackendStore.InstallCapabilities(ref, "capability1")
+		Eventually(func() core.TaskState {
+			s, err := tv.client.GetCapabilityStatus(context.Background(), &management.CapabilityStatusRequest{
+				Name:    "capability1",
+				Cluster: ref,
+			})
+			if err != nil {
+				return -1
+			}
+			return s.State
+		}).Should(Equal(core.TaskState_TaskSucceeded))
+	})
 })
@@ -2,6 +2,7 @@ package management
 
 import (
 	"context"
+	"errors"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/storage"
@@ -21,6 +22,7 @@ func (m *Server) CreateBootstrapToken(
 	token, err := m.coreDataSource.StorageBackend().CreateToken(ctx, req.Ttl.AsDuration(),
 		storage.WithLabels(req.GetLabels()),
 		storage.WithCapabilities(req.GetCapabilities()),
+		storage.WithLimits(req.Limits()),
 	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -40,14 +42,19 @@ func (m *Server) RevokeBootstrapToken(
 
 func (m *Server) ListBootstrapTokens(
 	ctx context.Context,
-	_ *emptypb.Empty,
+	in *ListBootstrapTokensRequest,
 ) (*core.BootstrapTokenList, error) {
-	tokens, err := m.coreDataSource.StorageBackend().ListTokens(ctx)
+	if err := validation.Validate(in); err != nil {
+		return nil, err
+	}
+	tokenList, err := m.coreDataSource.StorageBackend().ListTokens(ctx,
+		listOptions(in, in.GetCapabilities())...)
 	if err != nil {
+		if errors.Is(err, storage.ErrInvalidPageToken) {
+			return nil, err
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	tokenList := &core.BootstrapTokenList{}
-	tokenList.Items = append(tokenList.Items, tokens...)
 	return tokenList, nil
 }
 
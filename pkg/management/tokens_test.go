@@ -9,7 +9,6 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/test"
 	"google.golang.org/protobuf/types/known/durationpb"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 var _ = Describe("Tokens", Ordered, Label(test.Unit, test.Slow), func() {
@@ -17,7 +16,7 @@ var _ = Describe("Tokens", Ordered, Label(test.Unit, test.Slow), func() {
 	BeforeAll(setupManagementServer(&tv))
 
 	It("should initially have no tokens", func() {
-		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(tokens.Items).To(BeEmpty())
 	})
@@ -53,12 +52,12 @@ var _ = Describe("Tokens", Ordered, Label(test.Unit, test.Slow), func() {
 		}
 	})
 	It("should list bootstrap tokens", func() {
-		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(tokens.Items).To(HaveLen(100))
 	})
 	It("should get bootstrap tokens", func() {
-		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		Expect(tokens.Items).To(HaveLen(100))
 		for _, token := range tokens.Items {
@@ -68,16 +67,30 @@ var _ = Describe("Tokens", Ordered, Label(test.Unit, test.Slow), func() {
 		}
 	})
 	It("should revoke bootstrap tokens", func() {
-		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+		tokens, err := tv.client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 		Expect(err).NotTo(HaveOccurred())
 
 		for i, token := range tokens.Items {
 			_, err := tv.client.RevokeBootstrapToken(context.Background(), token.Reference())
 			Expect(err).NotTo(HaveOccurred())
 
-			tokens, err = tv.client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+			tokens, err = tv.client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(tokens.Items).To(HaveLen(100 - i - 1))
 		}
 	})
+	It("should create bootstrap tokens with usage limits", func() {
+		token, err := tv.client.CreateBootstrapToken(context.Background(), &management.CreateBootstrapTokenRequest{
+			Ttl:          durationpb.New(time.Minute),
+			MaxUsages:    1,
+			AllowedCIDRs: []string{"10.0.0.0/8"},
+			OneTime:      true,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		token, err = tv.client.GetBootstrapToken(context.Background(), token.Reference())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(token.GetMetadata().GetLimits().GetMaxUsages()).To(BeEquivalentTo(1))
+		Expect(token.GetMetadata().GetLimits().GetAllowedCIDRs()).To(ConsistOf("10.0.0.0/8"))
+		Expect(token.GetMetadata().GetLimits().GetOneTime()).To(BeTrue())
+	})
 })
@@ -3,6 +3,9 @@ package management
 import (
 	"os"
 	"path/filepath"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
 )
 
 func DefaultManagementSocket() string {
@@ -16,3 +19,19 @@ func DefaultManagementSocket() string {
 	}
 	return "unix:///tmp/opni-monitoring/management.sock"
 }
+
+// pageRequest is implemented by list requests which support pagination.
+type pageRequest interface {
+	GetPageSize() int32
+	GetPageToken() string
+	GetSortOrder() core.SortOrder
+}
+
+func listOptions(req pageRequest, capabilities []string) []storage.ListOption {
+	return []storage.ListOption{
+		storage.WithPageSize(int(req.GetPageSize())),
+		storage.WithPageToken(req.GetPageToken()),
+		storage.WithSortOrder(req.GetSortOrder()),
+		storage.WithCapabilityFilter(capabilities...),
+	}
+}
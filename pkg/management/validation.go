@@ -4,7 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
+	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/validation"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (r *CreateBootstrapTokenRequest) Validate() error {
@@ -22,9 +25,27 @@ func (r *CreateBootstrapTokenRequest) Validate() error {
 			return err
 		}
 	}
+	if limits := r.Limits(); limits != nil {
+		if err := validation.Validate(limits); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// Limits returns the usage limits requested for the token, or nil if the
+// token should not be limited.
+func (r *CreateBootstrapTokenRequest) Limits() *core.TokenLimits {
+	if r.GetMaxUsages() == 0 && len(r.GetAllowedCIDRs()) == 0 && !r.GetOneTime() {
+		return nil
+	}
+	return &core.TokenLimits{
+		MaxUsages:    r.GetMaxUsages(),
+		AllowedCIDRs: r.GetAllowedCIDRs(),
+		OneTime:      r.GetOneTime(),
+	}
+}
+
 func (r *ListClustersRequest) Validate() error {
 	if r.MatchLabels != nil {
 		if err := validation.Validate(r.MatchLabels); err != nil {
@@ -34,6 +55,61 @@ func (r *ListClustersRequest) Validate() error {
 	if err := validation.Validate(r.MatchOptions); err != nil {
 		return err
 	}
+	if err := validatePageRequest(r); err != nil {
+		return err
+	}
+	return validateCapabilityNames(r.GetCapabilities())
+}
+
+func (r *ListBootstrapTokensRequest) Validate() error {
+	if err := validatePageRequest(r); err != nil {
+		return err
+	}
+	return validateCapabilityNames(r.GetCapabilities())
+}
+
+func (r *ListRolesRequest) Validate() error {
+	return validatePageRequest(r)
+}
+
+func (r *ListRoleBindingsRequest) Validate() error {
+	return validatePageRequest(r)
+}
+
+func (r *ListAuditEventsRequest) Validate() error {
+	if err := validatePageRequest(r); err != nil {
+		return err
+	}
+	for name, ts := range map[string]*timestamppb.Timestamp{
+		"since": r.Since,
+		"until": r.Until,
+	} {
+		if ts == nil {
+			continue
+		}
+		if err := ts.CheckValid(); err != nil {
+			return fmt.Errorf("%w (%s): %s", validation.ErrInvalidValue, name, err.Error())
+		}
+	}
+	return nil
+}
+
+func validatePageRequest(r pageRequest) error {
+	if r.GetPageSize() < 0 {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidValue, "pageSize cannot be negative")
+	}
+	if _, ok := core.SortOrder_name[int32(r.GetSortOrder())]; !ok {
+		return fmt.Errorf("%w: unknown sort order %d", validation.ErrInvalidValue, r.GetSortOrder())
+	}
+	return nil
+}
+
+func validateCapabilityNames(names []string) error {
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("%w: %s", validation.ErrInvalidValue, "capability names cannot be empty")
+		}
+	}
 	return nil
 }
 
@@ -47,6 +123,45 @@ func (r *EditClusterRequest) Validate() error {
 	if err := validation.ValidateLabels(r.GetLabels()); err != nil {
 		return err
 	}
+	for _, key := range r.GetRemoveLabels() {
+		if err := validation.ValidateLabelName(key); err != nil {
+			return err
+		}
+		if _, ok := r.GetLabels()[key]; ok {
+			return fmt.Errorf("%w: label %q cannot be both set and removed", validation.ErrInvalidValue, key)
+		}
+	}
+	return nil
+}
+
+func (r *RotateClusterKeysRequest) Validate() error {
+	if r.Cluster == nil {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "cluster")
+	}
+	if err := validation.Validate(r.Cluster); err != nil {
+		return err
+	}
+	if r.GracePeriod != nil {
+		if err := r.GracePeriod.CheckValid(); err != nil {
+			return fmt.Errorf("%w (gracePeriod): %s", validation.ErrInvalidValue, err.Error())
+		}
+		if r.GracePeriod.AsDuration() < 0 {
+			return fmt.Errorf("%w: %s", validation.ErrInvalidValue, "gracePeriod cannot be negative")
+		}
+	}
+	return nil
+}
+
+func (r *ClusterCommandRequest) Validate() error {
+	if r.Cluster == nil {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "cluster")
+	}
+	if err := validation.Validate(r.Cluster); err != nil {
+		return err
+	}
+	if _, ok := controlv1.CommandType_name[int32(r.Type)]; !ok || r.Type == controlv1.CommandType_Unknown {
+		return fmt.Errorf("%w: %s", validation.ErrInvalidValue, "unknown command type")
+	}
 	return nil
 }
 
@@ -70,3 +185,36 @@ func (r *UpdateConfigRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *CapabilityStatusRequest) Validate() error {
+	if r.Cluster == nil {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "cluster")
+	}
+	if err := validation.Validate(r.Cluster); err != nil {
+		return err
+	}
+	if r.Name == "" {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "name")
+	}
+	return nil
+}
+
+func (r *UninstallCapabilityRequest) Validate() error {
+	if r.Cluster == nil {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "cluster")
+	}
+	if err := validation.Validate(r.Cluster); err != nil {
+		return err
+	}
+	if r.Name == "" {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "name")
+	}
+	return nil
+}
+
+func (r *RestoreRequest) Validate() error {
+	if len(r.GetChunk()) == 0 {
+		return fmt.Errorf("%w: %s", validation.ErrMissingRequiredField, "archive")
+	}
+	return nil
+}
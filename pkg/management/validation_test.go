@@ -1,9 +1,12 @@
 package management_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/management"
@@ -43,6 +46,25 @@ var _ = Describe("Validation", func() {
 				},
 			},
 		}, validation.ErrInvalidID),
+		Entry(nil, &management.CreateBootstrapTokenRequest{
+			Ttl:       durationpb.New(1),
+			MaxUsages: -1,
+		}, validation.ErrInvalidValue),
+		Entry(nil, &management.CreateBootstrapTokenRequest{
+			Ttl:       durationpb.New(1),
+			MaxUsages: 2,
+			OneTime:   true,
+		}, validation.ErrInvalidValue),
+		Entry(nil, &management.CreateBootstrapTokenRequest{
+			Ttl:          durationpb.New(1),
+			AllowedCIDRs: []string{"10.0.0.1"},
+		}, validation.ErrInvalidValue),
+		Entry(nil, &management.CreateBootstrapTokenRequest{
+			Ttl:          durationpb.New(1),
+			MaxUsages:    1,
+			AllowedCIDRs: []string{"10.0.0.0/8", "fd00::/8"},
+			OneTime:      true,
+		}, nil),
 	)
 	DescribeTable("ListClustersRequest",
 		validateEntry[*management.ListClustersRequest],
@@ -70,6 +92,101 @@ var _ = Describe("Validation", func() {
 			},
 			MatchOptions: core.MatchOptions_EmptySelectorMatchesNone,
 		}, nil),
+		Entry(nil, &management.ListClustersRequest{
+			PageSize:     10,
+			PageToken:    "Zm9v",
+			SortOrder:    core.SortOrder_Descending,
+			Capabilities: []string{"foo"},
+		}, nil),
+		Entry(nil, &management.ListClustersRequest{PageSize: -1}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListClustersRequest{SortOrder: 1234}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListClustersRequest{Capabilities: []string{""}}, validation.ErrInvalidValue),
+	)
+	DescribeTable("ListBootstrapTokensRequest",
+		validateEntry[*management.ListBootstrapTokensRequest],
+		Entry(nil, &management.ListBootstrapTokensRequest{}, nil),
+		Entry(nil, &management.ListBootstrapTokensRequest{
+			PageSize:     10,
+			SortOrder:    core.SortOrder_Descending,
+			Capabilities: []string{"foo"},
+		}, nil),
+		Entry(nil, &management.ListBootstrapTokensRequest{PageSize: -1}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListBootstrapTokensRequest{Capabilities: []string{""}}, validation.ErrInvalidValue),
+	)
+	DescribeTable("ListRolesRequest",
+		validateEntry[*management.ListRolesRequest],
+		Entry(nil, &management.ListRolesRequest{}, nil),
+		Entry(nil, &management.ListRolesRequest{PageSize: -1}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListRolesRequest{SortOrder: 1234}, validation.ErrInvalidValue),
+	)
+	DescribeTable("ListRoleBindingsRequest",
+		validateEntry[*management.ListRoleBindingsRequest],
+		Entry(nil, &management.ListRoleBindingsRequest{}, nil),
+		Entry(nil, &management.ListRoleBindingsRequest{PageSize: -1}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListRoleBindingsRequest{SortOrder: 1234}, validation.ErrInvalidValue),
+	)
+	DescribeTable("ListAuditEventsRequest",
+		validateEntry[*management.ListAuditEventsRequest],
+		Entry(nil, &management.ListAuditEventsRequest{}, nil),
+		Entry(nil, &management.ListAuditEventsRequest{
+			PageSize:  10,
+			SortOrder: core.SortOrder_Descending,
+			Since:     timestamppb.Now(),
+			Until:     timestamppb.Now(),
+		}, nil),
+		Entry(nil, &management.ListAuditEventsRequest{PageSize: -1}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListAuditEventsRequest{SortOrder: 1234}, validation.ErrInvalidValue),
+		Entry(nil, &management.ListAuditEventsRequest{
+			Since: &timestamppb.Timestamp{Nanos: -1},
+		}, validation.ErrInvalidValue),
+	)
+	DescribeTable("CapabilityStatusRequest",
+		validateEntry[*management.CapabilityStatusRequest],
+		Entry(nil, &management.CapabilityStatusRequest{
+			Name:    "foo",
+			Cluster: &core.Reference{Id: "foo"},
+		}, nil),
+		Entry(nil, &management.CapabilityStatusRequest{Name: "foo"}, validation.ErrMissingRequiredField),
+		Entry(nil, &management.CapabilityStatusRequest{
+			Name:    "foo",
+			Cluster: &core.Reference{Id: "\\"},
+		}, validation.ErrInvalidID),
+		Entry(nil, &management.CapabilityStatusRequest{
+			Cluster: &core.Reference{Id: "foo"},
+		}, validation.ErrMissingRequiredField),
+	)
+	DescribeTable("UninstallCapabilityRequest",
+		validateEntry[*management.UninstallCapabilityRequest],
+		Entry(nil, &management.UninstallCapabilityRequest{
+			Name:    "foo",
+			Cluster: &core.Reference{Id: "foo"},
+		}, nil),
+		Entry(nil, &management.UninstallCapabilityRequest{Name: "foo"}, validation.ErrMissingRequiredField),
+		Entry(nil, &management.UninstallCapabilityRequest{
+			Name:    "foo",
+			Cluster: &core.Reference{Id: "\\"},
+		}, validation.ErrInvalidID),
+		Entry(nil, &management.UninstallCapabilityRequest{
+			Cluster: &core.Reference{Id: "foo"},
+		}, validation.ErrMissingRequiredField),
+	)
+	DescribeTable("RotateClusterKeysRequest",
+		validateEntry[*management.RotateClusterKeysRequest],
+		Entry(nil, &management.RotateClusterKeysRequest{
+			Cluster: &core.Reference{Id: "foo"},
+		}, nil),
+		Entry(nil, &management.RotateClusterKeysRequest{
+			Cluster:     &core.Reference{Id: "foo"},
+			GracePeriod: durationpb.New(time.Minute),
+		}, nil),
+		Entry(nil, &management.RotateClusterKeysRequest{}, validation.ErrMissingRequiredField),
+		Entry(nil, &management.RotateClusterKeysRequest{
+			Cluster: &core.Reference{Id: "\\"},
+		}, validation.ErrInvalidID),
+		Entry(nil, &management.RotateClusterKeysRequest{
+			Cluster:     &core.Reference{Id: "foo"},
+			GracePeriod: durationpb.New(-time.Minute),
+		}, validation.ErrInvalidValue),
 	)
 	DescribeTable("EditClusterRequest",
 		validateEntry[*management.EditClusterRequest],
@@ -95,6 +212,31 @@ var _ = Describe("Validation", func() {
 				"foo": "bar",
 			},
 		}, nil),
+		Entry(nil, &management.EditClusterRequest{
+			Cluster: &core.Reference{
+				Id: "foo",
+			},
+			RemoveLabels: []string{"\\"},
+		}, validation.ErrInvalidLabelName),
+		Entry(nil, &management.EditClusterRequest{
+			Cluster: &core.Reference{
+				Id: "foo",
+			},
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+			RemoveLabels: []string{"foo"},
+		}, validation.ErrInvalidValue),
+		Entry(nil, &management.EditClusterRequest{
+			Cluster: &core.Reference{
+				Id: "foo",
+			},
+			Labels: map[string]string{
+				"foo": "bar",
+			},
+			RemoveLabels:    []string{"bar"},
+			ResourceVersion: "1",
+		}, nil),
 	)
 	DescribeTable("WatchClustersRequest",
 		validateEntry[*management.WatchClustersRequest],
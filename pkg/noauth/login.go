@@ -5,11 +5,11 @@ import (
 	"net/http"
 	"sort"
 
-	"google.golang.org/protobuf/types/known/emptypb"
+	"github.com/rancher/opni-monitoring/pkg/management"
 )
 
 func (s *Server) renderLoginPage(w http.ResponseWriter, r *http.Request) {
-	rbs, err := s.mgmtApiClient.ListRoleBindings(r.Context(), &emptypb.Empty{})
+	rbs, err := s.mgmtApiClient.ListRoleBindings(r.Context(), &management.ListRoleBindingsRequest{})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -2,6 +2,8 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/araddon/dateparse"
@@ -16,10 +18,12 @@ import (
 func BuildAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "admin",
-		Short: "Cortex admin commands",
+		Short: "Administrative commands",
 	}
 	cmd.AddCommand(BuildQueryCmd())
 	cmd.AddCommand(BuildQueryRangeCmd())
+	cmd.AddCommand(BuildBackupCmd())
+	cmd.AddCommand(BuildRestoreCmd())
 	ConfigureManagementCommand(cmd)
 	return cmd
 }
@@ -105,3 +109,85 @@ func parseTimeOrDie(timeStr string) time.Time {
 		return t.Time
 	}
 }
+
+func BuildBackupCmd() *cobra.Command {
+	var output, passphrase, passphraseFile string
+	cmd := &cobra.Command{
+		Use:   "backup -o <file>",
+		Args:  cobra.NoArgs,
+		Short: "Back up tokens, clusters, keyrings, RBAC objects, and plugin data",
+		Run: func(cmd *cobra.Command, args []string) {
+			stream, err := client.Backup(cmd.Context(), &management.BackupRequest{
+				Passphrase: readPassphraseOrDie(passphrase, passphraseFile),
+			})
+			if err != nil {
+				lg.Fatal(err)
+			}
+			f, err := os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+			if err != nil {
+				lg.Fatal(err)
+			}
+			err = management.ReceiveBackupArchive(stream, f)
+			if cerr := f.Close(); err == nil {
+				err = cerr
+			}
+			if err != nil {
+				os.Remove(output)
+				lg.Fatal(err)
+			}
+			lg.Infof("wrote backup to %s", output)
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "", "File to write the backup archive to")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Encrypt the backup archive using this passphrase")
+	cmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "Encrypt the backup archive using a passphrase read from this file")
+	cmd.MarkFlagRequired("output")
+	return cmd
+}
+
+func BuildRestoreCmd() *cobra.Command {
+	var input, passphrase, passphraseFile string
+	cmd := &cobra.Command{
+		Use:   "restore -f <file>",
+		Args:  cobra.NoArgs,
+		Short: "Restore a backup archive created with 'opnim admin backup'",
+		Long: "Restore a backup archive created with 'opnim admin backup'. Objects " +
+			"in the archive will replace existing objects with the same IDs. The " +
+			"archive can be restored into any type of storage backend.",
+		Run: func(cmd *cobra.Command, args []string) {
+			f, err := os.Open(input)
+			if err != nil {
+				lg.Fatal(err)
+			}
+			defer f.Close()
+			stream, err := client.Restore(cmd.Context())
+			if err != nil {
+				lg.Fatal(err)
+			}
+			err = management.SendRestoreArchive(stream, f, readPassphraseOrDie(passphrase, passphraseFile))
+			if err != nil {
+				lg.Fatal(err)
+			}
+			lg.Infof("restored backup from %s", input)
+		},
+	}
+	cmd.Flags().StringVarP(&input, "file", "f", "", "Backup archive to restore")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Passphrase used to decrypt the backup archive")
+	cmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "Read the passphrase used to decrypt the backup archive from this file")
+	cmd.MarkFlagRequired("file")
+	return cmd
+}
+
+func readPassphraseOrDie(passphrase, passphraseFile string) string {
+	if passphraseFile == "" {
+		return passphrase
+	}
+	if passphrase != "" {
+		lg.Fatal("--passphrase and --passphrase-file are mutually exclusive")
+	}
+	data, err := os.ReadFile(passphraseFile)
+	if err != nil {
+		lg.Fatal(err)
+	}
+	return strings.TrimRight(string(data), "\r\n")
+}
@@ -78,12 +78,19 @@ agent remote-write requests to add dynamic authentication.`,
 						).Error("failed to parse pin")
 					}
 				}
-				bootstrapper = &bootstrap.ClientConfig{
-					Capability: wellknown.CapabilityMetrics,
-					Token:      token,
-					Pins:       publicKeyPins,
-					Endpoint:   agentConfig.Spec.GatewayAddress,
+				clientConfig := &bootstrap.ClientConfig{
+					Capability:   wellknown.CapabilityMetrics,
+					Token:        token,
+					Pins:         publicKeyPins,
+					Endpoint:     agentConfig.Spec.GatewayAddress,
+					GRPCEndpoint: agentConfig.Spec.GatewayGRPCAddress,
 				}
+				if agentConfig.Spec.IdentityProvider == "host" {
+					// not running in a kubernetes cluster, so the token is stored in
+					// the local config file instead of the agent-config secret
+					clientConfig.ConfigFile = configLocation
+				}
+				bootstrapper = clientConfig
 			}
 
 			p, err := agent.New(cmd.Context(), agentConfig, agent.WithBootstrapper(bootstrapper))
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/management"
+	cliutil "github.com/rancher/opni-monitoring/pkg/opnim/util"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func BuildAuditCmd() *cobra.Command {
+	var since string
+	var limit int32
+	var pageToken string
+	var oldestFirst bool
+	auditCmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List audit events",
+		Long: `List audit events recorded for management API operations and cluster
+bootstrap requests. The most recent events are listed first by default.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			req := &management.ListAuditEventsRequest{
+				PageSize:  limit,
+				PageToken: pageToken,
+				SortOrder: core.SortOrder_Descending,
+			}
+			if oldestFirst {
+				req.SortOrder = core.SortOrder_Ascending
+			}
+			if since != "" {
+				duration, err := time.ParseDuration(since)
+				if err != nil {
+					lg.Fatal(err)
+				}
+				req.Since = timestamppb.New(time.Now().Add(-duration))
+			}
+			list, err := client.ListAuditEvents(cmd.Context(), req)
+			if err != nil {
+				lg.Fatal(err)
+			}
+			fmt.Println(cliutil.RenderAuditEventList(list))
+			if list.NextPageToken != "" {
+				fmt.Printf("More events are available. Use --page-token=%s to list them.\n", list.NextPageToken)
+			}
+		},
+	}
+	auditCmd.Flags().StringVar(&since, "since", "", "Only list events recorded within this duration (e.g. 24h)")
+	auditCmd.Flags().Int32Var(&limit, "limit", 50, "Maximum number of events to list (0 for no limit)")
+	auditCmd.Flags().StringVar(&pageToken, "page-token", "", "Page token from a previous listing")
+	auditCmd.Flags().BoolVar(&oldestFirst, "oldest-first", false, "List the oldest events first")
+	ConfigureManagementCommand(auditCmd)
+	return auditCmd
+}
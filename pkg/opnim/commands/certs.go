@@ -28,6 +28,10 @@ func BuildCertsInfoCmd() *cobra.Command {
 				lg.Fatal(err)
 			}
 			fmt.Println(cliutil.RenderCertInfoChain(t.Chain))
+			if len(t.PendingChain) > 0 {
+				fmt.Println("Pending certificate chain:")
+				fmt.Println(cliutil.RenderCertInfoChain(t.PendingChain))
+			}
 		},
 	}
 }
@@ -3,13 +3,20 @@ package commands
 import (
 	"fmt"
 	"reflect"
+	"sort"
+	"time"
 
+	controlv1 "github.com/rancher/opni-monitoring/pkg/apis/control/v1"
 	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	cliutil "github.com/rancher/opni-monitoring/pkg/opnim/util"
 	"github.com/rancher/opni-monitoring/plugins/cortex/pkg/apis/cortexadmin"
 	"github.com/spf13/cobra"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -20,8 +27,14 @@ func BuildClustersCmd() *cobra.Command {
 		Short:   "Manage clusters",
 	}
 	clustersCmd.AddCommand(BuildClustersListCmd())
+	clustersCmd.AddCommand(BuildClustersShowCmd())
 	clustersCmd.AddCommand(BuildClustersDeleteCmd())
 	clustersCmd.AddCommand(BuildClustersLabelCmd())
+	clustersCmd.AddCommand(BuildClustersUninstallCapabilityCmd())
+	clustersCmd.AddCommand(BuildClustersRotateKeysCmd())
+	clustersCmd.AddCommand(BuildClustersApproveCmd())
+	clustersCmd.AddCommand(BuildClustersRejectCmd())
+	clustersCmd.AddCommand(BuildClustersCommandCmd())
 	ConfigureManagementCommand(clustersCmd)
 	return clustersCmd
 }
@@ -51,6 +64,38 @@ func BuildClustersListCmd() *cobra.Command {
 	return cmd
 }
 
+func BuildClustersShowCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <cluster-id>",
+		Short: "Show details of a cluster and the status of its capabilities",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			ref := &core.Reference{
+				Id: args[0],
+			}
+			cluster, err := client.GetCluster(cmd.Context(), ref)
+			if err != nil {
+				lg.Fatal(err)
+			}
+			statuses := map[string]*core.CapabilityStatus{}
+			for _, c := range cluster.GetCapabilities() {
+				capStatus, err := client.GetCapabilityStatus(cmd.Context(), &management.CapabilityStatusRequest{
+					Name:    c.Name,
+					Cluster: ref,
+				})
+				if err != nil {
+					if status.Code(err) != codes.NotFound {
+						lg.Fatal(err)
+					}
+					continue
+				}
+				statuses[c.Name] = capStatus
+			}
+			fmt.Println(cliutil.RenderCluster(cluster, statuses))
+		},
+	}
+}
+
 func BuildClustersDeleteCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:     "delete <cluster-id> [<cluster-id>...]",
@@ -59,7 +104,7 @@ func BuildClustersDeleteCmd() *cobra.Command {
 		Args:    cobra.MinimumNArgs(1),
 		Run: func(cmd *cobra.Command, args []string) {
 			for _, cluster := range args {
-				_, err := client.DeleteCluster(cmd.Context(),
+				stream, err := client.DeleteCluster(cmd.Context(),
 					&core.Reference{
 						Id: cluster,
 					},
@@ -67,6 +112,26 @@ func BuildClustersDeleteCmd() *cobra.Command {
 				if err != nil {
 					lg.Fatal(err)
 				}
+				err = management.WaitForClusterDeletion(stream, func(p *management.DeleteClusterProgress) {
+					lg := lg.With(
+						"id", cluster,
+						"step", p.Step.String(),
+					)
+					if p.Capability != "" {
+						lg = lg.With("capability", p.Capability)
+					}
+					switch p.State {
+					case management.DeleteClusterStepState_StepCompleted:
+						lg.Info("Step completed")
+					case management.DeleteClusterStepState_StepSkipped:
+						lg.With("reason", p.Message).Warn("Step skipped")
+					case management.DeleteClusterStepState_StepFailed:
+						lg.With("error", p.Message).Error("Step failed")
+					}
+				})
+				if err != nil {
+					lg.Fatal(err)
+				}
 				lg.With(
 					"id", cluster,
 				).Info("Deleted cluster")
@@ -75,6 +140,158 @@ func BuildClustersDeleteCmd() *cobra.Command {
 	}
 }
 
+func BuildClustersUninstallCapabilityCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "uninstall-capability <cluster-id> <capability>",
+		Short: "Uninstall a capability from a cluster",
+		Long: "Uninstall a capability from a cluster, removing any data the capability " +
+			"stores for the cluster. The cluster remains registered, and its agent can " +
+			"still connect to install capabilities again. Use 'opnim clusters delete' " +
+			"to remove the cluster entirely.",
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			_, err := client.UninstallCapability(cmd.Context(), &management.UninstallCapabilityRequest{
+				Name: args[1],
+				Cluster: &core.Reference{
+					Id: args[0],
+				},
+			})
+			if err != nil {
+				lg.Fatal(err)
+			}
+			lg.With(
+				"id", args[0],
+				"capability", args[1],
+			).Info("Uninstalled capability")
+		},
+	}
+}
+
+func BuildClustersRotateKeysCmd() *cobra.Command {
+	var gracePeriod time.Duration
+	cmd := &cobra.Command{
+		Use:   "rotate-keys <cluster-id> [<cluster-id>...]",
+		Short: "Force rotation of the shared keys used by cluster agents",
+		Long: "Schedule the cluster's current shared keys to expire after the grace " +
+			"period. The cluster's agent will perform a new key exchange with the " +
+			"gateway before then; if it does not, it will need to be bootstrapped again.",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, id := range args {
+				resp, err := client.RotateClusterKeys(cmd.Context(), &management.RotateClusterKeysRequest{
+					Cluster: &core.Reference{
+						Id: id,
+					},
+					GracePeriod: durationpb.New(gracePeriod),
+				})
+				if err != nil {
+					lg.Fatal(err)
+				}
+				lg.With(
+					"id", id,
+					"expires", resp.GetExpiresAt().AsTime().Local().Format(time.RFC3339),
+				).Info("Scheduled key rotation")
+			}
+		},
+	}
+	cmd.Flags().DurationVar(&gracePeriod, "grace-period", keyring.DefaultRotationGracePeriod,
+		"Length of time for which the current keys remain valid")
+	return cmd
+}
+
+func BuildClustersApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve <cluster-id> [<cluster-id>...]",
+		Short: "Approve clusters which are pending approval",
+		Long: "Approve clusters which were bootstrapped while cluster approval was " +
+			"required. The capability requested by the cluster's agent will be installed.",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, id := range args {
+				_, err := client.ApproveCluster(cmd.Context(), &core.Reference{
+					Id: id,
+				})
+				if err != nil {
+					lg.Fatal(err)
+				}
+				lg.With(
+					"id", id,
+				).Info("Cluster approved")
+			}
+		},
+	}
+	return cmd
+}
+
+func BuildClustersRejectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject <cluster-id> [<cluster-id>...]",
+		Short: "Reject clusters which are pending approval",
+		Long: "Reject clusters which were bootstrapped while cluster approval was " +
+			"required. The cluster's keyring is revoked. Rejected clusters must be " +
+			"deleted before they can be bootstrapped again.",
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, id := range args {
+				_, err := client.RejectCluster(cmd.Context(), &core.Reference{
+					Id: id,
+				})
+				if err != nil {
+					lg.Fatal(err)
+				}
+				lg.With(
+					"id", id,
+				).Info("Cluster rejected")
+			}
+		},
+	}
+	return cmd
+}
+
+var clusterCommandTypes = map[string]controlv1.CommandType{
+	"sync-rules":  controlv1.CommandType_SyncRules,
+	"diagnostics": controlv1.CommandType_Diagnostics,
+	"rotate-keys": controlv1.CommandType_RotateKeys,
+}
+
+func BuildClustersCommandCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "command <cluster-id> <sync-rules|diagnostics|rotate-keys>",
+		Short: "Send a command to a connected cluster agent",
+		Long: "Send a command to the cluster's agent over its control stream and " +
+			"print the result. The agent must be connected to the gateway's gRPC API.",
+		Args:      cobra.ExactArgs(2),
+		ValidArgs: []string{"sync-rules", "diagnostics", "rotate-keys"},
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdType, ok := clusterCommandTypes[args[1]]
+			if !ok {
+				lg.Fatalf("unknown command %q", args[1])
+			}
+			resp, err := client.SendClusterCommand(cmd.Context(), &management.ClusterCommandRequest{
+				Cluster: &core.Reference{
+					Id: args[0],
+				},
+				Type: cmdType,
+			})
+			if err != nil {
+				lg.Fatal(err)
+			}
+			keys := make([]string, 0, len(resp.GetData()))
+			for k := range resp.GetData() {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				fmt.Printf("%s: %s\n", k, resp.GetData()[k])
+			}
+			lg.With(
+				"id", args[0],
+				"command", args[1],
+			).Info("Command completed")
+		},
+	}
+}
+
 func BuildClustersLabelCmd() *cobra.Command {
 	overwrite := false
 	cmd := &cobra.Command{
@@ -93,31 +310,28 @@ func BuildClustersLabelCmd() *cobra.Command {
 			if err != nil {
 				lg.Fatal(err)
 			}
-			currentLabels := map[string]string{}
-			for k, v := range cluster.GetLabels() {
-				currentLabels[k] = v
-			}
+			setLabels := map[string]string{}
+			var removeLabels []string
 			for k, v := range labels {
 				if v == "-" {
-					delete(currentLabels, k)
+					removeLabels = append(removeLabels, k)
 					continue
 				}
-				if overwrite {
-					currentLabels[k] = v
-				} else {
-					if _, ok := currentLabels[k]; !ok {
-						currentLabels[k] = v
-					} else {
-						lg.With(
-							"key", k,
-							"curValue", v,
-						).Fatal("Label already exists (use --overwrite to enable replacing existing values)")
-					}
+				if _, ok := cluster.GetLabels()[k]; ok && !overwrite {
+					lg.With(
+						"key", k,
+						"curValue", v,
+					).Fatal("Label already exists (use --overwrite to enable replacing existing values)")
 				}
+				setLabels[k] = v
 			}
+			// Only the changed labels are sent, and the edit is rejected if the
+			// cluster was modified since it was read above.
 			updatedCluster, err := client.EditCluster(cmd.Context(), &management.EditClusterRequest{
-				Cluster: cluster.Reference(),
-				Labels:  currentLabels,
+				Cluster:         cluster.Reference(),
+				Labels:          setLabels,
+				RemoveLabels:    removeLabels,
+				ResourceVersion: cluster.GetResourceVersion(),
 			})
 			if err != nil {
 				lg.With(
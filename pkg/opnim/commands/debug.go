@@ -7,7 +7,9 @@ import (
 	"os"
 	"strings"
 
+	"github.com/rancher/opni-monitoring/pkg/backup"
 	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/machinery"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	cliutil "github.com/rancher/opni-monitoring/pkg/opnim/util"
 	"github.com/spf13/cobra"
@@ -24,6 +26,7 @@ func BuildDebugCmd() *cobra.Command {
 	debugCmd.AddCommand(BuildDebugReloadCmd())
 	debugCmd.AddCommand(BuildDebugGetConfigCmd())
 	debugCmd.AddCommand(BuildDebugEtcdctlCmd())
+	debugCmd.AddCommand(BuildDebugMigrateStorageCmd())
 	ConfigureManagementCommand(debugCmd)
 	return debugCmd
 }
@@ -133,3 +136,99 @@ func BuildDebugEtcdctlCmd() *cobra.Command {
 	}
 	return debugEtcdctlCmd
 }
+
+func BuildDebugMigrateStorageCmd() *cobra.Command {
+	var from, to, stateFile string
+	var kvNamespaces []string
+	debugMigrateStorageCmd := &cobra.Command{
+		Use:   "migrate-storage --from <config> --to <config>",
+		Short: "Copy all objects from one storage backend to another",
+		Long: `Copy all objects from the storage backend configured in one gateway config
+to the storage backend configured in another. This includes tokens, clusters,
+roles, role bindings, keyrings in the "gateway" and "agent" namespaces, the
+gateway's own key-value store namespaces, and key-value store namespaces for
+all plugins found in the source config's plugin directories.
+
+Each object is verified by comparing checksums after it is copied. Progress is
+recorded in the state file, so an interrupted migration can be resumed by
+running the same command again. Objects which have not changed since they were
+last copied will be skipped.
+
+The migration can be run while the gateway is running, but changes made after
+an object has been copied will not be reflected in the destination until the
+command is run again.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromConfig, err := loadGatewayConfig(from)
+			if err != nil {
+				return fmt.Errorf("--from: %w", err)
+			}
+			toConfig, err := loadGatewayConfig(to)
+			if err != nil {
+				return fmt.Errorf("--to: %w", err)
+			}
+			source, err := machinery.ConfigureStorageBackend(cmd.Context(), &fromConfig.Spec.Storage)
+			if err != nil {
+				return fmt.Errorf("failed to configure source storage backend: %w", err)
+			}
+			dest, err := machinery.ConfigureStorageBackend(cmd.Context(), &toConfig.Spec.Storage)
+			if err != nil {
+				return fmt.Errorf("failed to configure destination storage backend: %w", err)
+			}
+
+			plugins, err := machinery.DiscoverPlugins(fromConfig.Spec.Plugins)
+			if err != nil {
+				return fmt.Errorf("failed to discover plugins: %w", err)
+			}
+			kvNamespaces = append(kvNamespaces, machinery.KeyValueNamespaces(plugins)...)
+
+			lg.With(
+				"from", fromConfig.Spec.Storage.Type,
+				"to", toConfig.Spec.Storage.Type,
+				"kvNamespaces", kvNamespaces,
+			).Info("starting migration")
+			result, err := backup.Migrate(cmd.Context(), source, dest,
+				backup.WithBackupOptions(
+					backup.WithKeyringNamespaces(backup.DefaultMigrationKeyringNamespaces...),
+					backup.WithKeyValueNamespaces(kvNamespaces...),
+				),
+				backup.WithStateFile(stateFile),
+				backup.WithLogger(lg.Named("migrate")),
+			)
+			if result != nil {
+				lg.With(
+					"copied", result.Copied,
+					"skipped", result.Skipped,
+				).Info("migration progress")
+			}
+			if err != nil {
+				return err
+			}
+			lg.Info("migration complete")
+			return nil
+		},
+	}
+	debugMigrateStorageCmd.Flags().StringVar(&from, "from", "", "Gateway config containing the source storage backend")
+	debugMigrateStorageCmd.Flags().StringVar(&to, "to", "", "Gateway config containing the destination storage backend")
+	debugMigrateStorageCmd.Flags().StringVar(&stateFile, "state-file", "migrate-storage.state.json", "File used to record migration progress")
+	debugMigrateStorageCmd.Flags().StringSliceVar(&kvNamespaces, "kv-namespace", []string{}, "Additional key-value store namespaces to copy")
+	debugMigrateStorageCmd.MarkFlagRequired("from")
+	debugMigrateStorageCmd.MarkFlagRequired("to")
+	return debugMigrateStorageCmd
+}
+
+func loadGatewayConfig(path string) (*v1beta1.GatewayConfig, error) {
+	objects := cliutil.LoadConfigObjectsOrDie(path, lg)
+	var gatewayConfig *v1beta1.GatewayConfig
+	objects.Visit(
+		func(config *v1beta1.GatewayConfig) {
+			if gatewayConfig == nil {
+				gatewayConfig = config
+			}
+		},
+	)
+	if gatewayConfig == nil {
+		return nil, fmt.Errorf("no gateway config found in %s", path)
+	}
+	gatewayConfig.Spec.SetDefaults()
+	return gatewayConfig, nil
+}
@@ -84,9 +84,11 @@ func BuildGatewayCmd() *cobra.Command {
 
 		m := management.NewServer(ctx, &gatewayConfig.Spec.Management, g,
 			management.WithCapabilitiesDataSource(g),
+			management.WithAgentControlDataSource(g),
 			management.WithSystemPlugins(systemPlugins),
 			management.WithAPIExtensions(mgmtExtensionPlugins),
 			management.WithLifecycler(lifecycler),
+			management.WithAuditLog(g.AuditLog()),
 		)
 
 		g.MustRegisterCollector(m)
@@ -8,7 +8,6 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/management"
 	cliutil "github.com/rancher/opni-monitoring/pkg/opnim/util"
 	"github.com/spf13/cobra"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func BuildRolesCmd() *cobra.Command {
@@ -120,7 +119,7 @@ func BuildRolesListCmd() *cobra.Command {
 		Use:   "list",
 		Short: "List roles",
 		Run: func(cmd *cobra.Command, args []string) {
-			t, err := client.ListRoles(cmd.Context(), &emptypb.Empty{})
+			t, err := client.ListRoles(cmd.Context(), &management.ListRolesRequest{})
 			if err != nil {
 				lg.Fatal(err)
 			}
@@ -196,7 +195,7 @@ func BuildRoleBindingsListCmd() *cobra.Command {
 		Use:   "list",
 		Short: "List role bindings",
 		Run: func(cmd *cobra.Command, args []string) {
-			t, err := client.ListRoleBindings(cmd.Context(), &emptypb.Empty{})
+			t, err := client.ListRoleBindings(cmd.Context(), &management.ListRoleBindingsRequest{})
 			if err != nil {
 				lg.Fatal(err)
 			}
@@ -210,7 +209,7 @@ func BuildAccessMatrixCmd() *cobra.Command {
 		Use:   "access-matrix",
 		Short: "Print an access matrix showing all users and their allowed clusters",
 		Run: func(cmd *cobra.Command, args []string) {
-			rbs, err := client.ListRoleBindings(cmd.Context(), &emptypb.Empty{})
+			rbs, err := client.ListRoleBindings(cmd.Context(), &management.ListRoleBindingsRequest{})
 			if err != nil {
 				fmt.Println(err)
 				return
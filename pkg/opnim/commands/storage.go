@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/machinery"
+	"github.com/rancher/opni-monitoring/pkg/storage/encryption"
+	"github.com/spf13/cobra"
+)
+
+func BuildStorageCmd() *cobra.Command {
+	storageCmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Manage gateway storage encryption",
+	}
+	storageCmd.AddCommand(BuildStorageGenerateKEKCmd())
+	storageCmd.AddCommand(BuildStorageRotateKEKCmd())
+	return storageCmd
+}
+
+func BuildStorageGenerateKEKCmd() *cobra.Command {
+	var output string
+	generateKEKCmd := &cobra.Command{
+		Use:   "generate-kek -o <file>",
+		Short: "Generate a new key encryption key file",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			keyFile, err := encryption.NewKeyFile()
+			if err != nil {
+				return err
+			}
+			if err := encryption.WriteKeyFile(output, keyFile); err != nil {
+				return err
+			}
+			lg.With(
+				"path", output,
+				"keyID", keyFile.ActiveKeyID,
+			).Info("wrote key file")
+			return nil
+		},
+	}
+	generateKEKCmd.Flags().StringVarP(&output, "output", "o", "", "Path to write the key file to")
+	generateKEKCmd.MarkFlagRequired("output")
+	return generateKEKCmd
+}
+
+func BuildStorageRotateKEKCmd() *cobra.Command {
+	var configPath string
+	var prune bool
+	var kvNamespaces []string
+	rotateKEKCmd := &cobra.Command{
+		Use:   "rotate-kek --config <config>",
+		Short: "Rotate the key encryption key and re-encrypt all stored secrets",
+		Long: `Generate a new key encryption key, make it the active key, and re-encrypt
+all keyrings and key-value data, including the gateway's own audit log,
+capability and cluster status records, in the storage backend configured in
+the given gateway config. Previous keys are kept in the key file so that the
+gateway can continue to read existing records while the command is running.
+
+Once all records have been re-encrypted, previous keys can be removed from the
+key file using --prune.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config, err := loadGatewayConfig(configPath)
+			if err != nil {
+				return err
+			}
+			encryptionSpec := config.Spec.Storage.Encryption
+			if encryptionSpec == nil ||
+				encryptionSpec.Provider != v1beta1.EncryptionProviderLocal ||
+				encryptionSpec.Local == nil {
+				return fmt.Errorf("storage encryption is not configured with the local provider")
+			}
+			keyFilePath := encryptionSpec.Local.KeyFile
+			keyFile, err := encryption.LoadKeyFile(keyFilePath)
+			if err != nil {
+				return err
+			}
+			newKey, err := keyFile.Rotate()
+			if err != nil {
+				return err
+			}
+			if err := encryption.WriteKeyFile(keyFilePath, keyFile); err != nil {
+				return err
+			}
+			lg.With(
+				"keyID", newKey.ID,
+			).Info("rotated key encryption key")
+
+			backend, err := machinery.ConfigureStorageBackend(cmd.Context(), &config.Spec.Storage)
+			if err != nil {
+				return fmt.Errorf("failed to configure storage backend: %w", err)
+			}
+			plugins, err := machinery.DiscoverPlugins(config.Spec.Plugins)
+			if err != nil {
+				return fmt.Errorf("failed to discover plugins: %w", err)
+			}
+			kvNamespaces = append(kvNamespaces, machinery.KeyValueNamespaces(plugins)...)
+			count, err := encryption.Reencrypt(cmd.Context(), backend,
+				[]string{"gateway", "agent"}, kvNamespaces)
+			if err != nil {
+				return fmt.Errorf("re-encrypted %d records before encountering an error: %w", count, err)
+			}
+			lg.With(
+				"records", count,
+			).Info("re-encrypted all records")
+
+			if prune {
+				removed := keyFile.Prune()
+				if err := encryption.WriteKeyFile(keyFilePath, keyFile); err != nil {
+					return err
+				}
+				lg.With(
+					"keyIDs", removed,
+				).Info("removed previous keys")
+			}
+			return nil
+		},
+	}
+	rotateKEKCmd.Flags().StringVar(&configPath, "config", "", "Gateway config containing the storage backend and encryption settings")
+	rotateKEKCmd.Flags().BoolVar(&prune, "prune", false, "Remove previous keys from the key file after all records have been re-encrypted")
+	rotateKEKCmd.Flags().StringSliceVar(&kvNamespaces, "kv-namespace", []string{}, "Additional key-value store namespaces to re-encrypt")
+	rotateKEKCmd.MarkFlagRequired("config")
+	return rotateKEKCmd
+}
@@ -9,7 +9,6 @@ import (
 	cliutil "github.com/rancher/opni-monitoring/pkg/opnim/util"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/durationpb"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func BuildTokensCmd() *cobra.Command {
@@ -28,6 +27,9 @@ func BuildTokensCmd() *cobra.Command {
 func BuildTokensCreateCmd() *cobra.Command {
 	var ttl string
 	var labels []string
+	var maxUsages int64
+	var allowedCIDRs []string
+	var oneTime bool
 	tokensCreateCmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a bootstrap token",
@@ -42,8 +44,11 @@ func BuildTokensCreateCmd() *cobra.Command {
 			}
 			t, err := client.CreateBootstrapToken(cmd.Context(),
 				&management.CreateBootstrapTokenRequest{
-					Ttl:    durationpb.New(duration),
-					Labels: labelMap,
+					Ttl:          durationpb.New(duration),
+					Labels:       labelMap,
+					MaxUsages:    maxUsages,
+					AllowedCIDRs: allowedCIDRs,
+					OneTime:      oneTime,
 				})
 			if err != nil {
 				lg.Fatal(err)
@@ -53,6 +58,9 @@ func BuildTokensCreateCmd() *cobra.Command {
 	}
 	tokensCreateCmd.Flags().StringVar(&ttl, "ttl", "300s", "Time to live")
 	tokensCreateCmd.Flags().StringSliceVar(&labels, "labels", []string{}, "Labels which will be auto-applied to any clusters created with this token")
+	tokensCreateCmd.Flags().Int64Var(&maxUsages, "max-usages", 0, "Maximum number of times the token can be used (0 for unlimited)")
+	tokensCreateCmd.Flags().StringSliceVar(&allowedCIDRs, "allowed-cidrs", []string{}, "CIDR ranges from which the token can be used (default: any address)")
+	tokensCreateCmd.Flags().BoolVar(&oneTime, "one-time", false, "Delete the token after it has been used successfully")
 	return tokensCreateCmd
 }
 
@@ -81,7 +89,7 @@ func BuildTokensListCmd() *cobra.Command {
 		Use:   "list",
 		Short: "List bootstrap tokens",
 		Run: func(cmd *cobra.Command, args []string) {
-			t, err := client.ListBootstrapTokens(cmd.Context(), &emptypb.Empty{})
+			t, err := client.ListBootstrapTokens(cmd.Context(), &management.ListBootstrapTokensRequest{})
 			if err != nil {
 				lg.Fatal(err)
 			}
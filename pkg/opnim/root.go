@@ -15,6 +15,7 @@ func BuildRootCmd() *cobra.Command {
 	rootCmd.AddCommand(commands.BuildAccessMatrixCmd())
 	rootCmd.AddCommand(commands.BuildAdminCmd())
 	rootCmd.AddCommand(commands.BuildAgentCmd())
+	rootCmd.AddCommand(commands.BuildAuditCmd())
 	rootCmd.AddCommand(commands.BuildBootstrapCmd())
 	rootCmd.AddCommand(commands.BuildCertsCmd())
 	rootCmd.AddCommand(commands.BuildClustersCmd())
@@ -22,6 +23,7 @@ func BuildRootCmd() *cobra.Command {
 	rootCmd.AddCommand(commands.BuildGatewayCmd())
 	rootCmd.AddCommand(commands.BuildRoleBindingsCmd())
 	rootCmd.AddCommand(commands.BuildRolesCmd())
+	rootCmd.AddCommand(commands.BuildStorageCmd())
 	rootCmd.AddCommand(commands.BuildTokensCmd())
 	rootCmd.AddCommand(commands.BuildVersionCmd())
 	return rootCmd
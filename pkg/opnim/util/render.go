@@ -23,20 +23,41 @@ func RenderBootstrapToken(token *core.BootstrapToken) string {
 func RenderBootstrapTokenList(list *core.BootstrapTokenList) string {
 	w := table.NewWriter()
 	w.SetStyle(table.StyleColoredDark)
-	w.AppendHeader(table.Row{"ID", "TOKEN", "TTL", "USAGES", "LABELS"})
+	anyTokensHaveCIDRs := false
+	for _, t := range list.Items {
+		if len(t.GetMetadata().GetLimits().GetAllowedCIDRs()) > 0 {
+			anyTokensHaveCIDRs = true
+		}
+	}
+	header := table.Row{"ID", "TOKEN", "TTL", "USAGES", "LABELS"}
+	if anyTokensHaveCIDRs {
+		header = append(header, "ALLOWED CIDRS")
+	}
+	w.AppendHeader(header)
 	for _, t := range list.Items {
 		token, err := tokens.FromBootstrapToken(t)
 		if err != nil {
 			return err.Error()
 		}
 
-		w.AppendRow(table.Row{
+		usages := fmt.Sprint(t.GetMetadata().GetUsageCount())
+		limits := t.GetMetadata().GetLimits()
+		if limits.GetOneTime() {
+			usages += " (one-time)"
+		} else if max := limits.GetMaxUsages(); max > 0 {
+			usages += fmt.Sprintf("/%d", max)
+		}
+		row := table.Row{
 			token.HexID(),
 			token.EncodeHex(),
 			(time.Duration(t.GetMetadata().GetTtl()) * time.Second).String(),
-			t.GetMetadata().GetUsageCount(),
+			usages,
 			strings.Join(JoinKeyValuePairs(t.GetMetadata().GetLabels()), "\n"),
-		})
+		}
+		if anyTokensHaveCIDRs {
+			row = append(row, strings.Join(limits.GetAllowedCIDRs(), "\n"))
+		}
+		w.AppendRow(row)
 	}
 	return w.Render()
 }
@@ -74,17 +95,29 @@ func RenderCertInfoChain(chain []*core.CertInfo) string {
 func RenderClusterList(list *core.ClusterList, stats *cortexadmin.UserIDStatsList) string {
 	w := table.NewWriter()
 	w.SetStyle(table.StyleColoredDark)
-	if stats == nil {
-		w.AppendHeader(table.Row{"ID", "LABELS"})
-	} else {
-		w.AppendHeader(table.Row{"ID", "LABELS", "NUM SERIES", "SAMPLE RATE", "RULE RATE"})
+	anyClustersNotApproved := false
+	for _, t := range list.Items {
+		if t.GetMetadata().GetApproval().GetState() != core.ApprovalState_ClusterApproved {
+			anyClustersNotApproved = true
+		}
+	}
+	header := table.Row{"ID", "LABELS", "HEALTH"}
+	if anyClustersNotApproved {
+		header = append(header, "APPROVAL")
 	}
+	if stats != nil {
+		header = append(header, "NUM SERIES", "SAMPLE RATE", "RULE RATE")
+	}
+	w.AppendHeader(header)
 	for _, t := range list.Items {
 		labels := []string{}
 		for k, v := range t.GetMetadata().GetLabels() {
 			labels = append(labels, fmt.Sprintf("%s=%s", k, v))
 		}
-		row := table.Row{t.GetId(), strings.Join(labels, ",")}
+		row := table.Row{t.GetId(), strings.Join(labels, ","), renderHealth(t.GetStatus())}
+		if anyClustersNotApproved {
+			row = append(row, renderApproval(t.GetMetadata().GetApproval()))
+		}
 		if stats != nil {
 			for _, s := range stats.Items {
 				if string(s.UserID) == t.GetId() {
@@ -102,6 +135,140 @@ func RenderClusterList(list *core.ClusterList, stats *cortexadmin.UserIDStatsLis
 	return w.Render()
 }
 
+// RenderCluster renders details of a single cluster, and the install status
+// of each of its capabilities. Capabilities with no known status are shown
+// as "Unknown".
+func RenderCluster(cluster *core.Cluster, statuses map[string]*core.CapabilityStatus) string {
+	buf := new(bytes.Buffer)
+	w := table.NewWriter()
+	w.SetIndexColumn(1)
+	w.SetStyle(table.StyleColoredDark)
+	w.SetColumnConfigs([]table.ColumnConfig{
+		{
+			Number: 1,
+			Align:  text.AlignRight,
+		},
+		{
+			Number: 2,
+		},
+	})
+	w.AppendRow(table.Row{"ID", cluster.GetId()})
+	w.AppendRow(table.Row{"LABELS", strings.Join(JoinKeyValuePairs(cluster.GetLabels()), "\n")})
+	if ts := cluster.GetMetadata().GetCreationTimestamp(); ts != nil {
+		w.AppendRow(table.Row{"CREATED", ts.AsTime().Local().Format(time.RFC3339)})
+	}
+	if approval := cluster.GetMetadata().GetApproval(); approval != nil {
+		w.AppendRow(table.Row{"APPROVAL", renderApproval(approval)})
+		if approval.GetState() == core.ApprovalState_ClusterPendingApproval {
+			w.AppendRow(table.Row{"REQUESTED CAPABILITY", approval.GetRequestedCapability()})
+		}
+		w.AppendRow(table.Row{"TOKEN", approval.GetTokenID()})
+		w.AppendRow(table.Row{"ADDRESS", approval.GetAddress()})
+		if ts := approval.GetRequestTimestamp(); ts != nil {
+			w.AppendRow(table.Row{"REQUESTED", ts.AsTime().Local().Format(time.RFC3339)})
+		}
+	}
+	w.AppendRow(table.Row{"HEALTH", renderHealth(cluster.GetStatus())})
+	if status := cluster.GetStatus(); status != nil {
+		w.AppendRow(table.Row{"LAST SEEN", status.GetLastSeen().AsTime().Local().Format(time.RFC3339)})
+		w.AppendRow(table.Row{"AGENT VERSION", status.GetAgentVersion()})
+		w.AppendRow(table.Row{"AGENT UPTIME", status.GetUptime().AsDuration().Round(time.Second).String()})
+		if ts := status.GetLastPushTimestamp(); ts != nil {
+			w.AppendRow(table.Row{"LAST PUSH", ts.AsTime().Local().Format(time.RFC3339)})
+		}
+		if ruleSync := status.GetRuleSync(); ruleSync.GetEnabled() {
+			w.AppendRow(table.Row{"RULE SYNC", renderRuleSync(ruleSync)})
+		}
+	}
+	buf.WriteString(w.Render())
+
+	if len(cluster.GetCapabilities()) == 0 {
+		return buf.String()
+	}
+	buf.WriteString("\n")
+	w = table.NewWriter()
+	w.SetStyle(table.StyleColoredDark)
+	w.AppendHeader(table.Row{"CAPABILITY", "STATUS", "ATTEMPTS", "LAST MODIFIED", "ERROR"})
+	for _, c := range cluster.GetCapabilities() {
+		status, ok := statuses[c.Name]
+		if !ok {
+			w.AppendRow(table.Row{c.Name, "Unknown", "", "", ""})
+			continue
+		}
+		w.AppendRow(table.Row{
+			c.Name,
+			strings.TrimPrefix(status.GetState().String(), "Task"),
+			status.GetAttempts(),
+			status.GetLastModified().AsTime().Local().Format(time.RFC3339),
+			status.GetLastError(),
+		})
+	}
+	buf.WriteString(w.Render())
+	return buf.String()
+}
+
+func renderHealth(status *core.ClusterStatus) string {
+	switch status.GetHealth() {
+	case core.ClusterHealth_ClusterHealthy:
+		return chalk.Green.Color("Healthy")
+	case core.ClusterHealth_ClusterDegraded:
+		return chalk.Yellow.Color("Degraded")
+	case core.ClusterHealth_ClusterDisconnected:
+		return chalk.Red.Color(fmt.Sprintf("Disconnected (%s ago)",
+			time.Since(status.GetLastSeen().AsTime()).Round(time.Second)))
+	default:
+		return "Unknown"
+	}
+}
+
+func renderRuleSync(ruleSync *core.RuleSyncStatus) string {
+	if ruleSync.GetLastError() != "" {
+		return chalk.Yellow.Color("Failed: " + ruleSync.GetLastError())
+	}
+	if ts := ruleSync.GetLastSyncTimestamp(); ts != nil {
+		return fmt.Sprintf("%d groups (%s)", ruleSync.GetGroups(), ts.AsTime().Local().Format(time.RFC3339))
+	}
+	return "Pending"
+}
+
+func renderApproval(approval *core.ClusterApproval) string {
+	switch approval.GetState() {
+	case core.ApprovalState_ClusterPendingApproval:
+		return chalk.Yellow.Color(fmt.Sprintf("Pending (%s)", approval.GetRequestedCapability()))
+	case core.ApprovalState_ClusterRejected:
+		return chalk.Red.Color("Rejected")
+	default:
+		return "Approved"
+	}
+}
+
+func RenderAuditEventList(list *core.AuditEventList) string {
+	w := table.NewWriter()
+	w.SetStyle(table.StyleColoredDark)
+	w.AppendHeader(table.Row{"TIME", "OPERATION", "CALLER", "RESULT", "REQUEST"})
+	for _, e := range list.Items {
+		caller := e.GetCaller().GetAddress()
+		if fwd := e.GetCaller().GetForwardedFor(); fwd != "" {
+			caller = fwd
+		}
+		if id := e.GetCaller().GetTokenID(); id != "" {
+			caller += fmt.Sprintf(" (token %s)", id)
+		}
+		result := e.GetResult().GetCode()
+		if msg := e.GetResult().GetMessage(); msg != "" {
+			result += ": " + msg
+		}
+		w.AppendRow(table.Row{
+			e.GetTimestamp().AsTime().Local().Format(time.RFC3339),
+			strings.TrimPrefix(e.GetOperation(), "/management.Management/"),
+			caller,
+			result,
+			e.GetRequest(),
+		})
+	}
+	return w.Render()
+}
+
 func RenderRole(role *core.Role) string {
 	return RenderRoleList(&core.RoleList{
 		Items: []*core.Role{role},
@@ -2,6 +2,7 @@ package pkp
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 )
@@ -55,3 +56,67 @@ func TLSConfig(pins []*PublicKeyPin) (*tls.Config, error) {
 		},
 	}, nil
 }
+
+// Prefix of the application protocol names used by clients to advertise
+// their pinned keys during the TLS handshake.
+const alpnPinPrefix = "pkp/"
+
+// ALPNProtocols returns application protocol names advertising the given
+// pins, which can be added to a client's tls.Config NextProtos to allow a
+// server which has more than one certificate to serve one which the client
+// will accept (see CertificateSelector).
+func ALPNProtocols(pins []*PublicKeyPin) []string {
+	protos := make([]string, 0, len(pins))
+	for _, pin := range pins {
+		protos = append(protos, alpnPinPrefix+pin.Encode())
+	}
+	return protos
+}
+
+// ChainPins returns pins for every certificate in the chain, using each of
+// the supported algorithms.
+func ChainPins(cert *tls.Certificate) ([]*PublicKeyPin, error) {
+	pins := []*PublicKeyPin{}
+	for _, der := range cert.Certificate {
+		c, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		pins = append(pins, NewSha256(c), NewBlake2b256(c))
+	}
+	return pins, nil
+}
+
+// CertificateSelector returns a GetConfigForClient callback for a server
+// config containing more than one certificate, ordered from oldest to newest.
+// Clients which advertise their pins using ALPNProtocols are served the
+// newest certificate with a pinned key in its chain. Other clients, and
+// clients which do not pin any of the certificates, are served the first
+// certificate.
+func CertificateSelector(config *tls.Config) (func(*tls.ClientHelloInfo) (*tls.Config, error), error) {
+	pinsByCert := make([]map[string]struct{}, len(config.Certificates))
+	configs := make([]*tls.Config, len(config.Certificates))
+	for i := range config.Certificates {
+		pins, err := ChainPins(&config.Certificates[i])
+		if err != nil {
+			return nil, err
+		}
+		pinsByCert[i] = map[string]struct{}{}
+		for _, proto := range ALPNProtocols(pins) {
+			pinsByCert[i][proto] = struct{}{}
+		}
+		configs[i] = config.Clone()
+		configs[i].Certificates = []tls.Certificate{config.Certificates[i]}
+	}
+	return func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+		for i := len(pinsByCert) - 1; i > 0; i-- {
+			for _, proto := range hello.SupportedProtos {
+				if _, ok := pinsByCert[i][proto]; ok {
+					return configs[i], nil
+				}
+			}
+		}
+		// Use the original config, which serves the first certificate
+		return nil, nil
+	}, nil
+}
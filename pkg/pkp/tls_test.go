@@ -131,4 +131,76 @@ var _ = Describe("TLS Config", Label(test.Unit), func() {
 			}
 		})
 	})
+	When("the server has multiple certificates", func() {
+		var oldCert, newCert tls.Certificate
+		var addr string
+		BeforeEach(func() {
+			var err error
+			oldCert, err = tls.X509KeyPair(test.TestData("self_signed_leaf.crt"), test.TestData("self_signed_leaf.key"))
+			Expect(err).NotTo(HaveOccurred())
+			newCert, err = tls.X509KeyPair(test.TestData("localhost.crt"), test.TestData("localhost.key"))
+			Expect(err).NotTo(HaveOccurred())
+
+			serverConfig := &tls.Config{
+				Certificates: []tls.Certificate{oldCert, newCert},
+			}
+			serverConfig.GetConfigForClient, err = pkp.CertificateSelector(serverConfig)
+			Expect(err).NotTo(HaveOccurred())
+			listener, err := tls.Listen("tcp4", "127.0.0.1:0", serverConfig)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(listener.Close)
+			addr = listener.Addr().String()
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						defer conn.Close()
+						conn.(*tls.Conn).Handshake()
+					}()
+				}
+			}()
+		})
+		leafPin := func(cert tls.Certificate) *pkp.PublicKeyPin {
+			leaf, err := x509.ParseCertificate(cert.Certificate[0])
+			Expect(err).NotTo(HaveOccurred())
+			return pkp.NewSha256(leaf)
+		}
+		servedCert := func(pins []*pkp.PublicKeyPin, advertise bool) ([]byte, error) {
+			tlsConfig, err := pkp.TLSConfig(pins)
+			Expect(err).NotTo(HaveOccurred())
+			if advertise {
+				tlsConfig.NextProtos = pkp.ALPNProtocols(pins)
+			}
+			conn, err := tls.Dial("tcp4", addr, tlsConfig)
+			if err != nil {
+				return nil, err
+			}
+			defer conn.Close()
+			return conn.ConnectionState().PeerCertificates[0].Raw, nil
+		}
+		It("should serve the first certificate to clients which do not advertise pins", func() {
+			raw, err := servedCert([]*pkp.PublicKeyPin{leafPin(oldCert)}, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw).To(Equal(oldCert.Certificate[0]))
+
+			_, err = servedCert([]*pkp.PublicKeyPin{leafPin(newCert)}, false)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should serve the newest certificate pinned by the client", func() {
+			raw, err := servedCert([]*pkp.PublicKeyPin{leafPin(oldCert)}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw).To(Equal(oldCert.Certificate[0]))
+
+			raw, err = servedCert([]*pkp.PublicKeyPin{leafPin(newCert)}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw).To(Equal(newCert.Certificate[0]))
+
+			raw, err = servedCert([]*pkp.PublicKeyPin{leafPin(oldCert), leafPin(newCert)}, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(raw).To(Equal(newCert.Certificate[0]))
+		})
+	})
 })
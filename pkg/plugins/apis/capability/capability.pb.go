@@ -116,6 +116,53 @@ func (x *InstallRequest) GetCluster() *core.Reference {
 	return nil
 }
 
+type UninstallRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster *core.Reference `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *UninstallRequest) Reset() {
+	*x = UninstallRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_plugins_apis_capability_capability_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UninstallRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UninstallRequest) ProtoMessage() {}
+
+func (x *UninstallRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_plugins_apis_capability_capability_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UninstallRequest.ProtoReflect.Descriptor instead.
+func (*UninstallRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_plugins_apis_capability_capability_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UninstallRequest) GetCluster() *core.Reference {
+	if x != nil {
+		return x.Cluster
+	}
+	return nil
+}
+
 type InstallerTemplateResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -127,7 +174,7 @@ type InstallerTemplateResponse struct {
 func (x *InstallerTemplateResponse) Reset() {
 	*x = InstallerTemplateResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_plugins_apis_capability_capability_proto_msgTypes[2]
+		mi := &file_pkg_plugins_apis_capability_capability_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -140,7 +187,7 @@ func (x *InstallerTemplateResponse) String() string {
 func (*InstallerTemplateResponse) ProtoMessage() {}
 
 func (x *InstallerTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_plugins_apis_capability_capability_proto_msgTypes[2]
+	mi := &file_pkg_plugins_apis_capability_capability_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -153,7 +200,7 @@ func (x *InstallerTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InstallerTemplateResponse.ProtoReflect.Descriptor instead.
 func (*InstallerTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_plugins_apis_capability_capability_proto_rawDescGZIP(), []int{2}
+	return file_pkg_plugins_apis_capability_capability_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InstallerTemplateResponse) GetTemplate() string {
@@ -179,25 +226,29 @@ var file_pkg_plugins_apis_capability_capability_proto_rawDesc = []byte{
 	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c,
 	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f,
 	0x72, 0x65, 0x2e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a, 0x00,
-	0x22, 0x31, 0x0a, 0x19, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x00, 0x3a, 0x00, 0x32, 0xf1, 0x02, 0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12,
-	0x3e, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x22, 0x38, 0x0a, 0x10, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x00, 0x3a, 0x00, 0x22, 0x31, 0x0a, 0x19, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x00, 0x3a, 0x00, 0x32, 0xf7, 0x02,
+	0x0a, 0x07, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x3e, 0x0a, 0x04, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x00, 0x30, 0x00, 0x12, 0x42, 0x0a, 0x0a, 0x43, 0x61, 0x6e,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x18, 0x2e, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x00, 0x30, 0x00, 0x12,
-	0x42, 0x0a, 0x0a, 0x43, 0x61, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28,
-	0x00, 0x30, 0x00, 0x12, 0x43, 0x0a, 0x07, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x1a,
-	0x2e, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x73, 0x74,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x00, 0x28, 0x00, 0x30, 0x00, 0x12, 0x41, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x6e,
-	0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x16, 0x2e,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x00, 0x30, 0x00, 0x12, 0x43, 0x0a,
+	0x07, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x00,
+	0x30, 0x00, 0x12, 0x47, 0x0a, 0x09, 0x55, 0x6e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x12,
+	0x1c, 0x2e, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x6e, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
 	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x28, 0x00, 0x30, 0x00, 0x12, 0x58, 0x0a, 0x11, 0x49,
 	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
@@ -225,31 +276,33 @@ func file_pkg_plugins_apis_capability_capability_proto_rawDescGZIP() []byte {
 	return file_pkg_plugins_apis_capability_capability_proto_rawDescData
 }
 
-var file_pkg_plugins_apis_capability_capability_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pkg_plugins_apis_capability_capability_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_pkg_plugins_apis_capability_capability_proto_goTypes = []interface{}{
 	(*InfoResponse)(nil),              // 0: capability.InfoResponse
 	(*InstallRequest)(nil),            // 1: capability.InstallRequest
-	(*InstallerTemplateResponse)(nil), // 2: capability.InstallerTemplateResponse
-	(*core.Reference)(nil),            // 3: core.Reference
-	(*emptypb.Empty)(nil),             // 4: google.protobuf.Empty
+	(*UninstallRequest)(nil),          // 2: capability.UninstallRequest
+	(*InstallerTemplateResponse)(nil), // 3: capability.InstallerTemplateResponse
+	(*core.Reference)(nil),            // 4: core.Reference
+	(*emptypb.Empty)(nil),             // 5: google.protobuf.Empty
 }
 var file_pkg_plugins_apis_capability_capability_proto_depIdxs = []int32{
-	3, // 0: capability.InstallRequest.cluster:type_name -> core.Reference
-	4, // 1: capability.Backend.Info:input_type -> google.protobuf.Empty
-	4, // 2: capability.Backend.CanInstall:input_type -> google.protobuf.Empty
-	1, // 3: capability.Backend.Install:input_type -> capability.InstallRequest
-	4, // 4: capability.Backend.Uninstall:input_type -> google.protobuf.Empty
-	4, // 5: capability.Backend.InstallerTemplate:input_type -> google.protobuf.Empty
-	0, // 6: capability.Backend.Info:output_type -> capability.InfoResponse
-	4, // 7: capability.Backend.CanInstall:output_type -> google.protobuf.Empty
-	4, // 8: capability.Backend.Install:output_type -> google.protobuf.Empty
-	4, // 9: capability.Backend.Uninstall:output_type -> google.protobuf.Empty
-	2, // 10: capability.Backend.InstallerTemplate:output_type -> capability.InstallerTemplateResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	4, // 0: capability.InstallRequest.cluster:type_name -> core.Reference
+	4, // 1: capability.UninstallRequest.cluster:type_name -> core.Reference
+	5, // 2: capability.Backend.Info:input_type -> google.protobuf.Empty
+	5, // 3: capability.Backend.CanInstall:input_type -> google.protobuf.Empty
+	1, // 4: capability.Backend.Install:input_type -> capability.InstallRequest
+	2, // 5: capability.Backend.Uninstall:input_type -> capability.UninstallRequest
+	5, // 6: capability.Backend.InstallerTemplate:input_type -> google.protobuf.Empty
+	0, // 7: capability.Backend.Info:output_type -> capability.InfoResponse
+	5, // 8: capability.Backend.CanInstall:output_type -> google.protobuf.Empty
+	5, // 9: capability.Backend.Install:output_type -> google.protobuf.Empty
+	5, // 10: capability.Backend.Uninstall:output_type -> google.protobuf.Empty
+	3, // 11: capability.Backend.InstallerTemplate:output_type -> capability.InstallerTemplateResponse
+	7, // [7:12] is the sub-list for method output_type
+	2, // [2:7] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_pkg_plugins_apis_capability_capability_proto_init() }
@@ -283,6 +336,18 @@ func file_pkg_plugins_apis_capability_capability_proto_init() {
 			}
 		}
 		file_pkg_plugins_apis_capability_capability_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UninstallRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_plugins_apis_capability_capability_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InstallerTemplateResponse); i {
 			case 0:
 				return &v.state
@@ -301,7 +366,7 @@ func file_pkg_plugins_apis_capability_capability_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_plugins_apis_capability_capability_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,7 +26,7 @@ type BackendClient interface {
 	Info(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InfoResponse, error)
 	CanInstall(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Install(ctx context.Context, in *InstallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	Uninstall(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Uninstall(ctx context.Context, in *UninstallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	InstallerTemplate(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*InstallerTemplateResponse, error)
 }
 
@@ -65,7 +65,7 @@ func (c *backendClient) Install(ctx context.Context, in *InstallRequest, opts ..
 	return out, nil
 }
 
-func (c *backendClient) Uninstall(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *backendClient) Uninstall(ctx context.Context, in *UninstallRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/capability.Backend/Uninstall", in, out, opts...)
 	if err != nil {
@@ -90,7 +90,7 @@ type BackendServer interface {
 	Info(context.Context, *emptypb.Empty) (*InfoResponse, error)
 	CanInstall(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
 	Install(context.Context, *InstallRequest) (*emptypb.Empty, error)
-	Uninstall(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	Uninstall(context.Context, *UninstallRequest) (*emptypb.Empty, error)
 	InstallerTemplate(context.Context, *emptypb.Empty) (*InstallerTemplateResponse, error)
 	mustEmbedUnimplementedBackendServer()
 }
@@ -108,7 +108,7 @@ func (UnimplementedBackendServer) CanInstall(context.Context, *emptypb.Empty) (*
 func (UnimplementedBackendServer) Install(context.Context, *InstallRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Install not implemented")
 }
-func (UnimplementedBackendServer) Uninstall(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
+func (UnimplementedBackendServer) Uninstall(context.Context, *UninstallRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Uninstall not implemented")
 }
 func (UnimplementedBackendServer) InstallerTemplate(context.Context, *emptypb.Empty) (*InstallerTemplateResponse, error) {
@@ -182,7 +182,7 @@ func _Backend_Install_Handler(srv interface{}, ctx context.Context, dec func(int
 }
 
 func _Backend_Uninstall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(UninstallRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -194,7 +194,7 @@ func _Backend_Uninstall_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: "/capability.Backend/Uninstall",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackendServer).Uninstall(ctx, req.(*emptypb.Empty))
+		return srv.(BackendServer).Uninstall(ctx, req.(*UninstallRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -7,16 +7,24 @@ import (
 	core "github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/plugins"
 	"google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
-	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 type Backend interface {
 	// Returns an error if installing the capability would fail.
 	CanInstall() error
-	// Any error returned from this method is fatal.
-	Install(cluster *core.Reference) error
+	// Installs the capability on the cluster. This is called in the
+	// background by the gateway, and is retried with exponential backoff if
+	// it returns an error, so it should be safe to call again after a partial
+	// or failed installation. The context is canceled if the installation is
+	// aborted, for example because the capability is being uninstalled or the
+	// cluster is being deleted, in which case the plugin should stop as soon
+	// as possible; the result is ignored.
+	Install(ctx context.Context, cluster *core.Reference) error
+	// Removes the capability from the cluster. Any data the plugin stores for
+	// the cluster should be cleaned up. Uninstalling a capability which is
+	// not installed should not return an error.
+	Uninstall(cluster *core.Reference) error
 	// Returns a go template string which will generate a shell command used to
 	// install the capability. This will be displayed to the user in the UI.
 	// See InstallerTemplateSpec above for the available template fields.
@@ -87,7 +95,7 @@ func (b *backendServerImpl) Install(
 	ctx context.Context,
 	in *InstallRequest,
 ) (*emptypb.Empty, error) {
-	err := b.impl.Install(in.Cluster)
+	err := b.impl.Install(ctx, in.Cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +104,13 @@ func (b *backendServerImpl) Install(
 
 func (b *backendServerImpl) Uninstall(
 	ctx context.Context,
-	in *emptypb.Empty,
+	in *UninstallRequest,
 ) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Uninstall not implemented")
+	err := b.impl.Uninstall(in.Cluster)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
 }
 
 func (b *backendServerImpl) InstallerTemplate(
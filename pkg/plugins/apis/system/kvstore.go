@@ -4,7 +4,10 @@ import (
 	"context"
 
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -13,24 +16,52 @@ type kvStoreServer struct {
 	store storage.KeyValueStore
 }
 
-func (s *kvStoreServer) Put(ctx context.Context, kv *KeyValue) (*emptypb.Empty, error) {
-	err := s.store.Put(ctx, kv.GetKey(), kv.GetValue())
+func (s *kvStoreServer) Put(ctx context.Context, kv *KeyValue) (*Revision, error) {
+	var revision int64
+	opts := []storage.KeyValueStoreOption{
+		storage.WithRevisionOut(&revision),
+	}
+	if kv.Ttl != nil {
+		if err := kv.Ttl.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		opts = append(opts, storage.WithTTL(kv.Ttl.AsDuration()))
+	}
+	if kv.Revision != nil {
+		opts = append(opts, storage.WithRevision(kv.Revision.GetRevision()))
+	}
+	err := s.store.Put(ctx, kv.GetKey(), kv.GetValue(), opts...)
 	if err != nil {
 		return nil, err
 	}
-	return &emptypb.Empty{}, nil
+	return &Revision{
+		Revision: revision,
+	}, nil
 }
 
 func (s *kvStoreServer) Get(ctx context.Context, key *Key) (*Value, error) {
-	data, err := s.store.Get(ctx, key.GetKey())
+	var revision int64
+	data, err := s.store.Get(ctx, key.GetKey(), storage.WithRevisionOut(&revision))
 	if err != nil {
 		return nil, err
 	}
 	return &Value{
-		Value: data,
+		Value:    data,
+		Revision: revision,
 	}, nil
 }
 
+func (s *kvStoreServer) Delete(ctx context.Context, req *DeleteRequest) (*emptypb.Empty, error) {
+	var opts []storage.KeyValueStoreOption
+	if req.Revision != nil {
+		opts = append(opts, storage.WithRevision(req.Revision.GetRevision()))
+	}
+	if err := s.store.Delete(ctx, req.GetKey(), opts...); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
 func (s *kvStoreServer) ListKeys(ctx context.Context, key *Key) (*KeyList, error) {
 	items, err := s.store.ListKeys(ctx, key.GetKey())
 	if err != nil {
@@ -41,10 +72,38 @@ func (s *kvStoreServer) ListKeys(ctx context.Context, key *Key) (*KeyList, error
 	}, nil
 }
 
+func (s *kvStoreServer) Watch(key *Key, stream KeyValueStore_WatchServer) error {
+	events, err := s.store.Watch(stream.Context(), key.GetKey())
+	if err != nil {
+		return err
+	}
+	for event := range events {
+		if err := stream.Send(watchEventToProto(event)); err != nil {
+			return err
+		}
+	}
+	return stream.Context().Err()
+}
+
+// KVStoreClient provides plugins with access to a key-value store namespaced
+// to the plugin. Values are protobuf messages.
+//
+// Errors returned by the store can be compared against storage.ErrNotFound
+// and storage.ErrConflict using errors.Is.
 type KVStoreClient interface {
-	Put(key string, value proto.Message) error
-	Get(key string, out proto.Message) error
+	// Put writes a value to the given key. The storage.WithTTL,
+	// storage.WithRevision and storage.WithRevisionOut options are supported.
+	Put(key string, value proto.Message, opts ...storage.KeyValueStoreOption) error
+	// Get reads the value of the given key. The storage.WithRevisionOut
+	// option is supported.
+	Get(key string, out proto.Message, opts ...storage.KeyValueStoreOption) error
+	// Delete deletes the given key. The storage.WithRevision option is
+	// supported.
+	Delete(key string, opts ...storage.KeyValueStoreOption) error
 	ListKeys(prefix string) ([]string, error)
+	// Watch streams changes to keys with the given prefix until the context
+	// is canceled. Values in watch events are the marshaled messages.
+	Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error)
 }
 
 type kvStoreClientImpl struct {
@@ -52,7 +111,9 @@ type kvStoreClientImpl struct {
 	client KeyValueStoreClient
 }
 
-func (c *kvStoreClientImpl) Put(key string, value proto.Message) error {
+func (c *kvStoreClientImpl) Put(key string, value proto.Message, opts ...storage.KeyValueStoreOption) error {
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
 	wire, err := proto.Marshal(value)
 	if err != nil {
 		return err
@@ -61,19 +122,51 @@ func (c *kvStoreClientImpl) Put(key string, value proto.Message) error {
 		Key:   key,
 		Value: wire,
 	}
-	_, err = c.client.Put(c.ctx, kv)
-	return err
+	if options.TTL > 0 {
+		kv.Ttl = durationpb.New(options.TTL)
+	}
+	if options.Revision != nil {
+		kv.Revision = &Revision{
+			Revision: *options.Revision,
+		}
+	}
+	rev, err := c.client.Put(c.ctx, kv)
+	if err != nil {
+		return convertError(err)
+	}
+	options.SetRevisionOut(rev.GetRevision())
+	return nil
 }
 
-func (c *kvStoreClientImpl) Get(key string, out proto.Message) error {
+func (c *kvStoreClientImpl) Get(key string, out proto.Message, opts ...storage.KeyValueStoreOption) error {
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
 	value, err := c.client.Get(c.ctx, &Key{
 		Key: key,
 	})
 	if err != nil {
+		return convertError(err)
+	}
+	if err := proto.Unmarshal(value.GetValue(), out); err != nil {
 		return err
 	}
+	options.SetRevisionOut(value.GetRevision())
+	return nil
+}
 
-	return proto.Unmarshal(value.GetValue(), out)
+func (c *kvStoreClientImpl) Delete(key string, opts ...storage.KeyValueStoreOption) error {
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	req := &DeleteRequest{
+		Key: key,
+	}
+	if options.Revision != nil {
+		req.Revision = &Revision{
+			Revision: *options.Revision,
+		}
+	}
+	_, err := c.client.Delete(c.ctx, req)
+	return convertError(err)
 }
 
 func (c *kvStoreClientImpl) ListKeys(prefix string) ([]string, error) {
@@ -85,3 +178,96 @@ func (c *kvStoreClientImpl) ListKeys(prefix string) ([]string, error) {
 	}
 	return resp.Items, nil
 }
+
+func (c *kvStoreClientImpl) Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error) {
+	stream, err := c.client.Watch(ctx, &Key{
+		Key: prefix,
+	})
+	if err != nil {
+		return nil, err
+	}
+	eventC := make(chan storage.KeyValueWatchEvent, 64)
+	go func() {
+		defer close(eventC)
+		for {
+			// The stream ends when the context is canceled or the server
+			// closes it; either way the channel is closed.
+			event, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case eventC <- watchEventFromProto(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return eventC, nil
+}
+
+// convertError converts status errors returned by the server back into the
+// corresponding storage errors.
+func convertError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return storage.ErrNotFound
+	case codes.Aborted:
+		return storage.ErrConflict
+	}
+	return err
+}
+
+func watchEventToProto(event storage.KeyValueWatchEvent) *WatchEvent {
+	out := &WatchEvent{
+		Current:  keyRevisionToProto(event.Current),
+		Previous: keyRevisionToProto(event.Previous),
+	}
+	switch event.EventType {
+	case storage.WatchEventCreate:
+		out.Type = WatchEventType_Added
+	case storage.WatchEventUpdate:
+		out.Type = WatchEventType_Modified
+	case storage.WatchEventDelete:
+		out.Type = WatchEventType_Deleted
+	}
+	return out
+}
+
+func watchEventFromProto(event *WatchEvent) storage.KeyValueWatchEvent {
+	out := storage.KeyValueWatchEvent{
+		Current:  keyRevisionFromProto(event.GetCurrent()),
+		Previous: keyRevisionFromProto(event.GetPrevious()),
+	}
+	switch event.GetType() {
+	case WatchEventType_Added:
+		out.EventType = storage.WatchEventCreate
+	case WatchEventType_Modified:
+		out.EventType = storage.WatchEventUpdate
+	case WatchEventType_Deleted:
+		out.EventType = storage.WatchEventDelete
+	}
+	return out
+}
+
+func keyRevisionToProto(kr *storage.KeyRevision) *KeyRevision {
+	if kr == nil {
+		return nil
+	}
+	return &KeyRevision{
+		Key:      kr.Key,
+		Value:    kr.Value,
+		Revision: kr.Revision,
+	}
+}
+
+func keyRevisionFromProto(kr *KeyRevision) *storage.KeyRevision {
+	if kr == nil {
+		return nil
+	}
+	return &storage.KeyRevision{
+		Key:      kr.GetKey(),
+		Value:    kr.GetValue(),
+		Revision: kr.GetRevision(),
+	}
+}
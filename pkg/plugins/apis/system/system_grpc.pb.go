@@ -145,9 +145,11 @@ var System_ServiceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type KeyValueStoreClient interface {
-	Put(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Put(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*Revision, error)
 	Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Value, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListKeys(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeyList, error)
+	Watch(ctx context.Context, in *Key, opts ...grpc.CallOption) (KeyValueStore_WatchClient, error)
 }
 
 type keyValueStoreClient struct {
@@ -158,8 +160,8 @@ func NewKeyValueStoreClient(cc grpc.ClientConnInterface) KeyValueStoreClient {
 	return &keyValueStoreClient{cc}
 }
 
-func (c *keyValueStoreClient) Put(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *keyValueStoreClient) Put(ctx context.Context, in *KeyValue, opts ...grpc.CallOption) (*Revision, error) {
+	out := new(Revision)
 	err := c.cc.Invoke(ctx, "/system.KeyValueStore/Put", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -176,6 +178,15 @@ func (c *keyValueStoreClient) Get(ctx context.Context, in *Key, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *keyValueStoreClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/system.KeyValueStore/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *keyValueStoreClient) ListKeys(ctx context.Context, in *Key, opts ...grpc.CallOption) (*KeyList, error) {
 	out := new(KeyList)
 	err := c.cc.Invoke(ctx, "/system.KeyValueStore/ListKeys", in, out, opts...)
@@ -185,13 +196,47 @@ func (c *keyValueStoreClient) ListKeys(ctx context.Context, in *Key, opts ...grp
 	return out, nil
 }
 
+func (c *keyValueStoreClient) Watch(ctx context.Context, in *Key, opts ...grpc.CallOption) (KeyValueStore_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &KeyValueStore_ServiceDesc.Streams[0], "/system.KeyValueStore/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &keyValueStoreWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type KeyValueStore_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type keyValueStoreWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *keyValueStoreWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // KeyValueStoreServer is the server API for KeyValueStore service.
 // All implementations must embed UnimplementedKeyValueStoreServer
 // for forward compatibility
 type KeyValueStoreServer interface {
-	Put(context.Context, *KeyValue) (*emptypb.Empty, error)
+	Put(context.Context, *KeyValue) (*Revision, error)
 	Get(context.Context, *Key) (*Value, error)
+	Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error)
 	ListKeys(context.Context, *Key) (*KeyList, error)
+	Watch(*Key, KeyValueStore_WatchServer) error
 	mustEmbedUnimplementedKeyValueStoreServer()
 }
 
@@ -199,15 +244,21 @@ type KeyValueStoreServer interface {
 type UnimplementedKeyValueStoreServer struct {
 }
 
-func (UnimplementedKeyValueStoreServer) Put(context.Context, *KeyValue) (*emptypb.Empty, error) {
+func (UnimplementedKeyValueStoreServer) Put(context.Context, *KeyValue) (*Revision, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Put not implemented")
 }
 func (UnimplementedKeyValueStoreServer) Get(context.Context, *Key) (*Value, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedKeyValueStoreServer) Delete(context.Context, *DeleteRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
 func (UnimplementedKeyValueStoreServer) ListKeys(context.Context, *Key) (*KeyList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListKeys not implemented")
 }
+func (UnimplementedKeyValueStoreServer) Watch(*Key, KeyValueStore_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
 func (UnimplementedKeyValueStoreServer) mustEmbedUnimplementedKeyValueStoreServer() {}
 
 // UnsafeKeyValueStoreServer may be embedded to opt out of forward compatibility for this service.
@@ -257,6 +308,24 @@ func _KeyValueStore_Get_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyValueStore_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KeyValueStoreServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/system.KeyValueStore/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KeyValueStoreServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KeyValueStore_ListKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Key)
 	if err := dec(in); err != nil {
@@ -275,6 +344,27 @@ func _KeyValueStore_ListKeys_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KeyValueStore_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Key)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(KeyValueStoreServer).Watch(m, &keyValueStoreWatchServer{stream})
+}
+
+type KeyValueStore_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type keyValueStoreWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *keyValueStoreWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // KeyValueStore_ServiceDesc is the grpc.ServiceDesc for KeyValueStore service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -290,11 +380,21 @@ var KeyValueStore_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Get",
 			Handler:    _KeyValueStore_Get_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _KeyValueStore_Delete_Handler,
+		},
 		{
 			MethodName: "ListKeys",
 			Handler:    _KeyValueStore_ListKeys_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _KeyValueStore_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "pkg/plugins/apis/system/system.proto",
 }
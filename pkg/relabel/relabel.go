@@ -0,0 +1,140 @@
+package relabel
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/labels"
+	promrelabel "github.com/prometheus/prometheus/model/relabel"
+	"github.com/prometheus/prometheus/prompb"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+)
+
+// Matches valid target labels for the replace action, which may reference
+// capture groups of the regex.
+var relabelTarget = regexp.MustCompile(`^(?:(?:[a-zA-Z_]|\$(?:\{\w+\}|\w+))+\w*)+$`)
+
+// Relabeler applies write relabel rules to remote-write requests, and counts
+// the samples dropped by each rule.
+type Relabeler struct {
+	rules          []*promrelabel.Config
+	droppedSamples *prometheus.CounterVec
+}
+
+// New validates the given relabel configs and returns a Relabeler which
+// applies them in order.
+func New(configs []v1beta1.RelabelConfig) (*Relabeler, error) {
+	r := &Relabeler{
+		droppedSamples: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "opni_agent_relabel_dropped_samples_total",
+			Help: "Number of samples dropped by each write relabel rule",
+		}, []string{"rule", "action"}),
+	}
+	for i, conf := range configs {
+		rule, err := convert(conf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid relabel config at index %d: %w", i, err)
+		}
+		r.rules = append(r.rules, rule)
+		// Initialize the counter, so that rules which have not dropped any
+		// samples are reported
+		r.droppedSamples.WithLabelValues(strconv.Itoa(i), string(rule.Action))
+	}
+	return r, nil
+}
+
+func convert(conf v1beta1.RelabelConfig) (*promrelabel.Config, error) {
+	rule := promrelabel.DefaultRelabelConfig
+	if conf.Action != "" {
+		rule.Action = promrelabel.Action(conf.Action)
+	}
+	if conf.Regex != "" {
+		re, err := promrelabel.NewRegexp(conf.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex: %w", err)
+		}
+		rule.Regex = re
+	}
+	switch rule.Action {
+	case promrelabel.Replace:
+		if conf.TargetLabel == "" {
+			return nil, fmt.Errorf("%s action requires a target label", rule.Action)
+		}
+		if !relabelTarget.MatchString(conf.TargetLabel) {
+			return nil, fmt.Errorf("%q is not a valid target label", conf.TargetLabel)
+		}
+	case promrelabel.Keep, promrelabel.Drop:
+	case promrelabel.LabelDrop:
+		if len(conf.SourceLabels) > 0 || conf.TargetLabel != "" ||
+			conf.Separator != "" || conf.Replacement != "" {
+			return nil, fmt.Errorf("%s action requires only a regex, and no other fields", rule.Action)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported action %q", conf.Action)
+	}
+	for _, name := range conf.SourceLabels {
+		if !model.LabelName(name).IsValid() {
+			return nil, fmt.Errorf("%q is not a valid source label", name)
+		}
+		rule.SourceLabels = append(rule.SourceLabels, model.LabelName(name))
+	}
+	if conf.Separator != "" {
+		rule.Separator = conf.Separator
+	}
+	if conf.Replacement != "" {
+		rule.Replacement = conf.Replacement
+	}
+	rule.TargetLabel = conf.TargetLabel
+	return &rule, nil
+}
+
+// Process applies the relabel rules to each series in the request, removing
+// series which are dropped. Series whose labels are all removed are dropped.
+// It returns the number of samples which were dropped.
+func (r *Relabeler) Process(wr *prompb.WriteRequest) int {
+	var dropped int
+	kept := wr.Timeseries[:0]
+	for _, ts := range wr.Timeseries {
+		lset := make(labels.Labels, 0, len(ts.Labels))
+		for _, l := range ts.Labels {
+			lset = append(lset, labels.Label{
+				Name:  l.Name,
+				Value: l.Value,
+			})
+		}
+		lset = labels.New(lset...)
+		for i, rule := range r.rules {
+			lset = promrelabel.Process(lset, rule)
+			if len(lset) == 0 {
+				r.droppedSamples.WithLabelValues(strconv.Itoa(i), string(rule.Action)).
+					Add(float64(len(ts.Samples)))
+				break
+			}
+		}
+		if len(lset) == 0 {
+			dropped += len(ts.Samples)
+			continue
+		}
+		ts.Labels = make([]prompb.Label, 0, len(lset))
+		for _, l := range lset {
+			ts.Labels = append(ts.Labels, prompb.Label{
+				Name:  l.Name,
+				Value: l.Value,
+			})
+		}
+		kept = append(kept, ts)
+	}
+	wr.Timeseries = kept
+	return dropped
+}
+
+func (r *Relabeler) Describe(ch chan<- *prometheus.Desc) {
+	r.droppedSamples.Describe(ch)
+}
+
+func (r *Relabeler) Collect(ch chan<- prometheus.Metric) {
+	r.droppedSamples.Collect(ch)
+}
@@ -0,0 +1,13 @@
+package relabel_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestRelabel(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Relabel Suite")
+}
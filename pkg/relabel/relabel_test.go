@@ -0,0 +1,144 @@
+package relabel_test
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/prometheus/prometheus/prompb"
+
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/relabel"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+const header = `
+# HELP opni_agent_relabel_dropped_samples_total Number of samples dropped by each write relabel rule
+# TYPE opni_agent_relabel_dropped_samples_total counter`
+
+func series(samples int, kv ...string) prompb.TimeSeries {
+	ts := prompb.TimeSeries{}
+	for i := 0; i < len(kv); i += 2 {
+		ts.Labels = append(ts.Labels, prompb.Label{
+			Name:  kv[i],
+			Value: kv[i+1],
+		})
+	}
+	for i := 0; i < samples; i++ {
+		ts.Samples = append(ts.Samples, prompb.Sample{
+			Value:     float64(i),
+			Timestamp: int64(i),
+		})
+	}
+	return ts
+}
+
+func labelMaps(wr *prompb.WriteRequest) []map[string]string {
+	var maps []map[string]string
+	for _, ts := range wr.Timeseries {
+		m := map[string]string{}
+		for _, l := range ts.Labels {
+			m[l.Name] = l.Value
+		}
+		maps = append(maps, m)
+	}
+	return maps
+}
+
+var _ = Describe("Relabeler", Label(test.Unit), func() {
+	It("should apply rules in order and count dropped samples per rule", func() {
+		r, err := relabel.New([]v1beta1.RelabelConfig{
+			{
+				SourceLabels: []string{"__name__"},
+				Regex:        "go_.*",
+				Action:       v1beta1.RelabelActionDrop,
+			},
+			{
+				SourceLabels: []string{"job"},
+				Regex:        "prometheus|node",
+				Action:       v1beta1.RelabelActionKeep,
+			},
+			{
+				Regex:  "instance|pod",
+				Action: v1beta1.RelabelActionLabelDrop,
+			},
+			{
+				SourceLabels: []string{"job", "__name__"},
+				Separator:    "/",
+				Regex:        "(.*)/(.*)",
+				TargetLabel:  "source",
+				Replacement:  "${1}_${2}",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		wr := &prompb.WriteRequest{
+			Timeseries: []prompb.TimeSeries{
+				series(2, "__name__", "go_goroutines", "job", "prometheus"),
+				series(3, "__name__", "up", "job", "other"),
+				series(1, "__name__", "up", "job", "node", "instance", "a", "pod", "b"),
+				series(4, "__name__", "up", "job", "prometheus", "env", "prod"),
+			},
+		}
+		Expect(r.Process(wr)).To(Equal(5))
+		Expect(labelMaps(wr)).To(Equal([]map[string]string{
+			{"__name__": "up", "job": "node", "source": "node_up"},
+			{"__name__": "up", "job": "prometheus", "env": "prod", "source": "prometheus_up"},
+		}))
+		Expect(wr.Timeseries[1].Samples).To(HaveLen(4))
+
+		Expect(promtestutil.CollectAndCompare(r, strings.NewReader(header+`
+opni_agent_relabel_dropped_samples_total{action="drop",rule="0"} 2
+opni_agent_relabel_dropped_samples_total{action="keep",rule="1"} 3
+opni_agent_relabel_dropped_samples_total{action="labeldrop",rule="2"} 0
+opni_agent_relabel_dropped_samples_total{action="replace",rule="3"} 0
+`))).To(Succeed())
+	})
+	It("should drop series whose labels are all removed", func() {
+		r, err := relabel.New([]v1beta1.RelabelConfig{
+			{
+				Regex:  ".*",
+				Action: v1beta1.RelabelActionLabelDrop,
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		wr := &prompb.WriteRequest{
+			Timeseries: []prompb.TimeSeries{
+				series(2, "__name__", "up"),
+			},
+		}
+		Expect(r.Process(wr)).To(Equal(2))
+		Expect(wr.Timeseries).To(BeEmpty())
+		Expect(promtestutil.CollectAndCompare(r, strings.NewReader(header+`
+opni_agent_relabel_dropped_samples_total{action="labeldrop",rule="0"} 2
+`))).To(Succeed())
+	})
+	DescribeTable("invalid configs",
+		func(conf v1beta1.RelabelConfig, errMsg string) {
+			_, err := relabel.New([]v1beta1.RelabelConfig{conf})
+			Expect(err).To(MatchError(ContainSubstring(errMsg)))
+		},
+		Entry("unsupported action", v1beta1.RelabelConfig{
+			Action: "hashmod",
+		}, `unsupported action "hashmod"`),
+		Entry("invalid regex", v1beta1.RelabelConfig{
+			Regex:  "(",
+			Action: v1beta1.RelabelActionDrop,
+		}, "invalid regex"),
+		Entry("replace without a target label", v1beta1.RelabelConfig{
+			SourceLabels: []string{"job"},
+		}, "requires a target label"),
+		Entry("invalid target label", v1beta1.RelabelConfig{
+			TargetLabel: "foo-bar",
+		}, "not a valid target label"),
+		Entry("invalid source label", v1beta1.RelabelConfig{
+			SourceLabels: []string{"foo-bar"},
+			Action:       v1beta1.RelabelActionKeep,
+		}, "not a valid source label"),
+		Entry("labeldrop with other fields", v1beta1.RelabelConfig{
+			SourceLabels: []string{"job"},
+			Action:       v1beta1.RelabelActionLabelDrop,
+		}, "requires only a regex"),
+	)
+})
@@ -75,6 +75,29 @@ type KeyringList struct {
 	Items           []Keyring `json:"items"`
 }
 
+//+kubebuilder:object:root=true
+type KeyValue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KeyValueSpec `json:"spec,omitempty"`
+}
+
+type KeyValueSpec struct {
+	// The key-value store namespace the key belongs to
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Value     []byte `json:"value,omitempty"`
+	// If set, the key is considered to be deleted after this time
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+type KeyValueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KeyValue `json:"items"`
+}
+
 func init() {
 	SchemeBuilder.Register(
 		&BootstrapToken{}, &BootstrapTokenList{},
@@ -82,5 +105,6 @@ func init() {
 		&Role{}, &RoleList{},
 		&RoleBinding{}, &RoleBindingList{},
 		&Keyring{}, &KeyringList{},
+		&KeyValue{}, &KeyValueList{},
 	)
 }
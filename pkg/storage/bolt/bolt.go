@@ -0,0 +1,259 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/logger"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	tokensBucket           = []byte("tokens")
+	tokenExpirationsBucket = []byte("token_expirations")
+	clustersBucket         = []byte("clusters")
+	keyringsBucket         = []byte("keyrings")
+	rolesBucket            = []byte("roles")
+	roleBindingsBucket     = []byte("rolebindings")
+	kvBucket               = []byte("kv")
+	kvMetadataBucket       = []byte("kv_metadata")
+
+	allBuckets = [][]byte{
+		tokensBucket,
+		tokenExpirationsBucket,
+		clustersBucket,
+		keyringsBucket,
+		rolesBucket,
+		roleBindingsBucket,
+		kvBucket,
+		kvMetadataBucket,
+	}
+)
+
+// BoltStore implements storage.Backend using an embedded bbolt database.
+// All objects are stored in a single file on the local filesystem, so this
+// store is only suitable for single-node deployments.
+type BoltStore struct {
+	BoltStoreOptions
+	Logger *zap.SugaredLogger
+	DB     *bbolt.DB
+
+	// clustersMu serializes cluster writes with publishing their events, so
+	// that watchers observe changes in the order they were committed.
+	clustersMu    sync.Mutex
+	clusterEvents storage.EventBroadcaster[*core.Cluster]
+
+	// kvMu serves the same purpose for key-value store writes.
+	kvMu     sync.Mutex
+	kvEvents storage.EventBroadcaster[*storage.KeyRevision]
+}
+
+var _ storage.Backend = (*BoltStore)(nil)
+
+type BoltStoreOptions struct {
+	OpenTimeout      time.Duration
+	ValueTransformer storage.ValueTransformer
+}
+
+type BoltStoreOption func(*BoltStoreOptions)
+
+func (o *BoltStoreOptions) Apply(opts ...BoltStoreOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithOpenTimeout sets the amount of time to wait to obtain a file lock on
+// the database file. A timeout of 0 will wait indefinitely.
+func WithOpenTimeout(timeout time.Duration) BoltStoreOption {
+	return func(o *BoltStoreOptions) {
+		o.OpenTimeout = timeout
+	}
+}
+
+// WithValueTransformer sets the transformer applied to keyring and key-value
+// store data, such as an encryption layer.
+func WithValueTransformer(vt storage.ValueTransformer) BoltStoreOption {
+	return func(o *BoltStoreOptions) {
+		o.ValueTransformer = vt
+	}
+}
+
+func NewBoltStore(conf *v1beta1.BoltStorageSpec, opts ...BoltStoreOption) (*BoltStore, error) {
+	options := BoltStoreOptions{
+		OpenTimeout:      10 * time.Second,
+		ValueTransformer: storage.IdentityTransformer{},
+	}
+	options.Apply(opts...)
+	lg := logger.New().Named("bolt")
+
+	if conf.Path == "" {
+		return nil, errors.New("database path is not set")
+	}
+	if err := os.MkdirAll(filepath.Dir(conf.Path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create database directory: %w", err)
+	}
+	db, err := open(conf.Path, options.OpenTimeout)
+	if err != nil {
+		return nil, err
+	}
+	lg.With(
+		"path", conf.Path,
+	).Info("opened database")
+	return &BoltStore{
+		BoltStoreOptions: options,
+		Logger:           lg,
+		DB:               db,
+	}, nil
+}
+
+// open opens the database file at the given path, creating it and any
+// missing top-level buckets if necessary.
+func open(filename string, timeout time.Duration) (*bbolt.DB, error) {
+	db, err := bbolt.Open(filename, 0600, &bbolt.Options{
+		Timeout: timeout,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for _, name := range allBuckets {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return initKvMetadata(tx)
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to initialize database: %w", err)
+	}
+	return db, nil
+}
+
+// initKvMetadata assigns revisions to any key-value store entries which were
+// written before revisions were tracked.
+func initKvMetadata(tx *bbolt.Tx) error {
+	b := tx.Bucket(kvBucket)
+	metadata := tx.Bucket(kvMetadataBucket)
+	var missing [][]byte
+	err := b.ForEach(func(k, _ []byte) error {
+		if metadata.Get(k) == nil {
+			missing = append(missing, append([]byte{}, k...))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, k := range missing {
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		meta := kvMetadata{
+			revision: int64(seq),
+		}
+		if err := metadata.Put(k, meta.marshal()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *BoltStore) Close() error {
+	return s.DB.Close()
+}
+
+func (s *BoltStore) KeyringStore(ctx context.Context, prefix string, ref *core.Reference) (storage.KeyringStore, error) {
+	return &boltKeyringStore{
+		store: s,
+		key:   []byte(path.Join(prefix, ref.Id)),
+	}, nil
+}
+
+func (s *BoltStore) KeyValueStore(prefix string) (storage.KeyValueStore, error) {
+	return &genericKeyValueStore{
+		store:  s,
+		prefix: prefix,
+	}, nil
+}
+
+type versionedMessage interface {
+	proto.Message
+	core.VersionedObject
+}
+
+// putVersioned assigns the object a new resource version and writes it to the
+// bucket. Resource versions are taken from the bucket's sequence, so they
+// increase monotonically within each bucket.
+func putVersioned(b *bbolt.Bucket, id string, obj versionedMessage) error {
+	seq, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	obj.SetResourceVersion(strconv.FormatUint(seq, 10))
+	data, err := protojson.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to marshal object: %w", err)
+	}
+	return b.Put([]byte(id), data)
+}
+
+// forEachPage calls fn for each key in the bucket in sort order, starting
+// after the cursor, until fn returns false.
+func forEachPage(
+	b *bbolt.Bucket,
+	cursor string,
+	options storage.ListOptions,
+	fn func(k, v []byte) (bool, error),
+) error {
+	c := b.Cursor()
+	var k, v []byte
+	next := c.Next
+	switch {
+	case options.Descending():
+		next = c.Prev
+		if cursor == "" {
+			k, v = c.Last()
+			break
+		}
+		// Seek finds the first key >= cursor, so step back to the first key
+		// which sorts before it.
+		if k, v = c.Seek([]byte(cursor)); k == nil {
+			k, v = c.Last()
+		}
+		if k != nil && string(k) >= cursor {
+			k, v = c.Prev()
+		}
+	case cursor == "":
+		k, v = c.First()
+	default:
+		if k, v = c.Seek([]byte(cursor)); k != nil && string(k) == cursor {
+			k, v = c.Next()
+		}
+	}
+	for ; k != nil; k, v = next() {
+		ok, err := fn(k, v)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *BoltStore) CreateCluster(ctx context.Context, cluster *core.Cluster) error {
+	cluster = proto.Clone(cluster).(*core.Cluster)
+	storage.InitVersionedObject(cluster)
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	var prev *core.Cluster
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		var err error
+		prev, err = getCluster(tx, cluster.Reference())
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return putVersioned(tx.Bucket(clustersBucket), cluster.Id, cluster)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cluster: %w", err)
+	}
+	if prev == nil {
+		s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+			EventType: storage.WatchEventCreate,
+			Current:   proto.Clone(cluster).(*core.Cluster),
+		})
+	} else {
+		s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+			EventType: storage.WatchEventUpdate,
+			Current:   proto.Clone(cluster).(*core.Cluster),
+			Previous:  prev,
+		})
+	}
+	return nil
+}
+
+func (s *BoltStore) DeleteCluster(ctx context.Context, ref *core.Reference) error {
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	var prev *core.Cluster
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		var err error
+		prev, err = getCluster(tx, ref)
+		if err != nil {
+			return err
+		}
+		return tx.Bucket(clustersBucket).Delete([]byte(ref.Id))
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete cluster: %w", err)
+	}
+	s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+		EventType: storage.WatchEventDelete,
+		Previous:  prev,
+	})
+	return nil
+}
+
+func (s *BoltStore) ListClusters(
+	ctx context.Context,
+	matchLabels *core.LabelSelector,
+	matchOptions core.MatchOptions,
+	opts ...storage.ListOption,
+) (*core.ClusterList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	selectorPredicate := storage.ClusterSelector{
+		LabelSelector: matchLabels,
+		MatchOptions:  matchOptions,
+	}.Predicate()
+
+	page := storage.NewPaginator[*core.Cluster](options)
+	err = s.DB.View(func(tx *bbolt.Tx) error {
+		return forEachPage(tx.Bucket(clustersBucket), cursor, options, func(k, v []byte) (bool, error) {
+			cluster := &core.Cluster{}
+			if err := protojson.Unmarshal(v, cluster); err != nil {
+				return false, fmt.Errorf("failed to unmarshal cluster: %w", err)
+			}
+			if !selectorPredicate(cluster) || !options.MatchCluster(cluster) {
+				return true, nil
+			}
+			return page.Add(string(k), cluster), nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	return &core.ClusterList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *BoltStore) GetCluster(ctx context.Context, ref *core.Reference) (*core.Cluster, error) {
+	var cluster *core.Cluster
+	err := s.DB.View(func(tx *bbolt.Tx) error {
+		var err error
+		cluster, err = getCluster(tx, ref)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	return cluster, nil
+}
+
+func (s *BoltStore) UpdateCluster(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.MutatorFunc[*core.Cluster],
+) (*core.Cluster, error) {
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	var cluster, prev *core.Cluster
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		var err error
+		cluster, err = getCluster(tx, ref)
+		if err != nil {
+			return err
+		}
+		prev = proto.Clone(cluster).(*core.Cluster)
+		if err := storage.ApplyMutator(cluster, mutator); err != nil {
+			return err
+		}
+		return putVersioned(tx.Bucket(clustersBucket), ref.Id, cluster)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update cluster: %w", err)
+	}
+	s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+		EventType: storage.WatchEventUpdate,
+		Current:   proto.Clone(cluster).(*core.Cluster),
+		Previous:  prev,
+	})
+	return cluster, nil
+}
+
+func (s *BoltStore) WatchClusters(
+	ctx context.Context,
+	known []*core.Cluster,
+) (<-chan storage.WatchEvent[*core.Cluster], error) {
+	// Holding the lock ensures no writes occur between listing the current
+	// clusters and subscribing to new events.
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	current, err := s.ListClusters(ctx, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	initial := storage.ComputeClusterEvents(known, current.Items)
+	return s.clusterEvents.Subscribe(ctx, initial...), nil
+}
+
+func getCluster(tx *bbolt.Tx, ref *core.Reference) (*core.Cluster, error) {
+	data := tx.Bucket(clustersBucket).Get([]byte(ref.Id))
+	if data == nil {
+		return nil, storage.ErrNotFound
+	}
+	cluster := &core.Cluster{}
+	if err := protojson.Unmarshal(data, cluster); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster: %w", err)
+	}
+	return cluster, nil
+}
@@ -0,0 +1,54 @@
+package bolt_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/storage/bolt"
+	"github.com/rancher/opni-monitoring/pkg/storage/conformance"
+	"github.com/rancher/opni-monitoring/pkg/util"
+	"go.etcd.io/bbolt"
+)
+
+func TestBolt(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Bolt Storage Suite")
+}
+
+var store = util.NewFuture[*bolt.BoltStore]()
+var errCtrl = util.NewFuture[conformance.ErrorController]()
+
+var _ = BeforeSuite(func() {
+	dbPath := filepath.Join(GinkgoT().TempDir(), "opni.db")
+	s, err := bolt.NewBoltStore(&v1beta1.BoltStorageSpec{
+		Path: dbPath,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	store.Set(s)
+
+	// Closing the database causes all subsequent transactions to fail
+	errCtrl.Set(conformance.NewErrorController(
+		func() {
+			s.DB.Close()
+		},
+		func() {
+			db, err := bbolt.Open(dbPath, 0600, nil)
+			if err != nil {
+				panic(err)
+			}
+			s.DB = db
+		},
+	))
+	DeferCleanup(func() {
+		s.Close()
+	})
+})
+
+var _ = Describe("Token Store", Ordered, conformance.TokenStoreTestSuite(store, errCtrl))
+var _ = Describe("Cluster Store", Ordered, conformance.ClusterStoreTestSuite(store, errCtrl))
+var _ = Describe("RBAC Store", Ordered, conformance.RBACStoreTestSuite(store, errCtrl))
+var _ = Describe("Keyring Store", Ordered, conformance.KeyringStoreTestSuite(store, errCtrl))
+var _ = Describe("KV Store", Ordered, conformance.KeyValueStoreTestSuite(store, errCtrl))
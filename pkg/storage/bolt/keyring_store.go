@@ -0,0 +1,119 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/bbolt"
+)
+
+type boltKeyringStore struct {
+	store *BoltStore
+	key   []byte
+}
+
+func (ks *boltKeyringStore) Put(ctx context.Context, keyring keyring.Keyring) error {
+	k, err := keyring.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal keyring: %w", err)
+	}
+	k, err = ks.store.ValueTransformer.TransformToStorage(ctx, k)
+	if err != nil {
+		return fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	err = ks.store.DB.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(keyringsBucket).Put(ks.key, k)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put keyring: %w", err)
+	}
+	return nil
+}
+
+func (ks *boltKeyringStore) Get(ctx context.Context) (keyring.Keyring, error) {
+	var data []byte
+	err := ks.store.DB.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(keyringsBucket).Get(ks.key); v != nil {
+			data = append(data, v...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keyring: %w", err)
+	}
+	if data == nil {
+		return nil, storage.ErrNotFound
+	}
+	data, err = ks.store.ValueTransformer.TransformFromStorage(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	k, err := keyring.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal keyring: %w", err)
+	}
+	return k, nil
+}
+
+// Update applies the mutator within a single write transaction. Bolt only
+// allows one write transaction at a time, so the update cannot race with
+// other writes.
+func (ks *boltKeyringStore) Update(ctx context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+	var retKeyring keyring.Keyring
+	err := ks.store.DB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(keyringsBucket)
+		v := b.Get(ks.key)
+		if v == nil {
+			return storage.ErrNotFound
+		}
+		data, err := ks.store.ValueTransformer.TransformFromStorage(ctx, append([]byte(nil), v...))
+		if err != nil {
+			return fmt.Errorf("failed to transform keyring: %w", err)
+		}
+		kr, err := keyring.Unmarshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal keyring: %w", err)
+		}
+		kr, err = mutator(kr)
+		if err != nil {
+			return err
+		}
+		k, err := kr.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal keyring: %w", err)
+		}
+		k, err = ks.store.ValueTransformer.TransformToStorage(ctx, k)
+		if err != nil {
+			return fmt.Errorf("failed to transform keyring: %w", err)
+		}
+		if err := b.Put(ks.key, k); err != nil {
+			return fmt.Errorf("failed to put keyring: %w", err)
+		}
+		retKeyring = kr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retKeyring, nil
+}
+
+func (ks *boltKeyringStore) Delete(ctx context.Context) error {
+	err := ks.store.DB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(keyringsBucket)
+		if b.Get(ks.key) == nil {
+			return storage.ErrNotFound
+		}
+		return b.Delete(ks.key)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete keyring: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,261 @@
+package bolt
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/bbolt"
+)
+
+// Keys with a TTL are not removed when they expire, but are ignored until they
+// are next written. Watchers will not receive delete events for expired keys.
+type genericKeyValueStore struct {
+	store  *BoltStore
+	prefix string
+}
+
+// kvMetadata is stored in the kv metadata bucket under the same key as the
+// value it describes.
+type kvMetadata struct {
+	revision int64
+	// unix nanoseconds, or 0 if the key does not expire
+	expiresAt int64
+}
+
+func (m kvMetadata) marshal() []byte {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf[:8], uint64(m.revision))
+	binary.BigEndian.PutUint64(buf[8:], uint64(m.expiresAt))
+	return buf
+}
+
+func unmarshalKvMetadata(data []byte) (kvMetadata, error) {
+	if len(data) != 16 {
+		return kvMetadata{}, fmt.Errorf("invalid kv metadata")
+	}
+	return kvMetadata{
+		revision:  int64(binary.BigEndian.Uint64(data[:8])),
+		expiresAt: int64(binary.BigEndian.Uint64(data[8:])),
+	}, nil
+}
+
+func (m kvMetadata) expired(now time.Time) bool {
+	return m.expiresAt != 0 && now.UnixNano() >= m.expiresAt
+}
+
+// ttl returns the time remaining before the key expires, or 0 if it does not
+// expire. Keys which have expired since they were read have the smallest
+// positive TTL, so that they are not mistaken for keys which do not expire.
+func (m kvMetadata) ttl(now time.Time) time.Duration {
+	if m.expiresAt == 0 {
+		return 0
+	}
+	if ttl := time.Duration(m.expiresAt - now.UnixNano()); ttl > 0 {
+		return ttl
+	}
+	return 1
+}
+
+// getKV returns a copy of the stored value and its metadata, or
+// storage.ErrNotFound if the key does not exist or has expired.
+func getKV(tx *bbolt.Tx, key []byte) ([]byte, kvMetadata, error) {
+	v := tx.Bucket(kvBucket).Get(key)
+	if v == nil {
+		return nil, kvMetadata{}, storage.ErrNotFound
+	}
+	meta, err := unmarshalKvMetadata(tx.Bucket(kvMetadataBucket).Get(key))
+	if err != nil {
+		return nil, kvMetadata{}, err
+	}
+	if meta.expired(time.Now()) {
+		return nil, kvMetadata{}, storage.ErrNotFound
+	}
+	return append([]byte{}, v...), meta, nil
+}
+
+func (s *genericKeyValueStore) Put(ctx context.Context, key string, value []byte, opts ...storage.KeyValueStoreOption) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	data, err := s.store.ValueTransformer.TransformToStorage(ctx, value)
+	if err != nil {
+		return err
+	}
+	k := []byte(path.Join(s.prefix, key))
+
+	s.store.kvMu.Lock()
+	defer s.store.kvMu.Unlock()
+	var prevData []byte
+	var prevMeta, meta kvMetadata
+	err = s.store.DB.Update(func(tx *bbolt.Tx) error {
+		var err error
+		prevData, prevMeta, err = getKV(tx, k)
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		if err := options.CheckRevision(prevMeta.revision); err != nil {
+			return err
+		}
+		b := tx.Bucket(kvBucket)
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+		meta = kvMetadata{
+			revision: int64(seq),
+		}
+		if options.TTL > 0 {
+			meta.expiresAt = time.Now().Add(options.TTL).UnixNano()
+		}
+		if err := b.Put(k, data); err != nil {
+			return err
+		}
+		return tx.Bucket(kvMetadataBucket).Put(k, meta.marshal())
+	})
+	if err != nil {
+		return err
+	}
+	options.SetRevisionOut(meta.revision)
+
+	event := storage.KeyValueWatchEvent{
+		EventType: storage.WatchEventCreate,
+		Current: &storage.KeyRevision{
+			Key:      string(k),
+			Value:    value,
+			Revision: meta.revision,
+		},
+	}
+	if prevData != nil {
+		event.EventType = storage.WatchEventUpdate
+		event.Previous = s.keyRevision(ctx, k, prevData, prevMeta)
+	}
+	s.store.kvEvents.Publish(event)
+	return nil
+}
+
+func (s *genericKeyValueStore) Get(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	var value []byte
+	var meta kvMetadata
+	err := s.store.DB.View(func(tx *bbolt.Tx) error {
+		var err error
+		value, meta, err = getKV(tx, []byte(path.Join(s.prefix, key)))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	value, err = s.store.ValueTransformer.TransformFromStorage(ctx, value)
+	if err != nil {
+		return nil, err
+	}
+	options.SetRevisionOut(meta.revision)
+	options.SetTTLOut(meta.ttl(time.Now()))
+	return value, nil
+}
+
+func (s *genericKeyValueStore) Delete(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	k := []byte(path.Join(s.prefix, key))
+
+	s.store.kvMu.Lock()
+	defer s.store.kvMu.Unlock()
+	var prevData []byte
+	var prevMeta kvMetadata
+	err := s.store.DB.Update(func(tx *bbolt.Tx) error {
+		var err error
+		prevData, prevMeta, err = getKV(tx, k)
+		if err != nil {
+			return err
+		}
+		if err := options.CheckRevision(prevMeta.revision); err != nil {
+			return err
+		}
+		if err := tx.Bucket(kvBucket).Delete(k); err != nil {
+			return err
+		}
+		return tx.Bucket(kvMetadataBucket).Delete(k)
+	})
+	if err != nil {
+		return err
+	}
+	s.store.kvEvents.Publish(storage.KeyValueWatchEvent{
+		EventType: storage.WatchEventDelete,
+		Previous:  s.keyRevision(ctx, k, prevData, prevMeta),
+	})
+	return nil
+}
+
+func (s *genericKeyValueStore) ListKeys(ctx context.Context, prefix string) ([]string, error) {
+	keys := []string{}
+	namespace := s.prefix + "/"
+	seek := []byte(namespace + prefix)
+	now := time.Now()
+	err := s.store.DB.View(func(tx *bbolt.Tx) error {
+		metadata := tx.Bucket(kvMetadataBucket)
+		c := tx.Bucket(kvBucket).Cursor()
+		for k, _ := c.Seek(seek); k != nil && bytes.HasPrefix(k, seek); k, _ = c.Next() {
+			meta, err := unmarshalKvMetadata(metadata.Get(k))
+			if err != nil {
+				return err
+			}
+			if meta.expired(now) {
+				continue
+			}
+			keys = append(keys, strings.TrimPrefix(string(k), namespace))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (s *genericKeyValueStore) Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error) {
+	// Holding the lock ensures the subscription is registered before any
+	// subsequent writes are published.
+	s.store.kvMu.Lock()
+	defer s.store.kvMu.Unlock()
+	return storage.SubscribeKeys(ctx, &s.store.kvEvents, s.prefix, prefix), nil
+}
+
+// keyRevision decodes a previous value for a watch event. If the value
+// cannot be decoded, it is omitted from the event.
+func (s *genericKeyValueStore) keyRevision(ctx context.Context, key []byte, data []byte, meta kvMetadata) *storage.KeyRevision {
+	value, err := s.store.ValueTransformer.TransformFromStorage(ctx, data)
+	if err != nil {
+		value = nil
+	}
+	return &storage.KeyRevision{
+		Key:      string(key),
+		Value:    value,
+		Revision: meta.revision,
+	}
+}
+
+func validateKey(key string) error {
+	// bbolt will reject empty keys, but we always prepend a prefix to the key,
+	// so we need to check for this ourselves
+	if key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+	return nil
+}
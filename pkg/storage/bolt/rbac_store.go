@@ -0,0 +1,229 @@
+package bolt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *BoltStore) CreateRole(ctx context.Context, role *core.Role) error {
+	role = proto.Clone(role).(*core.Role)
+	storage.InitVersionedObject(role)
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		return putVersioned(tx.Bucket(rolesBucket), role.Id, role)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStore) DeleteRole(ctx context.Context, ref *core.Reference) error {
+	if err := s.delete(rolesBucket, ref); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStore) GetRole(ctx context.Context, ref *core.Reference) (*core.Role, error) {
+	var data []byte
+	err := s.DB.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(rolesBucket).Get([]byte(ref.Id)); v != nil {
+			data = append(data, v...)
+			return nil
+		}
+		return storage.ErrNotFound
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	role := &core.Role{}
+	if err := protojson.Unmarshal(data, role); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal role: %w", err)
+	}
+	return role, nil
+}
+
+func (s *BoltStore) UpdateRole(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.RoleMutator,
+) (*core.Role, error) {
+	role := &core.Role{}
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(rolesBucket)
+		data := b.Get([]byte(ref.Id))
+		if data == nil {
+			return storage.ErrNotFound
+		}
+		if err := protojson.Unmarshal(data, role); err != nil {
+			return fmt.Errorf("failed to unmarshal role: %w", err)
+		}
+		if err := storage.ApplyMutator(role, mutator); err != nil {
+			return err
+		}
+		return putVersioned(b, ref.Id, role)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+	return role, nil
+}
+
+func (s *BoltStore) CreateRoleBinding(ctx context.Context, roleBinding *core.RoleBinding) error {
+	roleBinding = proto.Clone(roleBinding).(*core.RoleBinding)
+	storage.InitVersionedObject(roleBinding)
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		return putVersioned(tx.Bucket(roleBindingsBucket), roleBinding.Id, roleBinding)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create role binding: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStore) DeleteRoleBinding(ctx context.Context, ref *core.Reference) error {
+	if err := s.delete(roleBindingsBucket, ref); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete role binding: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStore) GetRoleBinding(ctx context.Context, ref *core.Reference) (*core.RoleBinding, error) {
+	var data []byte
+	err := s.DB.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(roleBindingsBucket).Get([]byte(ref.Id)); v != nil {
+			data = append(data, v...)
+			return nil
+		}
+		return storage.ErrNotFound
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role binding: %w", err)
+	}
+	roleBinding := &core.RoleBinding{}
+	if err := protojson.Unmarshal(data, roleBinding); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal role binding: %w", err)
+	}
+	// Taints are applied outside of the transaction, since they require
+	// looking up the referenced role.
+	if err := storage.ApplyRoleBindingTaints(ctx, s, roleBinding); err != nil {
+		return nil, err
+	}
+	return roleBinding, nil
+}
+
+func (s *BoltStore) UpdateRoleBinding(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.RoleBindingMutator,
+) (*core.RoleBinding, error) {
+	roleBinding := &core.RoleBinding{}
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(roleBindingsBucket)
+		data := b.Get([]byte(ref.Id))
+		if data == nil {
+			return storage.ErrNotFound
+		}
+		if err := protojson.Unmarshal(data, roleBinding); err != nil {
+			return fmt.Errorf("failed to unmarshal role binding: %w", err)
+		}
+		if err := storage.ApplyMutator(roleBinding, mutator); err != nil {
+			return err
+		}
+		roleBinding.Taints = nil
+		return putVersioned(b, ref.Id, roleBinding)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update role binding: %w", err)
+	}
+	if err := storage.ApplyRoleBindingTaints(ctx, s, roleBinding); err != nil {
+		return nil, err
+	}
+	return roleBinding, nil
+}
+
+func (s *BoltStore) ListRoles(ctx context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	page := storage.NewPaginator[*core.Role](options)
+	err = s.DB.View(func(tx *bbolt.Tx) error {
+		return forEachPage(tx.Bucket(rolesBucket), cursor, options, func(k, v []byte) (bool, error) {
+			role := &core.Role{}
+			if err := protojson.Unmarshal(v, role); err != nil {
+				return false, fmt.Errorf("failed to unmarshal role: %w", err)
+			}
+			return page.Add(string(k), role), nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return &core.RoleList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *BoltStore) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	page := storage.NewPaginator[*core.RoleBinding](options)
+	err = s.DB.View(func(tx *bbolt.Tx) error {
+		return forEachPage(tx.Bucket(roleBindingsBucket), cursor, options, func(k, v []byte) (bool, error) {
+			roleBinding := &core.RoleBinding{}
+			if err := protojson.Unmarshal(v, roleBinding); err != nil {
+				return false, fmt.Errorf("failed to decode role binding: %w", err)
+			}
+			return page.Add(string(k), roleBinding), nil
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	for _, roleBinding := range page.Items() {
+		if err := storage.ApplyRoleBindingTaints(ctx, s, roleBinding); err != nil {
+			return nil, err
+		}
+	}
+	return &core.RoleBindingList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *BoltStore) delete(bucket []byte, ref *core.Reference) error {
+	return s.DB.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(bucket)
+		if b.Get([]byte(ref.Id)) == nil {
+			return storage.ErrNotFound
+		}
+		return b.Delete([]byte(ref.Id))
+	})
+}
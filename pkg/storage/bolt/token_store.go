@@ -0,0 +1,193 @@
+package bolt
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func (s *BoltStore) CreateToken(ctx context.Context, ttl time.Duration, opts ...storage.TokenCreateOption) (*core.BootstrapToken, error) {
+	options := storage.NewTokenCreateOptions()
+	options.Apply(opts...)
+
+	token := options.Token.ToBootstrapToken()
+	token.Metadata = &core.BootstrapTokenMetadata{
+		UsageCount:   0,
+		Labels:       options.Labels,
+		Capabilities: options.Capabilities,
+		Limits:       options.Limits,
+	}
+	data, err := protojson.Marshal(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token: %w", err)
+	}
+	expiration := time.Now().Add(ttl)
+	err = s.DB.Update(func(tx *bbolt.Tx) error {
+		if err := tx.Bucket(tokensBucket).Put([]byte(token.TokenID), data); err != nil {
+			return err
+		}
+		return tx.Bucket(tokenExpirationsBucket).Put([]byte(token.TokenID), encodeTime(expiration))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	token.Metadata.Ttl = int64(ttl.Seconds())
+	return token, nil
+}
+
+func (s *BoltStore) DeleteToken(ctx context.Context, ref *core.Reference) error {
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		key := []byte(ref.Id)
+		if tx.Bucket(tokensBucket).Get(key) == nil {
+			return storage.ErrNotFound
+		}
+		return deleteToken(tx, key)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete token: %w", err)
+	}
+	return nil
+}
+
+func (s *BoltStore) GetToken(ctx context.Context, ref *core.Reference) (*core.BootstrapToken, error) {
+	var token *core.BootstrapToken
+	err := s.DB.View(func(tx *bbolt.Tx) error {
+		var err error
+		token, err = getToken(tx, []byte(ref.Id), time.Now())
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	return token, nil
+}
+
+func (s *BoltStore) ListTokens(ctx context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	page := storage.NewPaginator[*core.BootstrapToken](options)
+	// Expired tokens are garbage-collected while listing, so this needs to be
+	// a read-write transaction.
+	err = s.DB.Update(func(tx *bbolt.Tx) error {
+		now := time.Now()
+		expired := [][]byte{}
+		err := forEachPage(tx.Bucket(tokensBucket), cursor, options, func(k, _ []byte) (bool, error) {
+			token, err := getToken(tx, k, now)
+			if err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					expired = append(expired, append([]byte(nil), k...))
+					return true, nil
+				}
+				return false, err
+			}
+			if !options.MatchToken(token) {
+				return true, nil
+			}
+			return page.Add(string(k), token), nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range expired {
+			s.Logger.With(
+				"token", string(k),
+			).Debug("garbage-collecting expired token")
+			if err := deleteToken(tx, k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	return &core.BootstrapTokenList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *BoltStore) UpdateToken(ctx context.Context, ref *core.Reference, mutator storage.MutatorFunc[*core.BootstrapToken]) (*core.BootstrapToken, error) {
+	var token *core.BootstrapToken
+	err := s.DB.Update(func(tx *bbolt.Tx) error {
+		key := []byte(ref.Id)
+		var err error
+		token, err = getToken(tx, key, time.Now())
+		if err != nil {
+			return err
+		}
+		mutator(token)
+		data, err := protojson.Marshal(token)
+		if err != nil {
+			return fmt.Errorf("failed to marshal token: %w", err)
+		}
+		return tx.Bucket(tokensBucket).Put(key, data)
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update token: %w", err)
+	}
+	return token, nil
+}
+
+// getToken looks up a token by ID and fills in its remaining ttl relative
+// to the given time. If the token does not exist or has already expired,
+// storage.ErrNotFound is returned.
+func getToken(tx *bbolt.Tx, key []byte, now time.Time) (*core.BootstrapToken, error) {
+	data := tx.Bucket(tokensBucket).Get(key)
+	if data == nil {
+		return nil, storage.ErrNotFound
+	}
+	ttl := decodeTime(tx.Bucket(tokenExpirationsBucket).Get(key)).Sub(now)
+	if ttl <= 0 {
+		return nil, storage.ErrNotFound
+	}
+	token := &core.BootstrapToken{}
+	if err := protojson.Unmarshal(data, token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	if token.Metadata == nil {
+		token.Metadata = &core.BootstrapTokenMetadata{}
+	}
+	token.Metadata.Ttl = int64(ttl.Round(time.Second).Seconds())
+	return token, nil
+}
+
+func deleteToken(tx *bbolt.Tx, key []byte) error {
+	if err := tx.Bucket(tokensBucket).Delete(key); err != nil {
+		return err
+	}
+	return tx.Bucket(tokenExpirationsBucket).Delete(key)
+}
+
+func encodeTime(t time.Time) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(t.UnixNano()))
+	return buf
+}
+
+func decodeTime(data []byte) time.Time {
+	if len(data) != 8 {
+		return time.Time{}
+	}
+	return time.Unix(0, int64(binary.BigEndian.Uint64(data)))
+}
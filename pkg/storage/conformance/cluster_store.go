@@ -2,6 +2,7 @@ package conformance
 
 import (
 	"context"
+	"fmt"
 	"runtime"
 	"strconv"
 	"sync"
@@ -14,6 +15,8 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/test/testutil"
 	"github.com/rancher/opni-monitoring/pkg/util"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ClusterStoreTestSuite[T storage.ClusterStore](
@@ -246,6 +249,247 @@ func ClusterStoreTestSuite[T storage.ClusterStore](
 			Expect(err).NotTo(HaveOccurred())
 			Expect(cluster.Metadata.Labels).To(HaveKeyWithValue("value", strconv.Itoa(count)))
 		})
+		It("should track resource versions and timestamps", func() {
+			cluster := &core.Cluster{
+				Id: uuid.NewString(),
+			}
+			err := ts.CreateCluster(context.Background(), cluster)
+			Expect(err).NotTo(HaveOccurred())
+
+			created, err := ts.GetCluster(context.Background(), cluster.Reference())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.GetResourceVersion()).NotTo(BeEmpty())
+			Expect(created.GetCreationTimestamp()).NotTo(BeNil())
+			Expect(created.GetLastModified()).NotTo(BeNil())
+
+			updated, err := ts.UpdateCluster(context.Background(), cluster.Reference(), func(c *core.Cluster) {
+				c.SetLabels(map[string]string{"foo": "bar"})
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.GetResourceVersion()).NotTo(BeEmpty())
+			Expect(updated.GetResourceVersion()).NotTo(Equal(created.GetResourceVersion()))
+			Expect(updated.GetCreationTimestamp().AsTime()).To(Equal(created.GetCreationTimestamp().AsTime()))
+			Expect(updated.GetLastModified().AsTime()).NotTo(BeTemporally("<", created.GetLastModified().AsTime()))
+
+			current, err := ts.GetCluster(context.Background(), cluster.Reference())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current.GetResourceVersion()).To(Equal(updated.GetResourceVersion()))
+		})
+		It("should reject updates with a stale resource version", func() {
+			cluster := &core.Cluster{
+				Id: uuid.NewString(),
+			}
+			err := ts.CreateCluster(context.Background(), cluster)
+			Expect(err).NotTo(HaveOccurred())
+			created, err := ts.GetCluster(context.Background(), cluster.Reference())
+			Expect(err).NotTo(HaveOccurred())
+
+			updated, err := ts.UpdateCluster(context.Background(), cluster.Reference(),
+				storage.NewCompositeMutator(
+					storage.NewCompareAndSwapMutator[*core.Cluster](created.GetResourceVersion()),
+					func(c *core.Cluster) {
+						c.SetLabels(map[string]string{"foo": "bar"})
+					},
+				),
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.GetLabels()).To(HaveKeyWithValue("foo", "bar"))
+
+			_, err = ts.UpdateCluster(context.Background(), cluster.Reference(),
+				storage.NewCompositeMutator(
+					storage.NewCompareAndSwapMutator[*core.Cluster](created.GetResourceVersion()),
+					func(c *core.Cluster) {
+						c.SetLabels(map[string]string{"foo": "baz"})
+					},
+				),
+			)
+			Expect(err).To(MatchError(storage.ErrConflict))
+
+			current, err := ts.GetCluster(context.Background(), cluster.Reference())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(current.GetLabels()).To(HaveKeyWithValue("foo", "bar"))
+			Expect(current.GetResourceVersion()).To(Equal(updated.GetResourceVersion()))
+		})
+		It("should persist the approval state", func() {
+			requested := timestamppb.New(time.Unix(1700000000, 0))
+			cluster := &core.Cluster{
+				Id: uuid.NewString(),
+				Metadata: &core.ClusterMetadata{
+					Approval: &core.ClusterApproval{
+						State:               core.ApprovalState_ClusterPendingApproval,
+						RequestedCapability: "foo",
+						TokenID:             "token-id",
+						Address:             "10.0.0.1",
+						RequestTimestamp:    requested,
+					},
+				},
+			}
+			Expect(ts.CreateCluster(context.Background(), cluster)).To(Succeed())
+
+			stored, err := ts.GetCluster(context.Background(), cluster.Reference())
+			Expect(err).NotTo(HaveOccurred())
+			approval := stored.GetMetadata().GetApproval()
+			Expect(approval.GetState()).To(Equal(core.ApprovalState_ClusterPendingApproval))
+			Expect(approval.GetRequestedCapability()).To(Equal("foo"))
+			Expect(approval.GetTokenID()).To(Equal("token-id"))
+			Expect(approval.GetAddress()).To(Equal("10.0.0.1"))
+			Expect(approval.GetRequestTimestamp().AsTime()).To(BeTemporally("==", requested.AsTime()))
+
+			Expect(ts.DeleteCluster(context.Background(), cluster.Reference())).To(Succeed())
+		})
+		It("should list clusters in pages", func() {
+			selector := &core.LabelSelector{
+				MatchLabels: map[string]string{
+					"paging": "true",
+				},
+			}
+			var ids []string
+			for i := 0; i < 5; i++ {
+				cluster := &core.Cluster{
+					Id: fmt.Sprintf("paging-%02d", i),
+					Metadata: &core.ClusterMetadata{
+						Labels: selector.MatchLabels,
+					},
+				}
+				if i%2 == 0 {
+					cluster.Metadata.Capabilities = []*core.ClusterCapability{
+						{Name: "even"},
+					}
+				}
+				err := ts.CreateCluster(context.Background(), cluster)
+				Expect(err).NotTo(HaveOccurred())
+				ids = append(ids, cluster.Id)
+			}
+			listAll := func(opts ...storage.ListOption) [][]string {
+				var pages [][]string
+				token := ""
+				for {
+					list, err := ts.ListClusters(context.Background(), selector, 0,
+						append(opts, storage.WithPageToken(token))...)
+					Expect(err).NotTo(HaveOccurred())
+					var page []string
+					for _, c := range list.Items {
+						page = append(page, c.GetId())
+					}
+					pages = append(pages, page)
+					if list.NextPageToken == "" {
+						return pages
+					}
+					token = list.NextPageToken
+				}
+			}
+
+			Expect(listAll()).To(Equal([][]string{ids}))
+			Expect(listAll(storage.WithPageSize(2))).To(Equal([][]string{
+				{ids[0], ids[1]},
+				{ids[2], ids[3]},
+				{ids[4]},
+			}))
+			Expect(listAll(storage.WithPageSize(2), storage.WithSortOrder(core.SortOrder_Descending))).To(Equal([][]string{
+				{ids[4], ids[3]},
+				{ids[2], ids[1]},
+				{ids[0]},
+			}))
+			Expect(listAll(storage.WithPageSize(2), storage.WithCapabilityFilter("even"))).To(Equal([][]string{
+				{ids[0], ids[2]},
+				{ids[4]},
+			}))
+
+			_, err := ts.ListClusters(context.Background(), selector, 0, storage.WithPageToken("not a token!"))
+			Expect(err).To(MatchError(storage.ErrInvalidPageToken))
+
+			for _, id := range ids {
+				err := ts.DeleteCluster(context.Background(), &core.Reference{Id: id})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+		When("watching clusters", func() {
+			It("should receive create, update, and delete events", func() {
+				ctx, ca := context.WithCancel(context.Background())
+				defer ca()
+				existing, err := ts.ListClusters(context.Background(), nil, 0)
+				Expect(err).NotTo(HaveOccurred())
+				events, err := ts.WatchClusters(ctx, existing.Items)
+				Expect(err).NotTo(HaveOccurred())
+
+				cluster := &core.Cluster{
+					Id: uuid.NewString(),
+					Metadata: &core.ClusterMetadata{
+						Labels: map[string]string{
+							"foo": "bar",
+						},
+					},
+				}
+				Expect(ts.CreateCluster(context.Background(), cluster)).To(Succeed())
+				var event storage.WatchEvent[*core.Cluster]
+				Eventually(events, 10*time.Second).Should(Receive(&event))
+				Expect(event.EventType).To(Equal(storage.WatchEventCreate))
+				Expect(event.Current.Id).To(Equal(cluster.Id))
+				Expect(event.Current.Metadata.Labels).To(HaveKeyWithValue("foo", "bar"))
+
+				_, err = ts.UpdateCluster(context.Background(), cluster.Reference(), func(c *core.Cluster) {
+					c.Metadata.Labels["foo"] = "baz"
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(events, 10*time.Second).Should(Receive(&event))
+				Expect(event.EventType).To(Equal(storage.WatchEventUpdate))
+				Expect(event.Current.Id).To(Equal(cluster.Id))
+				Expect(event.Current.Metadata.Labels).To(HaveKeyWithValue("foo", "baz"))
+				Expect(event.Previous.Metadata.Labels).To(HaveKeyWithValue("foo", "bar"))
+
+				Expect(ts.DeleteCluster(context.Background(), cluster.Reference())).To(Succeed())
+				Eventually(events, 10*time.Second).Should(Receive(&event))
+				Expect(event.EventType).To(Equal(storage.WatchEventDelete))
+				Expect(event.Previous.Id).To(Equal(cluster.Id))
+
+				ca()
+				Eventually(events, 10*time.Second).Should(BeClosed())
+			})
+			It("should send events for differences from the known clusters", func() {
+				existing, err := ts.ListClusters(context.Background(), nil, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(existing.Items)).To(BeNumerically(">=", 2))
+
+				added := existing.Items[0]
+				modified := proto.Clone(existing.Items[1]).(*core.Cluster)
+				if modified.Metadata == nil {
+					modified.Metadata = &core.ClusterMetadata{}
+				}
+				modified.Metadata.Labels = map[string]string{
+					"outdated": "true",
+				}
+				deleted := &core.Cluster{
+					Id: uuid.NewString(),
+				}
+				known := append([]*core.Cluster{modified, deleted}, existing.Items[2:]...)
+
+				ctx, ca := context.WithCancel(context.Background())
+				defer ca()
+				events, err := ts.WatchClusters(ctx, known)
+				Expect(err).NotTo(HaveOccurred())
+
+				received := map[string]storage.WatchEvent[*core.Cluster]{}
+				for i := 0; i < 3; i++ {
+					var event storage.WatchEvent[*core.Cluster]
+					Eventually(events, 10*time.Second).Should(Receive(&event))
+					switch event.EventType {
+					case storage.WatchEventDelete:
+						received[event.Previous.Id] = event
+					default:
+						received[event.Current.Id] = event
+					}
+				}
+				Consistently(events, 500*time.Millisecond).ShouldNot(Receive())
+
+				Expect(received).To(HaveKey(added.Id))
+				Expect(received[added.Id].EventType).To(Equal(storage.WatchEventCreate))
+				Expect(received).To(HaveKey(modified.Id))
+				Expect(received[modified.Id].EventType).To(Equal(storage.WatchEventUpdate))
+				Expect(received[modified.Id].Previous.Metadata.Labels).To(HaveKeyWithValue("outdated", "true"))
+				Expect(received).To(HaveKey(deleted.Id))
+				Expect(received[deleted.Id].EventType).To(Equal(storage.WatchEventDelete))
+			})
+		})
 		Context("error handling", func() {
 			if runtime.GOOS != "linux" {
 				Skip("skipping tests on non-linux OS")
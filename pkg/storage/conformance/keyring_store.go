@@ -131,6 +131,63 @@ func KeyringStoreTestSuite[T storage.KeyringStoreBroker](
 			Expect(err).NotTo(HaveOccurred())
 			Expect(kr2).To(Equal(kr))
 		})
+		It("should atomically apply concurrent updates", func() {
+			kr := keyring.New(sharedKeys())
+			Expect(ts.Put(context.Background(), kr)).To(Succeed())
+
+			count := testutil.IfCI(5).Else(10)
+			var wg sync.WaitGroup
+			start := make(chan struct{})
+			for i := 0; i < count; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					<-start
+					_, err := ts.Update(context.Background(), func(kr keyring.Keyring) (keyring.Keyring, error) {
+						return kr.Merge(keyring.New(pkpKey(1))), nil
+					})
+					Expect(err).NotTo(HaveOccurred())
+				}()
+			}
+			close(start)
+			wg.Wait()
+
+			kr2, err := ts.Get(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			numKeys := 0
+			kr2.ForEach(func(any) {
+				numKeys++
+			})
+			Expect(numKeys).To(Equal(count + 1))
+		})
+		It("should not modify the keyring if the mutator fails", func() {
+			kr := keyring.New(sharedKeys())
+			Expect(ts.Put(context.Background(), kr)).To(Succeed())
+			_, err := ts.Update(context.Background(), func(keyring.Keyring) (keyring.Keyring, error) {
+				return nil, fmt.Errorf("test error")
+			})
+			Expect(err).To(MatchError("test error"))
+			kr2, err := ts.Get(context.Background())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(kr2).To(Equal(kr))
+		})
+		It("should delete the keyring", func() {
+			Expect(ts.Put(context.Background(), keyring.New(sharedKeys()))).To(Succeed())
+			Expect(ts.Delete(context.Background())).To(Succeed())
+			_, err := ts.Get(context.Background())
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
+		It("should return ErrNotFound when deleting a keyring that does not exist", func() {
+			err := ts.Delete(context.Background())
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
+		It("should return ErrNotFound when updating a keyring that does not exist", func() {
+			_, err := ts.Update(context.Background(), func(kr keyring.Keyring) (keyring.Keyring, error) {
+				return kr, nil
+			})
+			Expect(err).To(MatchError(storage.ErrNotFound))
+		})
 		It("should handle errors", func() {
 			errCtrl.EnableErrors()
 			defer errCtrl.DisableErrors()
@@ -56,6 +56,175 @@ func KeyValueStoreTestSuite[T storage.KeyValueStoreBroker](
 			Expect(err).NotTo(HaveOccurred())
 			Expect(keys).To(BeEmpty())
 		})
+		Context("revisions", func() {
+			var revision int64
+			It("should return the revision of a key when it is written", func() {
+				err := ts.Put(context.Background(), "rev", []byte("1"), storage.WithRevisionOut(&revision))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revision).To(BeNumerically(">", 0))
+
+				var getRevision int64
+				value, err := ts.Get(context.Background(), "rev", storage.WithRevisionOut(&getRevision))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal([]byte("1")))
+				Expect(getRevision).To(Equal(revision))
+			})
+			It("should increase the revision when a key is updated", func() {
+				var newRevision int64
+				err := ts.Put(context.Background(), "rev", []byte("2"), storage.WithRevisionOut(&newRevision))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(newRevision).To(BeNumerically(">", revision))
+				revision = newRevision
+			})
+			It("should only update a key if its revision matches", func() {
+				err := ts.Put(context.Background(), "rev", []byte("3"), storage.WithRevision(revision-1))
+				Expect(err).To(MatchError(storage.ErrConflict))
+
+				value, err := ts.Get(context.Background(), "rev")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal([]byte("2")))
+
+				err = ts.Put(context.Background(), "rev", []byte("3"),
+					storage.WithRevision(revision),
+					storage.WithRevisionOut(&revision),
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				value, err = ts.Get(context.Background(), "rev")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal([]byte("3")))
+			})
+			It("should only create a key with revision 0 if it does not exist", func() {
+				err := ts.Put(context.Background(), "rev", []byte("4"), storage.WithRevision(0))
+				Expect(err).To(MatchError(storage.ErrConflict))
+
+				err = ts.Put(context.Background(), "rev2", []byte("1"), storage.WithRevision(0))
+				Expect(err).NotTo(HaveOccurred())
+			})
+			It("should only delete a key if its revision matches", func() {
+				err := ts.Delete(context.Background(), "rev", storage.WithRevision(revision-1))
+				Expect(err).To(MatchError(storage.ErrConflict))
+
+				_, err = ts.Get(context.Background(), "rev")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = ts.Delete(context.Background(), "rev", storage.WithRevision(revision))
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = ts.Get(context.Background(), "rev")
+				Expect(err).To(MatchError(storage.ErrNotFound))
+
+				Expect(ts.Delete(context.Background(), "rev2")).To(Succeed())
+			})
+		})
+		Context("TTLs", func() {
+			It("should expire keys after their TTL", func() {
+				err := ts.Put(context.Background(), "ttl", []byte("1"), storage.WithTTL(time.Second))
+				Expect(err).NotTo(HaveOccurred())
+
+				value, err := ts.Get(context.Background(), "ttl")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal([]byte("1")))
+
+				Eventually(func() error {
+					_, err := ts.Get(context.Background(), "ttl")
+					return err
+				}, 10*time.Second, 100*time.Millisecond).Should(MatchError(storage.ErrNotFound))
+
+				keys, err := ts.ListKeys(context.Background(), "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(BeEmpty())
+			})
+			It("should treat expired keys as non-existent when writing", func() {
+				err := ts.Delete(context.Background(), "ttl")
+				Expect(err).To(MatchError(storage.ErrNotFound))
+
+				err = ts.Put(context.Background(), "ttl", []byte("2"), storage.WithRevision(0))
+				Expect(err).NotTo(HaveOccurred())
+
+				value, err := ts.Get(context.Background(), "ttl")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(value).To(Equal([]byte("2")))
+
+				Expect(ts.Delete(context.Background(), "ttl")).To(Succeed())
+			})
+			It("should return the remaining TTL of a key", func() {
+				err := ts.Put(context.Background(), "ttl", []byte("1"), storage.WithTTL(time.Minute))
+				Expect(err).NotTo(HaveOccurred())
+
+				var ttl time.Duration
+				_, err = ts.Get(context.Background(), "ttl", storage.WithTTLOut(&ttl))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ttl).To(BeNumerically(">", 0))
+				Expect(ttl).To(BeNumerically("<=", time.Minute))
+
+				err = ts.Put(context.Background(), "ttl", []byte("2"))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = ts.Get(context.Background(), "ttl", storage.WithTTLOut(&ttl))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ttl).To(BeZero())
+
+				Expect(ts.Delete(context.Background(), "ttl")).To(Succeed())
+			})
+		})
+		Context("watching keys", func() {
+			var ctx context.Context
+			var ca context.CancelFunc
+			var events <-chan storage.KeyValueWatchEvent
+			BeforeAll(func() {
+				ctx, ca = context.WithCancel(context.Background())
+				var err error
+				events, err = ts.Watch(ctx, "watch/")
+				Expect(err).NotTo(HaveOccurred())
+			})
+			AfterAll(func() {
+				ca()
+				Eventually(events).Should(BeClosed())
+			})
+			It("should send an event when a key is created", func() {
+				var revision int64
+				err := ts.Put(context.Background(), "watch/a", []byte("1"), storage.WithRevisionOut(&revision))
+				Expect(err).NotTo(HaveOccurred())
+
+				var event storage.KeyValueWatchEvent
+				Eventually(events, 10*time.Second).Should(Receive(&event))
+				Expect(event.EventType).To(Equal(storage.WatchEventCreate))
+				Expect(event.Current.Key).To(Equal("watch/a"))
+				Expect(event.Current.Value).To(Equal([]byte("1")))
+				Expect(event.Current.Revision).To(Equal(revision))
+				Expect(event.Previous).To(BeNil())
+			})
+			It("should send an event when a key is updated", func() {
+				err := ts.Put(context.Background(), "watch/a", []byte("2"))
+				Expect(err).NotTo(HaveOccurred())
+
+				var event storage.KeyValueWatchEvent
+				Eventually(events, 10*time.Second).Should(Receive(&event))
+				Expect(event.EventType).To(Equal(storage.WatchEventUpdate))
+				Expect(event.Current.Key).To(Equal("watch/a"))
+				Expect(event.Current.Value).To(Equal([]byte("2")))
+				Expect(event.Previous.Key).To(Equal("watch/a"))
+				Expect(event.Previous.Value).To(Equal([]byte("1")))
+			})
+			It("should not send events for keys outside the prefix", func() {
+				err := ts.Put(context.Background(), "other", []byte("1"))
+				Expect(err).NotTo(HaveOccurred())
+				Consistently(events, 500*time.Millisecond).ShouldNot(Receive())
+				Expect(ts.Delete(context.Background(), "other")).To(Succeed())
+				Consistently(events, 500*time.Millisecond).ShouldNot(Receive())
+			})
+			It("should send an event when a key is deleted", func() {
+				err := ts.Delete(context.Background(), "watch/a")
+				Expect(err).NotTo(HaveOccurred())
+
+				var event storage.KeyValueWatchEvent
+				Eventually(events, 10*time.Second).Should(Receive(&event))
+				Expect(event.EventType).To(Equal(storage.WatchEventDelete))
+				Expect(event.Current).To(BeNil())
+				Expect(event.Previous.Key).To(Equal("watch/a"))
+				Expect(event.Previous.Value).To(Equal([]byte("2")))
+			})
+		})
 		Context("error handling", func() {
 			It("should return an error when deleting a non-existent key", func() {
 				err := ts.Delete(context.Background(), "foo")
@@ -2,6 +2,7 @@ package conformance
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -50,6 +51,42 @@ func RBACStoreTestSuite[T storage.RBACStore](
 					Expect(roles.Items[0].GetId()).To(Equal("foo"))
 				})
 			})
+			It("should update roles", func() {
+				role, err := ts.GetRole(context.Background(), &core.Reference{
+					Id: "foo",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(role.GetResourceVersion()).NotTo(BeEmpty())
+				Expect(role.GetCreationTimestamp()).NotTo(BeNil())
+
+				updated, err := ts.UpdateRole(context.Background(), role.Reference(),
+					storage.NewCompositeMutator(
+						storage.NewCompareAndSwapMutator[*core.Role](role.GetResourceVersion()),
+						func(r *core.Role) {
+							r.ClusterIDs = []string{"bar"}
+						},
+					),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated.ClusterIDs).To(Equal([]string{"bar"}))
+				Expect(updated.GetResourceVersion()).NotTo(Equal(role.GetResourceVersion()))
+				Expect(updated.GetCreationTimestamp().AsTime()).To(Equal(role.GetCreationTimestamp().AsTime()))
+
+				_, err = ts.UpdateRole(context.Background(), role.Reference(),
+					storage.NewCompareAndSwapMutator[*core.Role](role.GetResourceVersion()),
+				)
+				Expect(err).To(MatchError(storage.ErrConflict))
+
+				current, err := ts.GetRole(context.Background(), role.Reference())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(current.ClusterIDs).To(Equal([]string{"bar"}))
+				Expect(current.GetResourceVersion()).To(Equal(updated.GetResourceVersion()))
+
+				_, err = ts.UpdateRole(context.Background(), &core.Reference{
+					Id: uuid.NewString(),
+				}, func(r *core.Role) {})
+				Expect(err).To(MatchError(storage.ErrNotFound))
+			})
 			It("should delete roles", func() {
 				all, err := ts.ListRoles(context.Background())
 				Expect(err).NotTo(HaveOccurred())
@@ -63,6 +100,54 @@ func RBACStoreTestSuite[T storage.RBACStore](
 				Expect(err).NotTo(HaveOccurred())
 				Expect(all.Items).To(BeEmpty())
 			})
+			It("should list roles in pages", func() {
+				var ids []string
+				for i := 0; i < 5; i++ {
+					id := fmt.Sprintf("paging-%02d", i)
+					err := ts.CreateRole(context.Background(), &core.Role{
+						Id: id,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					ids = append(ids, id)
+				}
+				listAll := func(opts ...storage.ListOption) [][]string {
+					var pages [][]string
+					token := ""
+					for {
+						list, err := ts.ListRoles(context.Background(), append(opts, storage.WithPageToken(token))...)
+						Expect(err).NotTo(HaveOccurred())
+						var page []string
+						for _, item := range list.Items {
+							page = append(page, item.GetId())
+						}
+						pages = append(pages, page)
+						if list.NextPageToken == "" {
+							return pages
+						}
+						token = list.NextPageToken
+					}
+				}
+
+				Expect(listAll()).To(Equal([][]string{ids}))
+				Expect(listAll(storage.WithPageSize(2))).To(Equal([][]string{
+					{ids[0], ids[1]},
+					{ids[2], ids[3]},
+					{ids[4]},
+				}))
+				Expect(listAll(storage.WithPageSize(2), storage.WithSortOrder(core.SortOrder_Descending))).To(Equal([][]string{
+					{ids[4], ids[3]},
+					{ids[2], ids[1]},
+					{ids[0]},
+				}))
+
+				_, err := ts.ListRoles(context.Background(), storage.WithPageToken("not a token!"))
+				Expect(err).To(MatchError(storage.ErrInvalidPageToken))
+
+				for _, id := range ids {
+					err := ts.DeleteRole(context.Background(), &core.Reference{Id: id})
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
 			Context("error handling", func() {
 				It("should handle errors when creating a role", func() {
 					errCtrl.EnableErrors()
@@ -137,6 +222,42 @@ func RBACStoreTestSuite[T storage.RBACStore](
 					Expect(rbs.Items[0].GetId()).To(Equal("foo"))
 				})
 			})
+			It("should update role bindings", func() {
+				rb, err := ts.GetRoleBinding(context.Background(), &core.Reference{
+					Id: "foo",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(rb.GetResourceVersion()).NotTo(BeEmpty())
+				Expect(rb.GetCreationTimestamp()).NotTo(BeNil())
+
+				updated, err := ts.UpdateRoleBinding(context.Background(), rb.Reference(),
+					storage.NewCompositeMutator(
+						storage.NewCompareAndSwapMutator[*core.RoleBinding](rb.GetResourceVersion()),
+						func(r *core.RoleBinding) {
+							r.Subjects = []string{"bar"}
+						},
+					),
+				)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated.Subjects).To(Equal([]string{"bar"}))
+				Expect(updated.GetResourceVersion()).NotTo(Equal(rb.GetResourceVersion()))
+				Expect(updated.GetCreationTimestamp().AsTime()).To(Equal(rb.GetCreationTimestamp().AsTime()))
+
+				_, err = ts.UpdateRoleBinding(context.Background(), rb.Reference(),
+					storage.NewCompareAndSwapMutator[*core.RoleBinding](rb.GetResourceVersion()),
+				)
+				Expect(err).To(MatchError(storage.ErrConflict))
+
+				current, err := ts.GetRoleBinding(context.Background(), rb.Reference())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(current.Subjects).To(Equal([]string{"bar"}))
+				Expect(current.GetResourceVersion()).To(Equal(updated.GetResourceVersion()))
+
+				_, err = ts.UpdateRoleBinding(context.Background(), &core.Reference{
+					Id: uuid.NewString(),
+				}, func(r *core.RoleBinding) {})
+				Expect(err).To(MatchError(storage.ErrNotFound))
+			})
 			It("should delete role bindings", func() {
 				all, err := ts.ListRoleBindings(context.Background())
 				Expect(err).NotTo(HaveOccurred())
@@ -150,6 +271,54 @@ func RBACStoreTestSuite[T storage.RBACStore](
 				Expect(err).NotTo(HaveOccurred())
 				Expect(all.Items).To(BeEmpty())
 			})
+			It("should list role bindings in pages", func() {
+				var ids []string
+				for i := 0; i < 5; i++ {
+					id := fmt.Sprintf("paging-%02d", i)
+					err := ts.CreateRoleBinding(context.Background(), &core.RoleBinding{
+						Id: id,
+					})
+					Expect(err).NotTo(HaveOccurred())
+					ids = append(ids, id)
+				}
+				listAll := func(opts ...storage.ListOption) [][]string {
+					var pages [][]string
+					token := ""
+					for {
+						list, err := ts.ListRoleBindings(context.Background(), append(opts, storage.WithPageToken(token))...)
+						Expect(err).NotTo(HaveOccurred())
+						var page []string
+						for _, item := range list.Items {
+							page = append(page, item.GetId())
+						}
+						pages = append(pages, page)
+						if list.NextPageToken == "" {
+							return pages
+						}
+						token = list.NextPageToken
+					}
+				}
+
+				Expect(listAll()).To(Equal([][]string{ids}))
+				Expect(listAll(storage.WithPageSize(2))).To(Equal([][]string{
+					{ids[0], ids[1]},
+					{ids[2], ids[3]},
+					{ids[4]},
+				}))
+				Expect(listAll(storage.WithPageSize(2), storage.WithSortOrder(core.SortOrder_Descending))).To(Equal([][]string{
+					{ids[4], ids[3]},
+					{ids[2], ids[1]},
+					{ids[0]},
+				}))
+
+				_, err := ts.ListRoleBindings(context.Background(), storage.WithPageToken("not a token!"))
+				Expect(err).To(MatchError(storage.ErrInvalidPageToken))
+
+				for _, id := range ids {
+					err := ts.DeleteRoleBinding(context.Background(), &core.Reference{Id: id})
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
 			Context("error handling", func() {
 				It("should handle errors when creating a role binding", func() {
 					errCtrl.EnableErrors()
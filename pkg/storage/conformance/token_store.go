@@ -3,6 +3,7 @@ package conformance
 import (
 	"context"
 	"runtime"
+	"sort"
 	"sync"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/test/testutil"
+	"github.com/rancher/opni-monitoring/pkg/tokens"
 	"github.com/rancher/opni-monitoring/pkg/util"
 )
 
@@ -29,7 +31,7 @@ func TokenStoreTestSuite[T storage.TokenStore](
 		It("should initially have no tokens", func() {
 			tokens, err := ts.ListTokens(context.Background())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(tokens).To(BeEmpty())
+			Expect(tokens.Items).To(BeEmpty())
 		})
 		When("creating a token", func() {
 			var ref *core.Reference
@@ -48,8 +50,8 @@ func TokenStoreTestSuite[T storage.TokenStore](
 			It("should appear in the list of tokens", func() {
 				tokens, err := ts.ListTokens(context.Background())
 				Expect(err).NotTo(HaveOccurred())
-				Expect(tokens).To(HaveLen(1))
-				Expect(tokens[0].GetTokenID()).To(Equal(ref.Id))
+				Expect(tokens.Items).To(HaveLen(1))
+				Expect(tokens.Items[0].GetTokenID()).To(Equal(ref.Id))
 			})
 			It("should be retrievable by ID", func() {
 				tk, err := ts.GetToken(context.Background(), ref)
@@ -79,7 +81,7 @@ func TokenStoreTestSuite[T storage.TokenStore](
 
 			list, err := ts.ListTokens(context.Background())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(list).To(HaveLen(2))
+			Expect(list.Items).To(HaveLen(2))
 
 			check = func(tk *core.BootstrapToken) {
 				Expect(tk).NotTo(BeNil())
@@ -106,7 +108,87 @@ func TokenStoreTestSuite[T storage.TokenStore](
 
 			list, err = ts.ListTokens(context.Background())
 			Expect(err).NotTo(HaveOccurred())
-			Expect(list).To(HaveLen(3))
+			Expect(list.Items).To(HaveLen(3))
+
+			existing := tokens.NewToken()
+			tk, err = ts.CreateToken(context.Background(), time.Hour, storage.WithToken(existing))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tk.TokenID).To(Equal(existing.HexID()))
+			Expect(tk.Secret).To(Equal(existing.HexSecret()))
+
+			tk, err = ts.GetToken(context.Background(), tk.Reference())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tk.TokenID).To(Equal(existing.HexID()))
+			Expect(tk.Secret).To(Equal(existing.HexSecret()))
+		})
+		It("should persist token limits", func() {
+			tk, err := ts.CreateToken(context.Background(), time.Hour, storage.WithLimits(&core.TokenLimits{
+				MaxUsages:    2,
+				AllowedCIDRs: []string{"10.0.0.0/8"},
+				OneTime:      true,
+			}))
+			Expect(err).NotTo(HaveOccurred())
+
+			tk, err = ts.GetToken(context.Background(), tk.Reference())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tk.GetMetadata().GetLimits().GetMaxUsages()).To(BeEquivalentTo(2))
+			Expect(tk.GetMetadata().GetLimits().GetAllowedCIDRs()).To(Equal([]string{"10.0.0.0/8"}))
+			Expect(tk.GetMetadata().GetLimits().GetOneTime()).To(BeTrue())
+
+			Expect(ts.DeleteToken(context.Background(), tk.Reference())).To(Succeed())
+		})
+		It("should list tokens in pages", func() {
+			var ids []string
+			for i := 0; i < 5; i++ {
+				tk, err := ts.CreateToken(context.Background(), time.Hour, storage.WithCapabilities(
+					[]*core.TokenCapability{
+						{
+							Type:      "paging",
+							Reference: &core.Reference{Id: "test"},
+						},
+					},
+				))
+				Expect(err).NotTo(HaveOccurred())
+				ids = append(ids, tk.TokenID)
+			}
+			sort.Strings(ids)
+			listAll := func(opts ...storage.ListOption) [][]string {
+				var pages [][]string
+				token := ""
+				for {
+					list, err := ts.ListTokens(context.Background(),
+						append(opts, storage.WithCapabilityFilter("paging"), storage.WithPageToken(token))...)
+					Expect(err).NotTo(HaveOccurred())
+					var page []string
+					for _, tk := range list.Items {
+						page = append(page, tk.GetTokenID())
+					}
+					pages = append(pages, page)
+					if list.NextPageToken == "" {
+						return pages
+					}
+					token = list.NextPageToken
+				}
+			}
+
+			Expect(listAll()).To(Equal([][]string{ids}))
+			Expect(listAll(storage.WithPageSize(2))).To(Equal([][]string{
+				{ids[0], ids[1]},
+				{ids[2], ids[3]},
+				{ids[4]},
+			}))
+			Expect(listAll(storage.WithPageSize(3), storage.WithSortOrder(core.SortOrder_Descending))).To(Equal([][]string{
+				{ids[4], ids[3], ids[2]},
+				{ids[1], ids[0]},
+			}))
+
+			_, err := ts.ListTokens(context.Background(), storage.WithPageToken("not a token!"))
+			Expect(err).To(MatchError(storage.ErrInvalidPageToken))
+
+			for _, id := range ids {
+				err := ts.DeleteToken(context.Background(), &core.Reference{Id: id})
+				Expect(err).NotTo(HaveOccurred())
+			}
 		})
 		When("deleting a token", func() {
 			When("the token exists", func() {
@@ -122,7 +204,7 @@ func TokenStoreTestSuite[T storage.TokenStore](
 
 					after, err := ts.ListTokens(context.Background())
 					Expect(err).NotTo(HaveOccurred())
-					Expect(after).To(HaveLen(len(before) - 1))
+					Expect(after.Items).To(HaveLen(len(before.Items) - 1))
 
 					_, err = ts.GetToken(context.Background(), tk.Reference())
 					Expect(err).To(MatchError(storage.ErrNotFound))
@@ -140,7 +222,7 @@ func TokenStoreTestSuite[T storage.TokenStore](
 
 					after, err := ts.ListTokens(context.Background())
 					Expect(err).NotTo(HaveOccurred())
-					Expect(after).To(HaveLen(len(before)))
+					Expect(after.Items).To(HaveLen(len(before.Items)))
 				})
 			})
 		})
@@ -2,14 +2,19 @@ package crds
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/sdk/api/v1beta1"
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/protobuf/proto"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+	toolscache "k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -25,6 +30,8 @@ var (
 )
 
 func (c *CRDStore) CreateCluster(ctx context.Context, cluster *core.Cluster) error {
+	cluster = proto.Clone(cluster).(*core.Cluster)
+	storage.InitVersionedObject(cluster)
 	return c.client.Create(ctx, &v1beta1.Cluster{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      cluster.Id,
@@ -56,7 +63,7 @@ func (c *CRDStore) GetCluster(ctx context.Context, ref *core.Reference) (*core.C
 		}
 		return nil, err
 	}
-	return cluster.Spec, nil
+	return specWithVersion(cluster.Spec, cluster.ResourceVersion), nil
 }
 
 func (c *CRDStore) UpdateCluster(ctx context.Context, ref *core.Reference, mutator storage.MutatorFunc[*core.Cluster]) (*core.Cluster, error) {
@@ -71,9 +78,17 @@ func (c *CRDStore) UpdateCluster(ctx context.Context, ref *core.Reference, mutat
 			return err
 		}
 		clone := existing.DeepCopy()
-		mutator(clone.Spec)
-		cluster = clone.Spec
-		return c.client.Update(ctx, clone)
+		spec := specWithVersion(clone.Spec, existing.ResourceVersion)
+		if err := storage.ApplyMutator(spec, mutator); err != nil {
+			return err
+		}
+		spec.SetResourceVersion("")
+		clone.Spec = spec
+		if err := c.client.Update(ctx, clone); err != nil {
+			return err
+		}
+		cluster = specWithVersion(clone.Spec, clone.ResourceVersion)
+		return nil
 	})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
@@ -84,7 +99,14 @@ func (c *CRDStore) UpdateCluster(ctx context.Context, ref *core.Reference, mutat
 	return cluster, nil
 }
 
-func (c *CRDStore) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error) {
+func (c *CRDStore) ListClusters(
+	ctx context.Context,
+	matchLabels *core.LabelSelector,
+	matchOptions core.MatchOptions,
+	opts ...storage.ListOption,
+) (*core.ClusterList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
 	list := &v1beta1.ClusterList{}
 	err := c.client.List(ctx, list, client.InNamespace(c.namespace))
 	if err != nil {
@@ -94,13 +116,143 @@ func (c *CRDStore) ListClusters(ctx context.Context, matchLabels *core.LabelSele
 		LabelSelector: matchLabels,
 		MatchOptions:  matchOptions,
 	}.Predicate()
-	clusters := &core.ClusterList{
-		Items: make([]*core.Cluster, 0, len(list.Items)),
-	}
+	items := make([]*core.Cluster, 0, len(list.Items))
 	for _, item := range list.Items {
-		if selectorPredicate(item.Spec) {
-			clusters.Items = append(clusters.Items, item.Spec)
+		if selectorPredicate(item.Spec) && options.MatchCluster(item.Spec) {
+			items = append(items, specWithVersion(item.Spec, item.ResourceVersion))
+		}
+	}
+	items, next, err := storage.Paginate(items, (*core.Cluster).GetId, options)
+	if err != nil {
+		return nil, err
+	}
+	return &core.ClusterList{
+		Items:         items,
+		NextPageToken: next,
+	}, nil
+}
+
+func (c *CRDStore) WatchClusters(
+	ctx context.Context,
+	known []*core.Cluster,
+) (<-chan storage.WatchEvent[*core.Cluster], error) {
+	eventC := make(chan storage.WatchEvent[*core.Cluster], 64)
+
+	mu := sync.Mutex{}
+	knownById := map[string]*core.Cluster{}
+	for _, cluster := range known {
+		knownById[cluster.Id] = cluster
+	}
+	send := func(event storage.WatchEvent[*core.Cluster]) {
+		select {
+		case eventC <- event:
+		case <-ctx.Done():
 		}
 	}
-	return clusters, nil
+
+	lw := &toolscache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			list := &v1beta1.ClusterList{}
+			err := c.watchClient.List(ctx, list, &client.ListOptions{
+				Namespace: c.namespace,
+				Raw:       &options,
+			})
+			return list, err
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return c.watchClient.Watch(ctx, &v1beta1.ClusterList{}, &client.ListOptions{
+				Namespace: c.namespace,
+				Raw:       &options,
+			})
+		},
+	}
+	store, informer := toolscache.NewInformer(lw, &v1beta1.Cluster{}, 0, toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			cluster := clusterFromObject(obj)
+			mu.Lock()
+			prev, ok := knownById[cluster.Id]
+			delete(knownById, cluster.Id)
+			mu.Unlock()
+			switch {
+			case !ok:
+				send(storage.WatchEvent[*core.Cluster]{
+					EventType: storage.WatchEventCreate,
+					Current:   cluster,
+				})
+			case !proto.Equal(prev, cluster):
+				send(storage.WatchEvent[*core.Cluster]{
+					EventType: storage.WatchEventUpdate,
+					Current:   cluster,
+					Previous:  prev,
+				})
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			prev := clusterFromObject(oldObj)
+			cluster := clusterFromObject(newObj)
+			if proto.Equal(prev, cluster) {
+				return
+			}
+			send(storage.WatchEvent[*core.Cluster]{
+				EventType: storage.WatchEventUpdate,
+				Current:   cluster,
+				Previous:  prev,
+			})
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			cluster, ok := obj.(*v1beta1.Cluster)
+			if !ok {
+				return
+			}
+			send(storage.WatchEvent[*core.Cluster]{
+				EventType: storage.WatchEventDelete,
+				Previous:  clusterFromObject(cluster),
+			})
+		},
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		informer.Run(ctx.Done())
+	}()
+	go func() {
+		defer wg.Done()
+		if !toolscache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+			return
+		}
+		// Any known clusters which were not present in the initial list have
+		// been deleted.
+		mu.Lock()
+		var deleted []*core.Cluster
+		for id, cluster := range knownById {
+			if _, exists, _ := store.GetByKey(c.namespace + "/" + id); !exists {
+				deleted = append(deleted, cluster)
+			}
+		}
+		knownById = map[string]*core.Cluster{}
+		mu.Unlock()
+		for _, cluster := range deleted {
+			send(storage.WatchEvent[*core.Cluster]{
+				EventType: storage.WatchEventDelete,
+				Previous:  cluster,
+			})
+		}
+	}()
+	go func() {
+		// the channel can only be closed once the informer has stopped
+		// invoking event handlers
+		wg.Wait()
+		close(eventC)
+	}()
+	return eventC, nil
+}
+
+func clusterFromObject(obj any) *core.Cluster {
+	cluster := obj.(*v1beta1.Cluster)
+	return specWithVersion(cluster.Spec, cluster.ResourceVersion)
 }
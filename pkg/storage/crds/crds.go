@@ -11,25 +11,29 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	"github.com/rancher/opni-monitoring/pkg/util"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type CRDStore struct {
 	CRDStoreOptions
-	client client.Client
-	logger *zap.SugaredLogger
+	client      client.Client
+	watchClient client.WithWatch
+	logger      *zap.SugaredLogger
 }
 
 var _ storage.TokenStore = (*CRDStore)(nil)
 var _ storage.ClusterStore = (*CRDStore)(nil)
 var _ storage.RBACStore = (*CRDStore)(nil)
 var _ storage.KeyringStoreBroker = (*CRDStore)(nil)
+var _ storage.KeyValueStoreBroker = (*CRDStore)(nil)
 
 type CRDStoreOptions struct {
-	namespace      string
-	restConfig     *rest.Config
-	commandTimeout time.Duration
+	namespace        string
+	restConfig       *rest.Config
+	commandTimeout   time.Duration
+	valueTransformer storage.ValueTransformer
 }
 
 type CRDStoreOption func(*CRDStoreOptions)
@@ -58,11 +62,20 @@ func WithCommandTimeout(timeout time.Duration) CRDStoreOption {
 	}
 }
 
+// WithValueTransformer sets the transformer applied to keyring and key-value
+// data, such as an encryption layer.
+func WithValueTransformer(vt storage.ValueTransformer) CRDStoreOption {
+	return func(o *CRDStoreOptions) {
+		o.valueTransformer = vt
+	}
+}
+
 func NewCRDStore(opts ...CRDStoreOption) *CRDStore {
 	lg := logger.New().Named("crd-store")
 	options := CRDStoreOptions{
-		namespace:      os.Getenv("POD_NAMESPACE"),
-		commandTimeout: 5 * time.Second,
+		namespace:        os.Getenv("POD_NAMESPACE"),
+		commandTimeout:   5 * time.Second,
+		valueTransformer: storage.IdentityTransformer{},
 	}
 	options.Apply(opts...)
 	if options.namespace == "" {
@@ -72,12 +85,17 @@ func NewCRDStore(opts ...CRDStoreOption) *CRDStore {
 	if options.restConfig == nil {
 		options.restConfig = util.Must(rest.InClusterConfig())
 	}
+	// Watch requests are long-lived, so they cannot share the command timeout
+	watchConfig := rest.CopyConfig(options.restConfig)
 	options.restConfig.Timeout = options.commandTimeout
 	return &CRDStore{
 		CRDStoreOptions: options,
 		client: util.Must(client.New(options.restConfig, client.Options{
 			Scheme: api.NewScheme(),
 		})),
+		watchClient: util.Must(client.NewWithWatch(watchConfig, client.Options{
+			Scheme: api.NewScheme(),
+		})),
 		logger: lg,
 	}
 }
@@ -90,3 +108,26 @@ func (e *CRDStore) KeyringStore(ctx context.Context, prefix string, ref *core.Re
 		prefix:          prefix,
 	}, nil
 }
+
+func (e *CRDStore) KeyValueStore(prefix string) (storage.KeyValueStore, error) {
+	return &crdKeyValueStore{
+		CRDStoreOptions: e.CRDStoreOptions,
+		client:          e.client,
+		watchClient:     e.watchClient,
+		prefix:          prefix,
+	}, nil
+}
+
+type versionedMessage interface {
+	proto.Message
+	core.VersionedObject
+}
+
+// specWithVersion returns a copy of a custom resource's spec with its resource
+// version set to that of the custom resource. Resource versions are not
+// stored in the spec itself.
+func specWithVersion[T versionedMessage](spec T, resourceVersion string) T {
+	spec = proto.Clone(spec).(T)
+	spec.SetResourceVersion(resourceVersion)
+	return spec
+}
@@ -2,6 +2,7 @@ package crds
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/keyring"
@@ -26,6 +27,10 @@ func (ks *crdKeyringStore) Put(ctx context.Context, keyring keyring.Keyring) err
 	if err != nil {
 		return err
 	}
+	data, err = ks.valueTransformer.TransformToStorage(ctx, data)
+	if err != nil {
+		return fmt.Errorf("failed to transform keyring: %w", err)
+	}
 	kr := &v1beta1.Keyring{}
 	if err := ks.client.Get(ctx, types.NamespacedName{
 		Name:      ks.ref.Id,
@@ -67,5 +72,73 @@ func (ks *crdKeyringStore) Get(ctx context.Context) (keyring.Keyring, error) {
 		}
 		return nil, err
 	}
-	return keyring.Unmarshal(kr.Data)
+	data, err := ks.valueTransformer.TransformFromStorage(ctx, kr.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	return keyring.Unmarshal(data)
+}
+
+// Update relies on the resource version of the Keyring object to detect
+// concurrent modifications, and retries on conflict.
+func (ks *crdKeyringStore) Update(ctx context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+	var retKeyring keyring.Keyring
+	err := retry.OnError(defaultBackoff, k8serrors.IsConflict, func() error {
+		obj := &v1beta1.Keyring{}
+		if err := ks.client.Get(ctx, types.NamespacedName{
+			Name:      ks.ref.Id,
+			Namespace: ks.namespace,
+		}, obj); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return storage.ErrNotFound
+			}
+			return err
+		}
+		data, err := ks.valueTransformer.TransformFromStorage(ctx, obj.Data)
+		if err != nil {
+			return fmt.Errorf("failed to transform keyring: %w", err)
+		}
+		kr, err := keyring.Unmarshal(data)
+		if err != nil {
+			return err
+		}
+		kr, err = mutator(kr)
+		if err != nil {
+			return err
+		}
+		data, err = kr.Marshal()
+		if err != nil {
+			return err
+		}
+		data, err = ks.valueTransformer.TransformToStorage(ctx, data)
+		if err != nil {
+			return fmt.Errorf("failed to transform keyring: %w", err)
+		}
+		obj.Data = data
+		if err := ks.client.Update(ctx, obj); err != nil {
+			return err
+		}
+		retKeyring = kr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retKeyring, nil
+}
+
+func (ks *crdKeyringStore) Delete(ctx context.Context) error {
+	err := ks.client.Delete(ctx, &v1beta1.Keyring{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ks.ref.Id,
+			Namespace: ks.namespace,
+		},
+	})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return storage.ErrNotFound
+		}
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,356 @@
+package crds
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/sdk/api/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const kvNamespaceLabel = "monitoring.opni.io/kv-namespace"
+
+// crdKeyValueStore stores each key in a KeyValue custom resource. Keys are
+// arbitrary strings, so resource names and labels are derived from hashes of
+// the key and namespace. The resource version of the custom resource is used
+// as the revision of the key.
+//
+// Keys with a TTL are not removed when they expire, but are ignored until they
+// are next written. Watchers will not receive delete events for expired keys.
+type crdKeyValueStore struct {
+	CRDStoreOptions
+	client      client.Client
+	watchClient client.WithWatch
+	prefix      string
+}
+
+func (s *crdKeyValueStore) objectName(key string) string {
+	sum := sha256.Sum256([]byte(s.prefix + "/" + key))
+	return "kv-" + hex.EncodeToString(sum[:])
+}
+
+func (s *crdKeyValueStore) namespaceLabel() string {
+	sum := sha256.Sum256([]byte(s.prefix))
+	return hex.EncodeToString(sum[:16])
+}
+
+func (s *crdKeyValueStore) listOptions() []client.ListOption {
+	return []client.ListOption{
+		client.InNamespace(s.namespace),
+		client.MatchingLabels{
+			kvNamespaceLabel: s.namespaceLabel(),
+		},
+	}
+}
+
+func parseRevision(resourceVersion string) int64 {
+	rev, _ := strconv.ParseInt(resourceVersion, 10, 64)
+	return rev
+}
+
+func kvExpired(kv *v1beta1.KeyValue, now time.Time) bool {
+	return kv.Spec.ExpiresAt != nil && !now.Before(kv.Spec.ExpiresAt.Time)
+}
+
+// get returns the custom resource for the key, or storage.ErrNotFound if it
+// does not exist or has expired.
+func (s *crdKeyValueStore) get(ctx context.Context, key string) (*v1beta1.KeyValue, error) {
+	kv := &v1beta1.KeyValue{}
+	err := s.client.Get(ctx, client.ObjectKey{
+		Name:      s.objectName(key),
+		Namespace: s.namespace,
+	}, kv)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	if kvExpired(kv, time.Now()) {
+		return kv, storage.ErrNotFound
+	}
+	return kv, nil
+}
+
+func (s *crdKeyValueStore) Put(ctx context.Context, key string, value []byte, opts ...storage.KeyValueStoreOption) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	data, err := s.valueTransformer.TransformToStorage(ctx, value)
+	if err != nil {
+		return fmt.Errorf("failed to transform value: %w", err)
+	}
+	// Conflicts are retried, unless a specific revision was requested.
+	retryable := func(err error) bool {
+		return options.Revision == nil &&
+			(k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err))
+	}
+	var revision int64
+	err = retry.OnError(defaultBackoff, retryable, func() error {
+		kv, err := s.get(ctx, key)
+		var current int64
+		switch {
+		case err == nil:
+			current = parseRevision(kv.ResourceVersion)
+		case !errors.Is(err, storage.ErrNotFound):
+			return err
+		}
+		if err := options.CheckRevision(current); err != nil {
+			return err
+		}
+		var expiresAt *metav1.Time
+		if options.TTL > 0 {
+			t := metav1.NewTime(time.Now().Add(options.TTL))
+			expiresAt = &t
+		}
+		if kv == nil {
+			kv = &v1beta1.KeyValue{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.objectName(key),
+					Namespace: s.namespace,
+					Labels: map[string]string{
+						kvNamespaceLabel: s.namespaceLabel(),
+					},
+				},
+				Spec: v1beta1.KeyValueSpec{
+					Namespace: s.prefix,
+					Key:       key,
+					Value:     data,
+					ExpiresAt: expiresAt,
+				},
+			}
+			err = s.client.Create(ctx, kv)
+		} else {
+			// The resource version of the object ensures the update fails if
+			// it was modified since it was read, including if it had expired.
+			kv.Spec.Value = data
+			kv.Spec.ExpiresAt = expiresAt
+			err = s.client.Update(ctx, kv)
+		}
+		if err != nil {
+			return err
+		}
+		revision = parseRevision(kv.ResourceVersion)
+		return nil
+	})
+	if err != nil {
+		if k8serrors.IsConflict(err) || k8serrors.IsAlreadyExists(err) {
+			return storage.ErrConflict
+		}
+		return err
+	}
+	options.SetRevisionOut(revision)
+	return nil
+}
+
+func (s *crdKeyValueStore) Get(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	kv, err := s.get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	value, err := s.valueTransformer.TransformFromStorage(ctx, kv.Spec.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform value: %w", err)
+	}
+	options.SetRevisionOut(parseRevision(kv.ResourceVersion))
+	var ttl time.Duration
+	if kv.Spec.ExpiresAt != nil {
+		// The key may have expired since it was read
+		if ttl = time.Until(kv.Spec.ExpiresAt.Time); ttl <= 0 {
+			ttl = 1
+		}
+	}
+	options.SetTTLOut(ttl)
+	return value, nil
+}
+
+func (s *crdKeyValueStore) Delete(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	retryable := func(err error) bool {
+		return options.Revision == nil && k8serrors.IsConflict(err)
+	}
+	err := retry.OnError(defaultBackoff, retryable, func() error {
+		kv, err := s.get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if err := options.CheckRevision(parseRevision(kv.ResourceVersion)); err != nil {
+			return err
+		}
+		err = s.client.Delete(ctx, kv, client.Preconditions{
+			ResourceVersion: &kv.ResourceVersion,
+		})
+		if k8serrors.IsNotFound(err) {
+			return storage.ErrNotFound
+		}
+		return err
+	})
+	if k8serrors.IsConflict(err) {
+		return storage.ErrConflict
+	}
+	return err
+}
+
+func (s *crdKeyValueStore) ListKeys(ctx context.Context, prefix string) ([]string, error) {
+	list := &v1beta1.KeyValueList{}
+	if err := s.client.List(ctx, list, s.listOptions()...); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	keys := []string{}
+	for _, kv := range list.Items {
+		if kv.Spec.Namespace != s.prefix || !strings.HasPrefix(kv.Spec.Key, prefix) || kvExpired(&kv, now) {
+			continue
+		}
+		keys = append(keys, kv.Spec.Key)
+	}
+	return keys, nil
+}
+
+func (s *crdKeyValueStore) Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error) {
+	// Events are only sent for changes made after the watch is established,
+	// so the current contents are used to filter out the informer's initial
+	// add events.
+	initial := &v1beta1.KeyValueList{}
+	if err := s.client.List(ctx, initial, s.listOptions()...); err != nil {
+		return nil, err
+	}
+	mu := sync.Mutex{}
+	known := map[string]string{}
+	for _, kv := range initial.Items {
+		known[kv.Name] = kv.ResourceVersion
+	}
+
+	eventC := make(chan storage.KeyValueWatchEvent, 64)
+	send := func(event storage.KeyValueWatchEvent) {
+		select {
+		case eventC <- event:
+		case <-ctx.Done():
+		}
+	}
+	matches := func(kv *v1beta1.KeyValue) bool {
+		return kv.Spec.Namespace == s.prefix && strings.HasPrefix(kv.Spec.Key, prefix)
+	}
+
+	lw := &toolscache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			list := &v1beta1.KeyValueList{}
+			err := s.watchClient.List(ctx, list, append(s.listOptions(), &client.ListOptions{
+				Raw: &options,
+			})...)
+			return list, err
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return s.watchClient.Watch(ctx, &v1beta1.KeyValueList{}, append(s.listOptions(), &client.ListOptions{
+				Raw: &options,
+			})...)
+		},
+	}
+	_, informer := toolscache.NewInformer(lw, &v1beta1.KeyValue{}, 0, toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			kv := obj.(*v1beta1.KeyValue)
+			mu.Lock()
+			rv, ok := known[kv.Name]
+			delete(known, kv.Name)
+			mu.Unlock()
+			if !matches(kv) || (ok && rv == kv.ResourceVersion) {
+				return
+			}
+			eventType := storage.WatchEventCreate
+			if ok {
+				// The key was modified between the initial list and the
+				// informer's list, so the previous value is not available.
+				eventType = storage.WatchEventUpdate
+			}
+			send(storage.KeyValueWatchEvent{
+				EventType: eventType,
+				Current:   s.keyRevision(ctx, kv),
+			})
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			prev := oldObj.(*v1beta1.KeyValue)
+			kv := newObj.(*v1beta1.KeyValue)
+			if !matches(kv) || prev.ResourceVersion == kv.ResourceVersion {
+				return
+			}
+			send(storage.KeyValueWatchEvent{
+				EventType: storage.WatchEventUpdate,
+				Current:   s.keyRevision(ctx, kv),
+				Previous:  s.keyRevision(ctx, prev),
+			})
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			kv, ok := obj.(*v1beta1.KeyValue)
+			if !ok || !matches(kv) {
+				return
+			}
+			send(storage.KeyValueWatchEvent{
+				EventType: storage.WatchEventDelete,
+				Previous:  s.keyRevision(ctx, kv),
+			})
+		},
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		informer.Run(ctx.Done())
+	}()
+	go func() {
+		// the channel can only be closed once the informer has stopped
+		// invoking event handlers
+		wg.Wait()
+		close(eventC)
+	}()
+	return eventC, nil
+}
+
+// keyRevision decodes the value of a key for a watch event. If the value
+// cannot be decoded, it is omitted from the event.
+func (s *crdKeyValueStore) keyRevision(ctx context.Context, kv *v1beta1.KeyValue) *storage.KeyRevision {
+	value, err := s.valueTransformer.TransformFromStorage(ctx, kv.Spec.Value)
+	if err != nil {
+		value = nil
+	}
+	return &storage.KeyRevision{
+		Key:      kv.Spec.Key,
+		Value:    value,
+		Revision: parseRevision(kv.ResourceVersion),
+	}
+}
+
+func validateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+	return nil
+}
@@ -6,12 +6,16 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/sdk/api/v1beta1"
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/protobuf/proto"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func (c *CRDStore) CreateRole(ctx context.Context, role *core.Role) error {
+	role = proto.Clone(role).(*core.Role)
+	storage.InitVersionedObject(role)
 	return c.client.Create(ctx, &v1beta1.Role{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      role.Id,
@@ -42,10 +46,45 @@ func (c *CRDStore) GetRole(ctx context.Context, ref *core.Reference) (*core.Role
 		}
 		return nil, err
 	}
-	return role.Spec, nil
+	return specWithVersion(role.Spec, role.ResourceVersion), nil
+}
+
+func (c *CRDStore) UpdateRole(ctx context.Context, ref *core.Reference, mutator storage.RoleMutator) (*core.Role, error) {
+	var role *core.Role
+	err := retry.OnError(defaultBackoff, k8serrors.IsConflict, func() error {
+		existing := &v1beta1.Role{}
+		err := c.client.Get(ctx, client.ObjectKey{
+			Name:      ref.Id,
+			Namespace: c.namespace,
+		}, existing)
+		if err != nil {
+			return err
+		}
+		clone := existing.DeepCopy()
+		spec := specWithVersion(clone.Spec, existing.ResourceVersion)
+		if err := storage.ApplyMutator(spec, mutator); err != nil {
+			return err
+		}
+		spec.SetResourceVersion("")
+		clone.Spec = spec
+		if err := c.client.Update(ctx, clone); err != nil {
+			return err
+		}
+		role = specWithVersion(clone.Spec, clone.ResourceVersion)
+		return nil
+	})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return role, nil
 }
 
 func (c *CRDStore) CreateRoleBinding(ctx context.Context, rb *core.RoleBinding) error {
+	rb = proto.Clone(rb).(*core.RoleBinding)
+	storage.InitVersionedObject(rb)
 	return c.client.Create(ctx, &v1beta1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      rb.Id,
@@ -76,35 +115,83 @@ func (c *CRDStore) GetRoleBinding(ctx context.Context, ref *core.Reference) (*co
 		}
 		return nil, err
 	}
-	return rb.Spec, nil
+	return specWithVersion(rb.Spec, rb.ResourceVersion), nil
+}
+
+func (c *CRDStore) UpdateRoleBinding(ctx context.Context, ref *core.Reference, mutator storage.RoleBindingMutator) (*core.RoleBinding, error) {
+	var rb *core.RoleBinding
+	err := retry.OnError(defaultBackoff, k8serrors.IsConflict, func() error {
+		existing := &v1beta1.RoleBinding{}
+		err := c.client.Get(ctx, client.ObjectKey{
+			Name:      ref.Id,
+			Namespace: c.namespace,
+		}, existing)
+		if err != nil {
+			return err
+		}
+		clone := existing.DeepCopy()
+		spec := specWithVersion(clone.Spec, existing.ResourceVersion)
+		if err := storage.ApplyMutator(spec, mutator); err != nil {
+			return err
+		}
+		spec.SetResourceVersion("")
+		spec.Taints = nil
+		clone.Spec = spec
+		if err := c.client.Update(ctx, clone); err != nil {
+			return err
+		}
+		rb = specWithVersion(clone.Spec, clone.ResourceVersion)
+		return nil
+	})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	return rb, nil
 }
 
-func (c *CRDStore) ListRoles(ctx context.Context) (*core.RoleList, error) {
+func (c *CRDStore) ListRoles(ctx context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
 	list := &v1beta1.RoleList{}
 	err := c.client.List(ctx, list, client.InNamespace(c.namespace))
 	if err != nil {
 		return nil, err
 	}
-	roles := &core.RoleList{
-		Items: make([]*core.Role, 0, len(list.Items)),
-	}
+	items := make([]*core.Role, 0, len(list.Items))
 	for _, item := range list.Items {
-		roles.Items = append(roles.Items, item.Spec)
+		items = append(items, specWithVersion(item.Spec, item.ResourceVersion))
 	}
-	return roles, nil
+	items, next, err := storage.Paginate(items, (*core.Role).GetId, options)
+	if err != nil {
+		return nil, err
+	}
+	return &core.RoleList{
+		Items:         items,
+		NextPageToken: next,
+	}, nil
 }
 
-func (c *CRDStore) ListRoleBindings(ctx context.Context) (*core.RoleBindingList, error) {
+func (c *CRDStore) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
 	list := &v1beta1.RoleBindingList{}
 	err := c.client.List(ctx, list, client.InNamespace(c.namespace))
 	if err != nil {
 		return nil, err
 	}
-	rb := &core.RoleBindingList{
-		Items: make([]*core.RoleBinding, 0, len(list.Items)),
-	}
+	items := make([]*core.RoleBinding, 0, len(list.Items))
 	for _, item := range list.Items {
-		rb.Items = append(rb.Items, item.Spec)
+		items = append(items, specWithVersion(item.Spec, item.ResourceVersion))
 	}
-	return rb, nil
+	items, next, err := storage.Paginate(items, (*core.RoleBinding).GetId, options)
+	if err != nil {
+		return nil, err
+	}
+	return &core.RoleBindingList{
+		Items:         items,
+		NextPageToken: next,
+	}, nil
 }
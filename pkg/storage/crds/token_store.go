@@ -7,7 +7,6 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/sdk/api/v1beta1"
 	"github.com/rancher/opni-monitoring/pkg/storage"
-	"github.com/rancher/opni-monitoring/pkg/tokens"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -20,13 +19,14 @@ func (c *CRDStore) CreateToken(ctx context.Context, ttl time.Duration, opts ...s
 	options := storage.NewTokenCreateOptions()
 	options.Apply(opts...)
 
-	token := tokens.NewToken().ToBootstrapToken()
+	token := options.Token.ToBootstrapToken()
 	token.Metadata = &core.BootstrapTokenMetadata{
 		LeaseID:      -1,
 		Ttl:          int64(ttl.Seconds()),
 		UsageCount:   0,
 		Labels:       options.Labels,
 		Capabilities: options.Capabilities,
+		Limits:       options.Limits,
 	}
 	err := c.client.Create(ctx, &v1beta1.BootstrapToken{
 		ObjectMeta: metav1.ObjectMeta{
@@ -81,22 +81,33 @@ func (c *CRDStore) GetToken(ctx context.Context, ref *core.Reference) (*core.Boo
 	return token.Spec, nil
 }
 
-func (c *CRDStore) ListTokens(ctx context.Context) ([]*core.BootstrapToken, error) {
+func (c *CRDStore) ListTokens(ctx context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
 	list := &v1beta1.BootstrapTokenList{}
 	err := c.client.List(ctx, list, client.InNamespace(c.namespace))
 	if err != nil {
 		return nil, err
 	}
-	tokens := make([]*core.BootstrapToken, len(list.Items))
+	items := make([]*core.BootstrapToken, 0, len(list.Items))
 	for i, item := range list.Items {
 		patchTTL(&list.Items[i])
 		if item.Spec.Metadata.Ttl <= 0 {
 			go c.garbageCollectToken(&list.Items[i])
 			continue
 		}
-		tokens[i] = item.Spec
+		if options.MatchToken(item.Spec) {
+			items = append(items, item.Spec)
+		}
+	}
+	items, next, err := storage.Paginate(items, (*core.BootstrapToken).GetTokenID, options)
+	if err != nil {
+		return nil, err
 	}
-	return tokens, nil
+	return &core.BootstrapTokenList{
+		Items:         items,
+		NextPageToken: next,
+	}, nil
 }
 
 func (c *CRDStore) UpdateToken(ctx context.Context, ref *core.Reference, mutator storage.MutatorFunc[*core.BootstrapToken]) (*core.BootstrapToken, error) {
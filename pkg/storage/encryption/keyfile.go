@@ -0,0 +1,166 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"sigs.k8s.io/yaml"
+)
+
+var (
+	ErrNoActiveKey = errors.New("key file has no active key")
+	ErrKeyNotFound = errors.New("key not found")
+)
+
+// KeyFile is the on-disk format used by the local key encryption key
+// provider. It contains all keys which may have been used to encrypt data
+// keys, and the ID of the key which should be used to encrypt new data keys.
+type KeyFile struct {
+	ActiveKeyID string             `json:"activeKeyID"`
+	Keys        []KeyEncryptionKey `json:"keys"`
+}
+
+type KeyEncryptionKey struct {
+	ID      string    `json:"id"`
+	Secret  []byte    `json:"secret"`
+	Created time.Time `json:"created"`
+}
+
+func (f *KeyFile) ActiveKey() (*KeyEncryptionKey, error) {
+	if f.ActiveKeyID == "" {
+		return nil, ErrNoActiveKey
+	}
+	return f.Key(f.ActiveKeyID)
+}
+
+func (f *KeyFile) Key(id string) (*KeyEncryptionKey, error) {
+	for i, k := range f.Keys {
+		if k.ID == id {
+			return &f.Keys[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrKeyNotFound, id)
+}
+
+// Rotate generates a new key and makes it the active key. Previous keys are
+// kept so that existing data can still be decrypted.
+func (f *KeyFile) Rotate() (*KeyEncryptionKey, error) {
+	key, err := newKeyEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	f.Keys = append(f.Keys, *key)
+	f.ActiveKeyID = key.ID
+	return key, nil
+}
+
+// Prune removes all keys except the active key. This should only be done
+// after all existing data has been re-encrypted using the active key.
+func (f *KeyFile) Prune() []string {
+	var removed []string
+	keys := []KeyEncryptionKey{}
+	for _, k := range f.Keys {
+		if k.ID == f.ActiveKeyID {
+			keys = append(keys, k)
+		} else {
+			removed = append(removed, k.ID)
+		}
+	}
+	f.Keys = keys
+	return removed
+}
+
+func (f *KeyFile) validate() error {
+	if _, err := f.ActiveKey(); err != nil {
+		return err
+	}
+	ids := map[string]struct{}{}
+	for _, k := range f.Keys {
+		if _, ok := ids[k.ID]; ok {
+			return fmt.Errorf("duplicate key ID: %s", k.ID)
+		}
+		ids[k.ID] = struct{}{}
+		if len(k.Secret) != chacha20poly1305.KeySize {
+			return fmt.Errorf("key %s has invalid length %d (expected %d)",
+				k.ID, len(k.Secret), chacha20poly1305.KeySize)
+		}
+	}
+	return nil
+}
+
+// NewKeyFile creates a key file containing a single new active key.
+func NewKeyFile() (*KeyFile, error) {
+	f := &KeyFile{}
+	if _, err := f.Rotate(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func LoadKeyFile(path string) (*KeyFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read key file: %w", err)
+	}
+	f := &KeyFile{}
+	if err := yaml.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("failed to parse key file: %w", err)
+	}
+	if err := f.validate(); err != nil {
+		return nil, fmt.Errorf("invalid key file: %w", err)
+	}
+	return f, nil
+}
+
+// WriteKeyFile writes the key file to the given path with owner-only
+// permissions. The file is replaced atomically.
+func WriteKeyFile(path string, f *KeyFile) error {
+	if err := f.validate(); err != nil {
+		return fmt.Errorf("invalid key file: %w", err)
+	}
+	data, err := yaml.Marshal(f)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".kek-*")
+	if err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	return nil
+}
+
+func newKeyEncryptionKey() (*KeyEncryptionKey, error) {
+	secret := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	return &KeyEncryptionKey{
+		ID:      fmt.Sprintf("%x", id),
+		Secret:  secret,
+		Created: time.Now().UTC().Truncate(time.Second),
+	}, nil
+}
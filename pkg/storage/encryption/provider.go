@@ -0,0 +1,120 @@
+package encryption
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+var ErrInvalidWrappedKey = errors.New("invalid wrapped key")
+
+// KEKProvider encrypts and decrypts data keys using key encryption keys
+// (KEKs) which are managed by the provider. Implementations may keep keys
+// locally or delegate to an external key management service.
+type KEKProvider interface {
+	// WrapKey encrypts a data key using the active key encryption key, and
+	// returns the ID of the key that was used.
+	WrapKey(ctx context.Context, dek []byte) (keyID string, wrapped []byte, err error)
+	// UnwrapKey decrypts a data key that was encrypted using the key
+	// encryption key with the given ID.
+	UnwrapKey(ctx context.Context, keyID string, wrapped []byte) ([]byte, error)
+}
+
+// LocalKEKProvider is a KEKProvider which reads key encryption keys from a
+// local key file. The file is reloaded if it is modified, so keys can be
+// rotated without restarting.
+type LocalKEKProvider struct {
+	path    string
+	mu      sync.Mutex
+	keyFile *KeyFile
+	info    os.FileInfo
+}
+
+var _ KEKProvider = (*LocalKEKProvider)(nil)
+
+func NewLocalKEKProvider(path string) (*LocalKEKProvider, error) {
+	p := &LocalKEKProvider{
+		path: path,
+	}
+	if err := p.reloadIfModified(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (p *LocalKEKProvider) WrapKey(_ context.Context, dek []byte) (string, []byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.reloadIfModifiedLocked(); err != nil {
+		return "", nil, err
+	}
+	kek, err := p.keyFile.ActiveKey()
+	if err != nil {
+		return "", nil, err
+	}
+	aead, err := chacha20poly1305.NewX(kek.Secret)
+	if err != nil {
+		return "", nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(dek)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, err
+	}
+	return kek.ID, aead.Seal(nonce, nonce, dek, []byte(kek.ID)), nil
+}
+
+func (p *LocalKEKProvider) UnwrapKey(_ context.Context, keyID string, wrapped []byte) ([]byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err := p.reloadIfModifiedLocked(); err != nil {
+		return nil, err
+	}
+	kek, err := p.keyFile.Key(keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(kek.Secret)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrapped) < aead.NonceSize() {
+		return nil, ErrInvalidWrappedKey
+	}
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	dek, err := aead.Open(nil, nonce, ciphertext, []byte(kek.ID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWrappedKey, err.Error())
+	}
+	return dek, nil
+}
+
+func (p *LocalKEKProvider) reloadIfModified() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.reloadIfModifiedLocked()
+}
+
+func (p *LocalKEKProvider) reloadIfModifiedLocked() error {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return fmt.Errorf("failed to read key file: %w", err)
+	}
+	// Key files are replaced atomically, so a new file indicates a change even
+	// if the modification time has not advanced.
+	if p.keyFile != nil && os.SameFile(info, p.info) &&
+		info.ModTime().Equal(p.info.ModTime()) && info.Size() == p.info.Size() {
+		return nil
+	}
+	keyFile, err := LoadKeyFile(p.path)
+	if err != nil {
+		return err
+	}
+	p.keyFile = keyFile
+	p.info = info
+	return nil
+}
@@ -0,0 +1,94 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"k8s.io/client-go/util/retry"
+)
+
+// Reencrypt reads and re-writes every keyring and key-value store entry in the
+// backend, which causes each record to be encrypted using a new data key
+// wrapped by the currently active key encryption key. Keyrings are looked up
+// for each cluster in the given keyring namespaces. It returns the number of
+// records that were re-written.
+func Reencrypt(
+	ctx context.Context,
+	backend storage.Backend,
+	keyringNamespaces []string,
+	keyValueNamespaces []string,
+) (int, error) {
+	count := 0
+	clusters, err := backend.ListClusters(ctx, nil, core.MatchOptions_Default)
+	if err != nil {
+		return count, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	for _, cluster := range clusters.Items {
+		for _, namespace := range keyringNamespaces {
+			ks, err := backend.KeyringStore(ctx, namespace, cluster.Reference())
+			if err != nil {
+				return count, fmt.Errorf("failed to get keyring store: %w", err)
+			}
+			// Rewrite the keyring unchanged, without overwriting concurrent
+			// updates such as key rotations
+			_, err = ks.Update(ctx, func(kr keyring.Keyring) (keyring.Keyring, error) {
+				return kr, nil
+			})
+			if err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+				return count, fmt.Errorf("failed to update keyring %s/%s: %w", namespace, cluster.Id, err)
+			}
+			count++
+		}
+	}
+	for _, namespace := range keyValueNamespaces {
+		kv, err := backend.KeyValueStore(namespace)
+		if err != nil {
+			return count, fmt.Errorf("failed to get key-value store: %w", err)
+		}
+		keys, err := kv.ListKeys(ctx, "")
+		if err != nil {
+			return count, fmt.Errorf("failed to list keys in namespace %s: %w", namespace, err)
+		}
+		for _, key := range keys {
+			if err := reencryptKey(ctx, kv, key); err != nil {
+				if errors.Is(err, storage.ErrNotFound) {
+					continue
+				}
+				return count, fmt.Errorf("failed to re-encrypt key %s in namespace %s: %w", key, namespace, err)
+			}
+			count++
+		}
+	}
+	return count, nil
+}
+
+// reencryptKey rewrites the key's current value, keeping its remaining TTL.
+// The write only succeeds if the key has not been modified since it was read,
+// so concurrent updates are not overwritten; conflicts are retried.
+func reencryptKey(ctx context.Context, kv storage.KeyValueStore, key string) error {
+	return retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return errors.Is(err, storage.ErrConflict)
+	}, func() error {
+		var revision int64
+		var ttl time.Duration
+		value, err := kv.Get(ctx, key, storage.WithRevisionOut(&revision), storage.WithTTLOut(&ttl))
+		if err != nil {
+			return err
+		}
+		opts := []storage.KeyValueStoreOption{
+			storage.WithRevision(revision),
+		}
+		if ttl > 0 {
+			opts = append(opts, storage.WithTTL(ttl))
+		}
+		return kv.Put(ctx, key, value, opts...)
+	})
+}
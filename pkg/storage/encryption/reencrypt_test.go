@@ -0,0 +1,147 @@
+package encryption_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/storage/bolt"
+	"github.com/rancher/opni-monitoring/pkg/storage/encryption"
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"go.etcd.io/bbolt"
+)
+
+var _ = Describe("Reencrypt", Ordered, Label(test.Unit), func() {
+	var store *bolt.BoltStore
+	var keyFilePath string
+	var keyFile *encryption.KeyFile
+	ctx := context.Background()
+
+	// storedKeyIDs returns the key IDs of all encrypted records in the
+	// database, and the number of records which are not encrypted.
+	storedKeyIDs := func() (ids []string, unencrypted int) {
+		err := store.DB.View(func(tx *bbolt.Tx) error {
+			for _, bucket := range []string{"keyrings", "kv"} {
+				err := tx.Bucket([]byte(bucket)).ForEach(func(_, v []byte) error {
+					if id, ok := encryption.KeyID(v); ok {
+						ids = append(ids, id)
+					} else {
+						unencrypted++
+					}
+					return nil
+				})
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return
+	}
+
+	BeforeAll(func() {
+		tempDir := GinkgoT().TempDir()
+		keyFilePath = filepath.Join(tempDir, "kek.yaml")
+		var err error
+		keyFile, err = encryption.NewKeyFile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encryption.WriteKeyFile(keyFilePath, keyFile)).To(Succeed())
+
+		dbPath := filepath.Join(tempDir, "opni.db")
+		plaintextStore, err := bolt.NewBoltStore(&v1beta1.BoltStorageSpec{
+			Path: dbPath,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("writing unencrypted records")
+		Expect(plaintextStore.CreateCluster(ctx, &core.Cluster{Id: "cluster-1"})).To(Succeed())
+		ks, err := plaintextStore.KeyringStore(ctx, "gateway", &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(ctx, keyring.New(keyring.NewSharedKeys(make([]byte, 64))))).To(Succeed())
+		kv, err := plaintextStore.KeyValueStore("test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Put(ctx, "foo", []byte("bar"))).To(Succeed())
+		Expect(plaintextStore.Close()).To(Succeed())
+
+		provider, err := encryption.NewLocalKEKProvider(keyFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		store, err = bolt.NewBoltStore(&v1beta1.BoltStorageSpec{
+			Path: dbPath,
+		}, bolt.WithValueTransformer(encryption.NewEnvelopeTransformer(provider)))
+		Expect(err).NotTo(HaveOccurred())
+		DeferCleanup(store.Close)
+	})
+
+	It("should read unencrypted records", func() {
+		kv, err := store.KeyValueStore("test")
+		Expect(err).NotTo(HaveOccurred())
+		value, err := kv.Get(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal([]byte("bar")))
+
+		_, unencrypted := storedKeyIDs()
+		Expect(unencrypted).To(Equal(2))
+	})
+	It("should encrypt existing records", func() {
+		count, err := encryption.Reencrypt(ctx, store, []string{"gateway", "agent"}, []string{"test"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(2))
+
+		ids, unencrypted := storedKeyIDs()
+		Expect(unencrypted).To(BeZero())
+		Expect(ids).To(ConsistOf(keyFile.ActiveKeyID, keyFile.ActiveKeyID))
+	})
+	It("should re-encrypt records using a rotated key", func() {
+		newKey, err := keyFile.Rotate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encryption.WriteKeyFile(keyFilePath, keyFile)).To(Succeed())
+
+		count, err := encryption.Reencrypt(ctx, store, []string{"gateway", "agent"}, []string{"test"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(2))
+
+		ids, _ := storedKeyIDs()
+		Expect(ids).To(ConsistOf(newKey.ID, newKey.ID))
+
+		By("pruning the previous key")
+		keyFile.Prune()
+		Expect(encryption.WriteKeyFile(keyFilePath, keyFile)).To(Succeed())
+
+		ks, err := store.KeyringStore(ctx, "gateway", &core.Reference{Id: "cluster-1"})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = ks.Get(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		kv, err := store.KeyValueStore("test")
+		Expect(err).NotTo(HaveOccurred())
+		value, err := kv.Get(ctx, "foo")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal([]byte("bar")))
+	})
+	It("should keep the TTL of re-encrypted keys", func() {
+		kv, err := store.KeyValueStore("test")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kv.Put(ctx, "expiring", []byte("baz"), storage.WithTTL(time.Hour))).To(Succeed())
+
+		count, err := encryption.Reencrypt(ctx, store, []string{"gateway", "agent"}, []string{"test"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(count).To(Equal(3))
+
+		var ttl time.Duration
+		value, err := kv.Get(ctx, "expiring", storage.WithTTLOut(&ttl))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal([]byte("baz")))
+		Expect(ttl).To(BeNumerically("~", time.Hour, time.Minute))
+
+		value, err = kv.Get(ctx, "foo", storage.WithTTLOut(&ttl))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value).To(Equal([]byte("bar")))
+		Expect(ttl).To(BeZero())
+	})
+})
@@ -0,0 +1,119 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// All encrypted records begin with this prefix. Records without the prefix
+// are assumed to have been written before encryption was enabled, and are
+// returned as-is.
+var envelopePrefix = []byte("opni:enc:v1:")
+
+var ErrDecryptionFailed = errors.New("failed to decrypt record")
+
+// envelope is the stored form of an encrypted record. The data is encrypted
+// with a random data key, which is itself encrypted by the KEK provider.
+type envelope struct {
+	KeyID      string `json:"kid"`
+	WrappedKey []byte `json:"dek"`
+	Nonce      []byte `json:"nonce"`
+	Data       []byte `json:"data"`
+}
+
+// EnvelopeTransformer is a storage.ValueTransformer which encrypts records
+// using envelope encryption.
+type EnvelopeTransformer struct {
+	provider KEKProvider
+}
+
+var _ storage.ValueTransformer = (*EnvelopeTransformer)(nil)
+
+func NewEnvelopeTransformer(provider KEKProvider) *EnvelopeTransformer {
+	return &EnvelopeTransformer{
+		provider: provider,
+	}
+}
+
+func (t *EnvelopeTransformer) TransformToStorage(ctx context.Context, data []byte) ([]byte, error) {
+	dek := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(dek)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	keyID, wrapped, err := t.provider.WrapKey(ctx, dek)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	env, err := json.Marshal(envelope{
+		KeyID:      keyID,
+		WrappedKey: wrapped,
+		Nonce:      nonce,
+		Data:       aead.Seal(nil, nonce, data, []byte(keyID)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, envelopePrefix...), env...), nil
+}
+
+func (t *EnvelopeTransformer) TransformFromStorage(ctx context.Context, data []byte) ([]byte, error) {
+	env, ok, err := decodeEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return data, nil
+	}
+	dek, err := t.provider.UnwrapKey(ctx, env.KeyID, env.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDecryptionFailed, err.Error())
+	}
+	aead, err := chacha20poly1305.NewX(dek)
+	if err != nil {
+		return nil, err
+	}
+	if len(env.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("%w: invalid nonce", ErrDecryptionFailed)
+	}
+	plaintext, err := aead.Open(nil, env.Nonce, env.Data, []byte(env.KeyID))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDecryptionFailed, err.Error())
+	}
+	return plaintext, nil
+}
+
+// KeyID returns the ID of the key encryption key used to encrypt the given
+// record. If the record is not encrypted, ok will be false.
+func KeyID(data []byte) (id string, ok bool) {
+	env, ok, err := decodeEnvelope(data)
+	if err != nil || !ok {
+		return "", false
+	}
+	return env.KeyID, true
+}
+
+func decodeEnvelope(data []byte) (*envelope, bool, error) {
+	if !bytes.HasPrefix(data, envelopePrefix) {
+		return nil, false, nil
+	}
+	env := &envelope{}
+	if err := json.Unmarshal(data[len(envelopePrefix):], env); err != nil {
+		return nil, false, fmt.Errorf("%w: malformed envelope: %s", ErrDecryptionFailed, err.Error())
+	}
+	return env, true, nil
+}
@@ -0,0 +1,107 @@
+package encryption_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/storage/encryption"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Envelope Transformer", Label(test.Unit), func() {
+	var keyFilePath string
+	var keyFile *encryption.KeyFile
+	var transformer *encryption.EnvelopeTransformer
+	ctx := context.Background()
+
+	BeforeEach(func() {
+		keyFilePath = filepath.Join(GinkgoT().TempDir(), "kek.yaml")
+		var err error
+		keyFile, err = encryption.NewKeyFile()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(encryption.WriteKeyFile(keyFilePath, keyFile)).To(Succeed())
+		provider, err := encryption.NewLocalKEKProvider(keyFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		transformer = encryption.NewEnvelopeTransformer(provider)
+	})
+
+	It("should write key files with owner-only permissions", func() {
+		info, err := os.Stat(keyFilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+	It("should encrypt and decrypt records", func() {
+		data, err := transformer.TransformToStorage(ctx, []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).NotTo(ContainSubstring("secret"))
+
+		id, ok := encryption.KeyID(data)
+		Expect(ok).To(BeTrue())
+		Expect(id).To(Equal(keyFile.ActiveKeyID))
+
+		plaintext, err := transformer.TransformFromStorage(ctx, data)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(plaintext).To(Equal([]byte("secret")))
+	})
+	It("should use a different data key for each record", func() {
+		data1, err := transformer.TransformToStorage(ctx, []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		data2, err := transformer.TransformToStorage(ctx, []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data1).NotTo(Equal(data2))
+	})
+	It("should return unencrypted records as-is", func() {
+		_, ok := encryption.KeyID([]byte("plaintext"))
+		Expect(ok).To(BeFalse())
+		data, err := transformer.TransformFromStorage(ctx, []byte("plaintext"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(Equal([]byte("plaintext")))
+	})
+	It("should fail to decrypt records which have been modified", func() {
+		data, err := transformer.TransformToStorage(ctx, []byte("secret"))
+		Expect(err).NotTo(HaveOccurred())
+		data[len(data)-3] ^= 1
+		_, err = transformer.TransformFromStorage(ctx, data)
+		Expect(err).To(MatchError(encryption.ErrDecryptionFailed))
+	})
+	When("the key file is rotated", func() {
+		It("should encrypt new records with the new key", func() {
+			oldData, err := transformer.TransformToStorage(ctx, []byte("old"))
+			Expect(err).NotTo(HaveOccurred())
+
+			oldKeyID := keyFile.ActiveKeyID
+			newKey, err := keyFile.Rotate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryption.WriteKeyFile(keyFilePath, keyFile)).To(Succeed())
+
+			newData, err := transformer.TransformToStorage(ctx, []byte("new"))
+			Expect(err).NotTo(HaveOccurred())
+			id, _ := encryption.KeyID(newData)
+			Expect(id).To(Equal(newKey.ID))
+
+			plaintext, err := transformer.TransformFromStorage(ctx, oldData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("old")))
+
+			By("pruning the previous key")
+			Expect(keyFile.Prune()).To(ConsistOf(oldKeyID))
+			Expect(encryption.WriteKeyFile(keyFilePath, keyFile)).To(Succeed())
+			_, err = transformer.TransformFromStorage(ctx, oldData)
+			Expect(err).To(MatchError(encryption.ErrDecryptionFailed))
+
+			plaintext, err = transformer.TransformFromStorage(ctx, newData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plaintext).To(Equal([]byte("new")))
+		})
+	})
+	It("should reject invalid key files", func() {
+		Expect(encryption.WriteKeyFile(keyFilePath, &encryption.KeyFile{})).
+			To(MatchError(encryption.ErrNoActiveKey))
+		Expect(os.WriteFile(keyFilePath, []byte("activeKeyID: foo\n"), 0600)).To(Succeed())
+		_, err := encryption.LoadKeyFile(keyFilePath)
+		Expect(err).To(MatchError(encryption.ErrKeyNotFound))
+	})
+})
@@ -16,3 +16,31 @@ func (e *NotFoundError) Error() string {
 func (e *NotFoundError) GRPCStatus() *status.Status {
 	return status.New(codes.NotFound, e.Error())
 }
+
+// ErrConflict is returned when an object could not be updated because it was
+// modified after the caller last read it.
+var ErrConflict = &ConflictError{}
+
+type ConflictError struct{}
+
+func (e *ConflictError) Error() string {
+	return "conflict: the object has been modified"
+}
+
+func (e *ConflictError) GRPCStatus() *status.Status {
+	return status.New(codes.Aborted, e.Error())
+}
+
+// ErrInvalidPageToken is returned by list operations if the page token could
+// not be decoded.
+var ErrInvalidPageToken = &InvalidPageTokenError{}
+
+type InvalidPageTokenError struct{}
+
+func (e *InvalidPageTokenError) Error() string {
+	return "invalid page token"
+}
+
+func (e *InvalidPageTokenError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
@@ -4,16 +4,21 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	"k8s.io/client-go/util/retry"
 )
 
 func (e *EtcdStore) CreateCluster(ctx context.Context, cluster *core.Cluster) error {
+	cluster = proto.Clone(cluster).(*core.Cluster)
+	storage.InitVersionedObject(cluster)
 	data, err := protojson.Marshal(cluster)
 	if err != nil {
 		return fmt.Errorf("failed to marshal cluster: %w", err)
@@ -44,33 +49,41 @@ func (e *EtcdStore) ListClusters(
 	ctx context.Context,
 	matchLabels *core.LabelSelector,
 	matchOptions core.MatchOptions,
+	opts ...storage.ListOption,
 ) (*core.ClusterList, error) {
-	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
-	defer ca()
-	resp, err := e.Client.Get(ctx, path.Join(e.Prefix, clusterKey),
-		clientv3.WithPrefix(),
-		clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend))
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
 	if err != nil {
-		return nil, fmt.Errorf("failed to list clusters: %w", err)
-	}
-	clusters := &core.ClusterList{
-		Items: []*core.Cluster{},
+		return nil, err
 	}
+	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
+	defer ca()
 	selectorPredicate := storage.ClusterSelector{
 		LabelSelector: matchLabels,
 		MatchOptions:  matchOptions,
 	}.Predicate()
 
-	for _, kv := range resp.Kvs {
-		cluster := &core.Cluster{}
-		if err := protojson.Unmarshal(kv.Value, cluster); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal cluster: %w", err)
-		}
-		if selectorPredicate(cluster) {
-			clusters.Items = append(clusters.Items, cluster)
-		}
+	page := storage.NewPaginator[*core.Cluster](options)
+	err = e.rangeList(ctx, path.Join(e.Prefix, clusterKey), cursor, options,
+		func(id string, kv *mvccpb.KeyValue) (bool, error) {
+			cluster, err := unmarshalCluster(kv)
+			if err != nil {
+				return false, err
+			}
+			if !selectorPredicate(cluster) || !options.MatchCluster(cluster) {
+				return true, nil
+			}
+			return page.Add(id, cluster), nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
 	}
-	return clusters, nil
+	return &core.ClusterList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (e *EtcdStore) GetCluster(ctx context.Context, ref *core.Reference) (*core.Cluster, error) {
@@ -90,11 +103,11 @@ func (e *EtcdStore) getCluster(ctx context.Context, ref *core.Reference) (*core.
 	if len(resp.Kvs) == 0 {
 		return nil, 0, storage.ErrNotFound
 	}
-	cluster := &core.Cluster{}
-	if err := protojson.Unmarshal(resp.Kvs[0].Value, cluster); err != nil {
-		return nil, 0, fmt.Errorf("failed to unmarshal cluster: %w", err)
+	cluster, err := unmarshalCluster(resp.Kvs[0])
+	if err != nil {
+		return nil, 0, err
 	}
-	return cluster, resp.Kvs[0].Version, nil
+	return cluster, resp.Kvs[0].ModRevision, nil
 }
 
 func (e *EtcdStore) UpdateCluster(
@@ -108,16 +121,19 @@ func (e *EtcdStore) UpdateCluster(
 		defer ca()
 		txn := e.Client.Txn(ctx)
 		key := path.Join(e.Prefix, clusterKey, ref.Id)
-		cluster, version, err := e.getCluster(ctx, ref)
+		cluster, revision, err := e.getCluster(ctx, ref)
 		if err != nil {
 			return fmt.Errorf("failed to get cluster: %w", err)
 		}
-		mutator(cluster)
+		if err := storage.ApplyMutator(cluster, mutator); err != nil {
+			return err
+		}
+		cluster.SetResourceVersion("")
 		data, err := protojson.Marshal(cluster)
 		if err != nil {
 			return fmt.Errorf("failed to marshal cluster: %w", err)
 		}
-		txnResp, err := txn.If(clientv3.Compare(clientv3.Version(key), "=", version)).
+		txnResp, err := txn.If(clientv3.Compare(clientv3.ModRevision(key), "=", revision)).
 			Then(clientv3.OpPut(key, string(data))).
 			Commit()
 		if err != nil {
@@ -129,6 +145,7 @@ func (e *EtcdStore) UpdateCluster(
 		if !txnResp.Succeeded {
 			return retryErr
 		}
+		cluster.SetResourceVersion(strconv.FormatInt(txnResp.Header.Revision, 10))
 		retCluster = cluster
 		return nil
 	})
@@ -137,3 +154,107 @@ func (e *EtcdStore) UpdateCluster(
 	}
 	return retCluster, nil
 }
+
+func (e *EtcdStore) WatchClusters(
+	ctx context.Context,
+	known []*core.Cluster,
+) (<-chan storage.WatchEvent[*core.Cluster], error) {
+	prefix := path.Join(e.Prefix, clusterKey) + "/"
+	listCtx, ca := context.WithTimeout(ctx, e.CommandTimeout)
+	defer ca()
+	resp, err := e.Client.Get(listCtx, prefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	current := make([]*core.Cluster, 0, len(resp.Kvs))
+	for _, kv := range resp.Kvs {
+		cluster, err := unmarshalCluster(kv)
+		if err != nil {
+			return nil, err
+		}
+		current = append(current, cluster)
+	}
+	initial := storage.ComputeClusterEvents(known, current)
+
+	// Start watching from the revision immediately following the list, so
+	// that no changes are missed or duplicated.
+	wc := e.Client.Watch(clientv3.WithRequireLeader(ctx), prefix,
+		clientv3.WithPrefix(),
+		clientv3.WithPrevKV(),
+		clientv3.WithRev(resp.Header.Revision+1),
+	)
+
+	eventC := make(chan storage.WatchEvent[*core.Cluster], len(initial))
+	for _, event := range initial {
+		eventC <- event
+	}
+	go func() {
+		defer close(eventC)
+		for resp := range wc {
+			if err := resp.Err(); err != nil {
+				e.Logger.With(
+					zap.Error(err),
+				).Error("cluster watch failed")
+				return
+			}
+			for _, ev := range resp.Events {
+				event, err := decodeClusterEvent(ev)
+				if err != nil {
+					e.Logger.With(
+						zap.Error(err),
+					).Warn("skipping malformed cluster watch event")
+					continue
+				}
+				select {
+				case eventC <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return eventC, nil
+}
+
+func decodeClusterEvent(ev *clientv3.Event) (storage.WatchEvent[*core.Cluster], error) {
+	event := storage.WatchEvent[*core.Cluster]{}
+	var err error
+	if ev.Kv != nil && ev.Type == clientv3.EventTypePut {
+		if event.Current, err = unmarshalCluster(ev.Kv); err != nil {
+			return event, err
+		}
+	}
+	if ev.PrevKv != nil {
+		if event.Previous, err = unmarshalCluster(ev.PrevKv); err != nil {
+			return event, err
+		}
+	}
+	switch {
+	case ev.Type == clientv3.EventTypeDelete:
+		event.EventType = storage.WatchEventDelete
+		if event.Previous == nil {
+			// the previous value may have been compacted
+			event.Previous = &core.Cluster{
+				Id: path.Base(string(ev.Kv.Key)),
+			}
+		}
+	case ev.IsCreate() || event.Previous == nil:
+		event.EventType = storage.WatchEventCreate
+		event.Previous = nil
+	default:
+		event.EventType = storage.WatchEventUpdate
+	}
+	return event, nil
+}
+
+// unmarshalCluster decodes a stored cluster. The resource version is not
+// stored with the cluster; it is the revision at which the key was last
+// modified.
+func unmarshalCluster(kv *mvccpb.KeyValue) (*core.Cluster, error) {
+	cluster := &core.Cluster{}
+	if err := protojson.Unmarshal(kv.Value, cluster); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster: %w", err)
+	}
+	cluster.SetResourceVersion(strconv.FormatInt(kv.ModRevision, 10))
+	return cluster, nil
+}
@@ -50,8 +50,9 @@ type EtcdStore struct {
 var _ storage.Backend = (*EtcdStore)(nil)
 
 type EtcdStoreOptions struct {
-	Prefix         string
-	CommandTimeout time.Duration
+	Prefix           string
+	CommandTimeout   time.Duration
+	ValueTransformer storage.ValueTransformer
 }
 
 type EtcdStoreOption func(*EtcdStoreOptions)
@@ -74,9 +75,18 @@ func WithCommandTimeout(timeout time.Duration) EtcdStoreOption {
 	}
 }
 
+// WithValueTransformer sets the transformer applied to keyring and key-value
+// store data, such as an encryption layer.
+func WithValueTransformer(vt storage.ValueTransformer) EtcdStoreOption {
+	return func(o *EtcdStoreOptions) {
+		o.ValueTransformer = vt
+	}
+}
+
 func NewEtcdStore(ctx context.Context, conf *v1beta1.EtcdStorageSpec, opts ...EtcdStoreOption) *EtcdStore {
 	options := EtcdStoreOptions{
-		CommandTimeout: 5 * time.Second,
+		CommandTimeout:   5 * time.Second,
+		ValueTransformer: storage.IdentityTransformer{},
 	}
 	options.Apply(opts...)
 	lg := logger.New().Named("etcd")
@@ -131,6 +141,7 @@ func (e *EtcdStore) KeyValueStore(prefix string) (storage.KeyValueStore, error)
 	return &genericKeyValueStore{
 		EtcdStoreOptions: e.EtcdStoreOptions,
 		client:           e.Client,
+		logger:           e.Logger.Named("kv"),
 		prefix:           path.Join(pfx, "kv"),
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/keyring"
 	"github.com/rancher/opni-monitoring/pkg/storage"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"k8s.io/client-go/util/retry"
 )
 
 type etcdKeyringStore struct {
@@ -25,6 +26,10 @@ func (ks *etcdKeyringStore) Put(ctx context.Context, keyring keyring.Keyring) er
 	if err != nil {
 		return fmt.Errorf("failed to marshal keyring: %w", err)
 	}
+	k, err = ks.ValueTransformer.TransformToStorage(ctx, k)
+	if err != nil {
+		return fmt.Errorf("failed to transform keyring: %w", err)
+	}
 	_, err = ks.client.Put(ctx, path.Join(ks.prefix, keyringKey, ks.ref.Id), string(k))
 	if err != nil {
 		return fmt.Errorf("failed to put keyring: %w", err)
@@ -35,16 +40,79 @@ func (ks *etcdKeyringStore) Put(ctx context.Context, keyring keyring.Keyring) er
 func (ks *etcdKeyringStore) Get(ctx context.Context) (keyring.Keyring, error) {
 	ctx, ca := context.WithTimeout(ctx, ks.CommandTimeout)
 	defer ca()
+	k, _, err := ks.get(ctx)
+	return k, err
+}
+
+func (ks *etcdKeyringStore) get(ctx context.Context) (keyring.Keyring, int64, error) {
 	resp, err := ks.client.Get(ctx, path.Join(ks.prefix, keyringKey, ks.ref.Id))
 	if err != nil {
-		return nil, fmt.Errorf("failed to get keyring: %w", err)
+		return nil, 0, fmt.Errorf("failed to get keyring: %w", err)
 	}
 	if len(resp.Kvs) == 0 {
-		return nil, storage.ErrNotFound
+		return nil, 0, storage.ErrNotFound
+	}
+	data, err := ks.ValueTransformer.TransformFromStorage(ctx, resp.Kvs[0].Value)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	k, err := keyring.Unmarshal(data)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal keyring: %w", err)
+	}
+	return k, resp.Kvs[0].ModRevision, nil
+}
+
+func (ks *etcdKeyringStore) Update(ctx context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+	var retKeyring keyring.Keyring
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		ctx, ca := context.WithTimeout(ctx, ks.CommandTimeout)
+		defer ca()
+		key := path.Join(ks.prefix, keyringKey, ks.ref.Id)
+		kr, revision, err := ks.get(ctx)
+		if err != nil {
+			return err
+		}
+		kr, err = mutator(kr)
+		if err != nil {
+			return err
+		}
+		k, err := kr.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal keyring: %w", err)
+		}
+		k, err = ks.ValueTransformer.TransformToStorage(ctx, k)
+		if err != nil {
+			return fmt.Errorf("failed to transform keyring: %w", err)
+		}
+		txnResp, err := ks.client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", revision)).
+			Then(clientv3.OpPut(key, string(k))).
+			Commit()
+		if err != nil {
+			return fmt.Errorf("failed to put keyring: %w", err)
+		}
+		if !txnResp.Succeeded {
+			return retryErr
+		}
+		retKeyring = kr
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	k, err := keyring.Unmarshal(resp.Kvs[0].Value)
+	return retKeyring, nil
+}
+
+func (ks *etcdKeyringStore) Delete(ctx context.Context) error {
+	ctx, ca := context.WithTimeout(ctx, ks.CommandTimeout)
+	defer ca()
+	resp, err := ks.client.Delete(ctx, path.Join(ks.prefix, keyringKey, ks.ref.Id))
 	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal keyring: %w", err)
+		return fmt.Errorf("failed to delete keyring: %w", err)
 	}
-	return k, nil
+	if resp.Deleted == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
 }
@@ -4,36 +4,69 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"math"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
 )
 
 type genericKeyValueStore struct {
 	EtcdStoreOptions
 	client *clientv3.Client
+	logger *zap.SugaredLogger
 	prefix string
 }
 
-func (s *genericKeyValueStore) Put(ctx context.Context, key string, value []byte) error {
+func (s *genericKeyValueStore) Put(ctx context.Context, key string, value []byte, opts ...storage.KeyValueStoreOption) error {
 	if err := validateKey(key); err != nil {
 		return err
 	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
 	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
 	defer ca()
-	_, err := s.client.Put(ctx, path.Join(s.prefix, key), base64.StdEncoding.EncodeToString(value))
+	value, err := s.ValueTransformer.TransformToStorage(ctx, value)
 	if err != nil {
 		return err
 	}
+	var putOpts []clientv3.OpOption
+	if options.TTL > 0 {
+		// etcd leases have a granularity of one second
+		lease, err := s.client.Grant(ctx, int64(math.Ceil(options.TTL.Seconds())))
+		if err != nil {
+			return fmt.Errorf("failed to create lease: %w", err)
+		}
+		putOpts = append(putOpts, clientv3.WithLease(lease.ID))
+	}
+	k := path.Join(s.prefix, key)
+	txn := s.client.Txn(ctx)
+	if options.Revision != nil {
+		txn = txn.If(clientv3.Compare(clientv3.ModRevision(k), "=", *options.Revision))
+	}
+	resp, err := txn.
+		Then(clientv3.OpPut(k, base64.StdEncoding.EncodeToString(value), putOpts...)).
+		Commit()
+	if err != nil {
+		return err
+	}
+	if !resp.Succeeded {
+		return storage.ErrConflict
+	}
+	options.SetRevisionOut(resp.Header.Revision)
 	return nil
 }
 
-func (s *genericKeyValueStore) Get(ctx context.Context, key string) ([]byte, error) {
+func (s *genericKeyValueStore) Get(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) ([]byte, error) {
 	if err := validateKey(key); err != nil {
 		return nil, err
 	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
 	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
 	defer ca()
 	resp, err := s.client.Get(ctx, path.Join(s.prefix, key))
@@ -43,20 +76,58 @@ func (s *genericKeyValueStore) Get(ctx context.Context, key string) ([]byte, err
 	if len(resp.Kvs) == 0 {
 		return nil, storage.ErrNotFound
 	}
-	return base64.StdEncoding.DecodeString(string(resp.Kvs[0].Value))
+	value, err := s.decode(ctx, resp.Kvs[0])
+	if err != nil {
+		return nil, err
+	}
+	options.SetRevisionOut(resp.Kvs[0].ModRevision)
+	options.SetTTLOut(0)
+	if options.TTLOut != nil && resp.Kvs[0].Lease != 0 {
+		ttl, err := s.client.TimeToLive(ctx, clientv3.LeaseID(resp.Kvs[0].Lease))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get lease: %w", err)
+		}
+		if ttl.TTL < 0 {
+			// the lease expired after the key was read
+			return nil, storage.ErrNotFound
+		}
+		// etcd leases have a granularity of one second, so a lease which is
+		// about to expire can have a remaining TTL of 0
+		if ttl.TTL == 0 {
+			ttl.TTL = 1
+		}
+		options.SetTTLOut(time.Duration(ttl.TTL) * time.Second)
+	}
+	return value, nil
 }
 
-func (s *genericKeyValueStore) Delete(ctx context.Context, key string) error {
+func (s *genericKeyValueStore) Delete(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) error {
 	if err := validateKey(key); err != nil {
 		return err
 	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
 	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
 	defer ca()
-	resp, err := s.client.Delete(ctx, path.Join(s.prefix, key))
+	k := path.Join(s.prefix, key)
+	txn := s.client.Txn(ctx)
+	if options.Revision != nil {
+		txn = txn.If(clientv3.Compare(clientv3.ModRevision(k), "=", *options.Revision))
+	}
+	resp, err := txn.
+		Then(clientv3.OpDelete(k)).
+		Else(clientv3.OpGet(k, clientv3.WithCountOnly())).
+		Commit()
 	if err != nil {
 		return err
 	}
-	if resp.Deleted == 0 {
+	if !resp.Succeeded {
+		if resp.Responses[0].GetResponseRange().GetCount() == 0 {
+			return storage.ErrNotFound
+		}
+		return storage.ErrConflict
+	}
+	if resp.Responses[0].GetResponseDeleteRange().GetDeleted() == 0 {
 		return storage.ErrNotFound
 	}
 	return nil
@@ -79,6 +150,97 @@ func (s *genericKeyValueStore) ListKeys(ctx context.Context, prefix string) ([]s
 	return keys, nil
 }
 
+func (s *genericKeyValueStore) Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error) {
+	// Watch from the revision following the current one, so that no changes
+	// made after this function returns are missed.
+	getCtx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	resp, err := s.client.Get(getCtx, s.prefix+"/", clientv3.WithCountOnly())
+	ca()
+	if err != nil {
+		return nil, err
+	}
+	wc := s.client.Watch(clientv3.WithRequireLeader(ctx), s.prefix+"/"+prefix,
+		clientv3.WithPrefix(),
+		clientv3.WithPrevKV(),
+		clientv3.WithRev(resp.Header.Revision+1),
+	)
+	eventC := make(chan storage.KeyValueWatchEvent, 64)
+	go func() {
+		defer close(eventC)
+		for wr := range wc {
+			if err := wr.Err(); err != nil {
+				s.logger.With(
+					zap.Error(err),
+				).Error("key-value store watch failed")
+				return
+			}
+			for _, ev := range wr.Events {
+				event, err := s.watchEvent(ctx, ev)
+				if err != nil {
+					s.logger.With(
+						zap.Error(err),
+						"key", string(ev.Kv.Key),
+					).Warn("skipping malformed key-value store watch event")
+					continue
+				}
+				select {
+				case eventC <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return eventC, nil
+}
+
+func (s *genericKeyValueStore) watchEvent(ctx context.Context, ev *clientv3.Event) (storage.KeyValueWatchEvent, error) {
+	event := storage.KeyValueWatchEvent{}
+	if ev.PrevKv != nil {
+		prev, err := s.keyRevision(ctx, ev.PrevKv)
+		if err != nil {
+			return event, err
+		}
+		event.Previous = prev
+	}
+	switch ev.Type {
+	case mvccpb.PUT:
+		current, err := s.keyRevision(ctx, ev.Kv)
+		if err != nil {
+			return event, err
+		}
+		event.Current = current
+		if ev.IsCreate() {
+			event.EventType = storage.WatchEventCreate
+		} else {
+			event.EventType = storage.WatchEventUpdate
+		}
+	case mvccpb.DELETE:
+		event.EventType = storage.WatchEventDelete
+	}
+	return event, nil
+}
+
+func (s *genericKeyValueStore) keyRevision(ctx context.Context, kv *mvccpb.KeyValue) (*storage.KeyRevision, error) {
+	value, err := s.decode(ctx, kv)
+	if err != nil {
+		return nil, err
+	}
+	return &storage.KeyRevision{
+		Key:      strings.TrimPrefix(string(kv.Key), s.prefix+"/"),
+		Value:    value,
+		Revision: kv.ModRevision,
+	}, nil
+}
+
+func (s *genericKeyValueStore) decode(ctx context.Context, kv *mvccpb.KeyValue) ([]byte, error) {
+	value, err := base64.StdEncoding.DecodeString(string(kv.Value))
+	if err != nil {
+		return nil, err
+	}
+	return s.ValueTransformer.TransformFromStorage(ctx, value)
+}
+
 func validateKey(key string) error {
 	// etcd will check keys, but we need to check if the key is empty ourselves
 	// since we always prepend a prefix to the key
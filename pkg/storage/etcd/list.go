@@ -0,0 +1,66 @@
+package etcd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// rangeList calls fn for each key under the prefix in sort order, starting
+// after the cursor, until fn returns false. If a page size is set, keys are
+// read using range requests of at most one page (plus one key, to determine
+// whether there is a next page) instead of reading all keys at once.
+func (e *EtcdStore) rangeList(
+	ctx context.Context,
+	prefix string,
+	cursor string,
+	options storage.ListOptions,
+	fn func(id string, kv *mvccpb.KeyValue) (bool, error),
+) error {
+	prefix = strings.TrimSuffix(prefix, "/") + "/"
+	start, end := prefix, clientv3.GetPrefixRangeEnd(prefix)
+	order := clientv3.SortAscend
+	if options.Descending() {
+		order = clientv3.SortDescend
+		if cursor != "" {
+			end = prefix + cursor
+		}
+	} else if cursor != "" {
+		start = prefix + cursor + "\x00"
+	}
+	for {
+		opts := []clientv3.OpOption{
+			clientv3.WithRange(end),
+			clientv3.WithSort(clientv3.SortByKey, order),
+		}
+		if options.PageSize > 0 {
+			opts = append(opts, clientv3.WithLimit(int64(options.PageSize)+1))
+		}
+		resp, err := e.Client.Get(ctx, start, opts...)
+		if err != nil {
+			return err
+		}
+		for _, kv := range resp.Kvs {
+			ok, err := fn(strings.TrimPrefix(string(kv.Key), prefix), kv)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return nil
+			}
+		}
+		if !resp.More || len(resp.Kvs) == 0 {
+			return nil
+		}
+		// Some keys were filtered out; continue with the next range.
+		last := string(resp.Kvs[len(resp.Kvs)-1].Key)
+		if options.Descending() {
+			end = last
+		} else {
+			start = last + "\x00"
+		}
+	}
+}
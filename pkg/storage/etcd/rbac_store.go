@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
+	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/client-go/util/retry"
 )
 
 func (e *EtcdStore) CreateRole(ctx context.Context, role *core.Role) error {
 	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
 	defer ca()
+	role = proto.Clone(role).(*core.Role)
+	storage.InitVersionedObject(role)
 	data, err := protojson.Marshal(role)
 	if err != nil {
 		return fmt.Errorf("failed to marshal role: %w", err)
@@ -48,16 +55,66 @@ func (e *EtcdStore) GetRole(ctx context.Context, ref *core.Reference) (*core.Rol
 	if len(resp.Kvs) == 0 {
 		return nil, fmt.Errorf("failed to get role: %w", storage.ErrNotFound)
 	}
-	role := &core.Role{}
-	if err := protojson.Unmarshal(resp.Kvs[0].Value, role); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal role: %w", err)
+	return unmarshalRole(resp.Kvs[0])
+}
+
+func (e *EtcdStore) UpdateRole(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.RoleMutator,
+) (*core.Role, error) {
+	var retRole *core.Role
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
+		defer ca()
+		key := path.Join(e.Prefix, roleKey, ref.Id)
+		resp, err := e.Client.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get role: %w", err)
+		}
+		if len(resp.Kvs) == 0 {
+			return storage.ErrNotFound
+		}
+		role, err := unmarshalRole(resp.Kvs[0])
+		if err != nil {
+			return err
+		}
+		if err := storage.ApplyMutator(role, mutator); err != nil {
+			return err
+		}
+		role.SetResourceVersion("")
+		data, err := protojson.Marshal(role)
+		if err != nil {
+			return fmt.Errorf("failed to marshal role: %w", err)
+		}
+		txnResp, err := e.Client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)).
+			Then(clientv3.OpPut(key, string(data))).
+			Commit()
+		if err != nil {
+			e.Logger.With(
+				zap.Error(err),
+			).Error("error updating role")
+			return err
+		}
+		if !txnResp.Succeeded {
+			return retryErr
+		}
+		role.SetResourceVersion(strconv.FormatInt(txnResp.Header.Revision, 10))
+		retRole = role
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	return role, nil
+	return retRole, nil
 }
 
 func (e *EtcdStore) CreateRoleBinding(ctx context.Context, roleBinding *core.RoleBinding) error {
 	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
 	defer ca()
+	roleBinding = proto.Clone(roleBinding).(*core.RoleBinding)
+	storage.InitVersionedObject(roleBinding)
 	data, err := protojson.Marshal(roleBinding)
 	if err != nil {
 		return fmt.Errorf("failed to marshal role binding: %w", err)
@@ -92,9 +149,9 @@ func (e *EtcdStore) GetRoleBinding(ctx context.Context, ref *core.Reference) (*c
 	if len(resp.Kvs) == 0 {
 		return nil, fmt.Errorf("failed to get role binding: %w", storage.ErrNotFound)
 	}
-	roleBinding := &core.RoleBinding{}
-	if err := protojson.Unmarshal(resp.Kvs[0].Value, roleBinding); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal role binding: %w", err)
+	roleBinding, err := unmarshalRoleBinding(resp.Kvs[0])
+	if err != nil {
+		return nil, err
 	}
 	if err := storage.ApplyRoleBindingTaints(ctx, e, roleBinding); err != nil {
 		return nil, err
@@ -102,45 +159,137 @@ func (e *EtcdStore) GetRoleBinding(ctx context.Context, ref *core.Reference) (*c
 	return roleBinding, nil
 }
 
-func (e *EtcdStore) ListRoles(ctx context.Context) (*core.RoleList, error) {
+func (e *EtcdStore) UpdateRoleBinding(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.RoleBindingMutator,
+) (*core.RoleBinding, error) {
+	var retRoleBinding *core.RoleBinding
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
+		defer ca()
+		key := path.Join(e.Prefix, roleBindingKey, ref.Id)
+		resp, err := e.Client.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf("failed to get role binding: %w", err)
+		}
+		if len(resp.Kvs) == 0 {
+			return storage.ErrNotFound
+		}
+		roleBinding, err := unmarshalRoleBinding(resp.Kvs[0])
+		if err != nil {
+			return err
+		}
+		if err := storage.ApplyMutator(roleBinding, mutator); err != nil {
+			return err
+		}
+		roleBinding.SetResourceVersion("")
+		roleBinding.Taints = nil
+		data, err := protojson.Marshal(roleBinding)
+		if err != nil {
+			return fmt.Errorf("failed to marshal role binding: %w", err)
+		}
+		txnResp, err := e.Client.Txn(ctx).
+			If(clientv3.Compare(clientv3.ModRevision(key), "=", resp.Kvs[0].ModRevision)).
+			Then(clientv3.OpPut(key, string(data))).
+			Commit()
+		if err != nil {
+			e.Logger.With(
+				zap.Error(err),
+			).Error("error updating role binding")
+			return err
+		}
+		if !txnResp.Succeeded {
+			return retryErr
+		}
+		roleBinding.SetResourceVersion(strconv.FormatInt(txnResp.Header.Revision, 10))
+		retRoleBinding = roleBinding
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := storage.ApplyRoleBindingTaints(ctx, e, retRoleBinding); err != nil {
+		return nil, err
+	}
+	return retRoleBinding, nil
+}
+
+func (e *EtcdStore) ListRoles(ctx context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
 	defer ca()
-	resp, err := e.Client.Get(ctx, path.Join(e.Prefix, roleKey), clientv3.WithPrefix())
+	page := storage.NewPaginator[*core.Role](options)
+	err = e.rangeList(ctx, path.Join(e.Prefix, roleKey), cursor, options,
+		func(id string, kv *mvccpb.KeyValue) (bool, error) {
+			role, err := unmarshalRole(kv)
+			if err != nil {
+				return false, err
+			}
+			return page.Add(id, role), nil
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list roles: %w", err)
 	}
-	roleList := &core.RoleList{
-		Items: make([]*core.Role, len(resp.Kvs)),
-	}
-	for i, kv := range resp.Kvs {
-		role := &core.Role{}
-		if err := protojson.Unmarshal(kv.Value, role); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal role: %w", err)
-		}
-		roleList.Items[i] = role
-	}
-	return roleList, nil
+	return &core.RoleList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
-func (e *EtcdStore) ListRoleBindings(ctx context.Context) (*core.RoleBindingList, error) {
+func (e *EtcdStore) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
 	defer ca()
-	resp, err := e.Client.Get(ctx, path.Join(e.Prefix, roleBindingKey), clientv3.WithPrefix())
+	page := storage.NewPaginator[*core.RoleBinding](options)
+	err = e.rangeList(ctx, path.Join(e.Prefix, roleBindingKey), cursor, options,
+		func(id string, kv *mvccpb.KeyValue) (bool, error) {
+			roleBinding, err := unmarshalRoleBinding(kv)
+			if err != nil {
+				return false, err
+			}
+			return page.Add(id, roleBinding), nil
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list role bindings: %w", err)
 	}
-	roleBindingList := &core.RoleBindingList{
-		Items: make([]*core.RoleBinding, len(resp.Kvs)),
-	}
-	for i, kv := range resp.Kvs {
-		roleBinding := &core.RoleBinding{}
-		if err := protojson.Unmarshal(kv.Value, roleBinding); err != nil {
-			return nil, fmt.Errorf("failed to decode role binding: %w", err)
-		}
+	for _, roleBinding := range page.Items() {
 		if err := storage.ApplyRoleBindingTaints(ctx, e, roleBinding); err != nil {
 			return nil, err
 		}
-		roleBindingList.Items[i] = roleBinding
 	}
-	return roleBindingList, nil
+	return &core.RoleBindingList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func unmarshalRole(kv *mvccpb.KeyValue) (*core.Role, error) {
+	role := &core.Role{}
+	if err := protojson.Unmarshal(kv.Value, role); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal role: %w", err)
+	}
+	role.SetResourceVersion(strconv.FormatInt(kv.ModRevision, 10))
+	return role, nil
+}
+
+func unmarshalRoleBinding(kv *mvccpb.KeyValue) (*core.RoleBinding, error) {
+	roleBinding := &core.RoleBinding{}
+	if err := protojson.Unmarshal(kv.Value, roleBinding); err != nil {
+		return nil, fmt.Errorf("failed to decode role binding: %w", err)
+	}
+	roleBinding.SetResourceVersion(strconv.FormatInt(kv.ModRevision, 10))
+	return roleBinding, nil
 }
@@ -8,7 +8,7 @@ import (
 
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/storage"
-	"github.com/rancher/opni-monitoring/pkg/tokens"
+	"go.etcd.io/etcd/api/v3/mvccpb"
 	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -25,12 +25,13 @@ func (e *EtcdStore) CreateToken(ctx context.Context, ttl time.Duration, opts ...
 	if err != nil {
 		return nil, fmt.Errorf("failed to create lease: %w", err)
 	}
-	token := tokens.NewToken().ToBootstrapToken()
+	token := options.Token.ToBootstrapToken()
 	token.Metadata = &core.BootstrapTokenMetadata{
 		LeaseID:      int64(lease.ID),
 		UsageCount:   0,
 		Labels:       options.Labels,
 		Capabilities: options.Capabilities,
+		Limits:       options.Limits,
 	}
 	data, err := protojson.Marshal(token)
 	if err != nil {
@@ -98,25 +99,38 @@ func (e *EtcdStore) getToken(ctx context.Context, ref *core.Reference) (*core.Bo
 	return token, kv.Version, nil
 }
 
-func (e *EtcdStore) ListTokens(ctx context.Context) ([]*core.BootstrapToken, error) {
+func (e *EtcdStore) ListTokens(ctx context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
 	ctx, ca := context.WithTimeout(ctx, e.CommandTimeout)
 	defer ca()
-	resp, err := e.Client.Get(ctx, path.Join(e.Prefix, tokensKey), clientv3.WithPrefix())
+	page := storage.NewPaginator[*core.BootstrapToken](options)
+	err = e.rangeList(ctx, path.Join(e.Prefix, tokensKey), cursor, options,
+		func(id string, kv *mvccpb.KeyValue) (bool, error) {
+			token := &core.BootstrapToken{}
+			if err := protojson.Unmarshal(kv.Value, token); err != nil {
+				return false, fmt.Errorf("failed to unmarshal token: %w", err)
+			}
+			if !options.MatchToken(token) {
+				return true, nil
+			}
+			if err := e.addLeaseMetadata(ctx, token, kv.Lease); err != nil {
+				return false, err
+			}
+			return page.Add(id, token), nil
+		},
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list tokens: %w", err)
 	}
-	items := make([]*core.BootstrapToken, len(resp.Kvs))
-	for i, kv := range resp.Kvs {
-		token := &core.BootstrapToken{}
-		if err := protojson.Unmarshal(kv.Value, token); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal token: %w", err)
-		}
-		if err := e.addLeaseMetadata(ctx, token, kv.Lease); err != nil {
-			return nil, err
-		}
-		items[i] = token
-	}
-	return items, nil
+	return &core.BootstrapTokenList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
 }
 
 func (e *EtcdStore) UpdateToken(ctx context.Context, ref *core.Reference, mutator storage.MutatorFunc[*core.BootstrapToken]) (*core.BootstrapToken, error) {
@@ -0,0 +1,220 @@
+package file
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/validation"
+)
+
+// FileStore implements storage.KeyringStoreBroker by storing each keyring in
+// its own file in a local directory. Keyring files and directories are only
+// accessible by the owner, and files with looser permissions are rejected.
+// Writes are serialized within the process, so the directory must not be
+// shared by multiple processes.
+type FileStore struct {
+	FileStoreOptions
+	Dir string
+	mu  sync.Mutex
+}
+
+var _ storage.KeyringStoreBroker = (*FileStore)(nil)
+
+type FileStoreOptions struct {
+	ValueTransformer storage.ValueTransformer
+}
+
+type FileStoreOption func(*FileStoreOptions)
+
+func (o *FileStoreOptions) Apply(opts ...FileStoreOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithValueTransformer sets the transformer applied to keyring data, such as
+// an encryption layer.
+func WithValueTransformer(vt storage.ValueTransformer) FileStoreOption {
+	return func(o *FileStoreOptions) {
+		o.ValueTransformer = vt
+	}
+}
+
+func NewFileStore(conf *v1beta1.FileStorageSpec, opts ...FileStoreOption) (*FileStore, error) {
+	options := FileStoreOptions{
+		ValueTransformer: storage.IdentityTransformer{},
+	}
+	options.Apply(opts...)
+
+	if conf.Dir == "" {
+		return nil, errors.New("keyring directory is not set")
+	}
+	if err := os.MkdirAll(conf.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create keyring directory: %w", err)
+	}
+	if err := checkPermissions(conf.Dir); err != nil {
+		return nil, err
+	}
+	return &FileStore{
+		FileStoreOptions: options,
+		Dir:              conf.Dir,
+	}, nil
+}
+
+func (s *FileStore) KeyringStore(
+	_ context.Context,
+	namespace string,
+	ref *core.Reference,
+) (storage.KeyringStore, error) {
+	if err := validation.ValidateID(namespace); err != nil {
+		return nil, fmt.Errorf("invalid namespace %q: %w", namespace, err)
+	}
+	if err := validation.Validate(ref); err != nil {
+		return nil, err
+	}
+	return &fileKeyringStore{
+		store:     s,
+		namespace: namespace,
+		name:      ref.Id + ".keyring",
+	}, nil
+}
+
+type fileKeyringStore struct {
+	store     *FileStore
+	namespace string
+	name      string
+}
+
+func (ks *fileKeyringStore) dir() string {
+	return filepath.Join(ks.store.Dir, ks.namespace)
+}
+
+func (ks *fileKeyringStore) path() string {
+	return filepath.Join(ks.dir(), ks.name)
+}
+
+// Put atomically replaces the keyring file, so that a partially written
+// keyring is never observed.
+func (ks *fileKeyringStore) Put(ctx context.Context, keyring keyring.Keyring) error {
+	ks.store.mu.Lock()
+	defer ks.store.mu.Unlock()
+	return ks.put(ctx, keyring)
+}
+
+func (ks *fileKeyringStore) put(ctx context.Context, keyring keyring.Keyring) error {
+	k, err := keyring.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal keyring: %w", err)
+	}
+	k, err = ks.store.ValueTransformer.TransformToStorage(ctx, k)
+	if err != nil {
+		return fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	if err := os.MkdirAll(ks.dir(), 0700); err != nil {
+		return fmt.Errorf("failed to put keyring: %w", err)
+	}
+	f, err := os.CreateTemp(ks.dir(), "."+ks.name+"-*")
+	if err != nil {
+		return fmt.Errorf("failed to put keyring: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(k)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to put keyring: %w", err)
+	}
+	if err := os.Rename(f.Name(), ks.path()); err != nil {
+		return fmt.Errorf("failed to put keyring: %w", err)
+	}
+	return nil
+}
+
+func (ks *fileKeyringStore) Get(ctx context.Context) (keyring.Keyring, error) {
+	f, err := os.Open(ks.path())
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get keyring: %w", err)
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keyring: %w", err)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return nil, fmt.Errorf("keyring file %s has insecure permissions %#o (expected 0600)",
+			ks.path(), info.Mode().Perm())
+	}
+	data := make([]byte, info.Size())
+	if _, err := f.ReadAt(data, 0); err != nil {
+		return nil, fmt.Errorf("failed to get keyring: %w", err)
+	}
+	data, err = ks.store.ValueTransformer.TransformFromStorage(ctx, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	k, err := keyring.Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal keyring: %w", err)
+	}
+	return k, nil
+}
+
+func (ks *fileKeyringStore) Update(ctx context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+	ks.store.mu.Lock()
+	defer ks.store.mu.Unlock()
+	kr, err := ks.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kr, err = mutator(kr)
+	if err != nil {
+		return nil, err
+	}
+	if err := ks.put(ctx, kr); err != nil {
+		return nil, err
+	}
+	return kr, nil
+}
+
+func (ks *fileKeyringStore) Delete(context.Context) error {
+	ks.store.mu.Lock()
+	defer ks.store.mu.Unlock()
+	if err := os.Remove(ks.path()); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return storage.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete keyring: %w", err)
+	}
+	return nil
+}
+
+func checkPermissions(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("failed to check keyring directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", dir)
+	}
+	if info.Mode().Perm()&0077 != 0 {
+		return fmt.Errorf("keyring directory %s has insecure permissions %#o (expected 0700)",
+			dir, info.Mode().Perm())
+	}
+	return nil
+}
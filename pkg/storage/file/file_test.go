@@ -0,0 +1,100 @@
+package file_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage/conformance"
+	"github.com/rancher/opni-monitoring/pkg/storage/file"
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"github.com/rancher/opni-monitoring/pkg/util"
+)
+
+func TestFile(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "File Storage Suite")
+}
+
+var store = util.NewFuture[*file.FileStore]()
+var errCtrl = util.NewFuture[conformance.ErrorController]()
+
+var _ = BeforeSuite(func() {
+	dir := filepath.Join(GinkgoT().TempDir(), "keyrings")
+	s, err := file.NewFileStore(&v1beta1.FileStorageSpec{
+		Dir: dir,
+	})
+	Expect(err).NotTo(HaveOccurred())
+	store.Set(s)
+
+	// Replacing the directory with a regular file causes all operations to
+	// fail, even when running as root.
+	errCtrl.Set(conformance.NewErrorController(
+		func() {
+			Expect(os.Rename(dir, dir+".bak")).To(Succeed())
+			Expect(os.WriteFile(dir, nil, 0600)).To(Succeed())
+		},
+		func() {
+			Expect(os.Remove(dir)).To(Succeed())
+			Expect(os.Rename(dir+".bak", dir)).To(Succeed())
+		},
+	))
+})
+
+var _ = Describe("Keyring Store", Ordered, conformance.KeyringStoreTestSuite(store, errCtrl))
+
+var _ = Describe("Permissions", Label(test.Unit), func() {
+	It("should create keyring files which are only accessible by the owner", func() {
+		ks, err := store.Get().KeyringStore(context.Background(), "perms", &core.Reference{
+			Id: "test",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(context.Background(), keyring.New())).To(Succeed())
+
+		dirInfo, err := os.Stat(filepath.Join(store.Get().Dir, "perms"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dirInfo.Mode().Perm()).To(Equal(os.FileMode(0700)))
+		info, err := os.Stat(filepath.Join(store.Get().Dir, "perms", "test.keyring"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+	It("should reject keyring files with insecure permissions", func() {
+		ks, err := store.Get().KeyringStore(context.Background(), "perms", &core.Reference{
+			Id: "insecure",
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ks.Put(context.Background(), keyring.New())).To(Succeed())
+		Expect(os.Chmod(filepath.Join(store.Get().Dir, "perms", "insecure.keyring"), 0644)).To(Succeed())
+
+		_, err = ks.Get(context.Background())
+		Expect(err).To(MatchError(ContainSubstring("insecure permissions")))
+	})
+	It("should reject directories with insecure permissions", func() {
+		dir := filepath.Join(GinkgoT().TempDir(), "keyrings")
+		Expect(os.Mkdir(dir, 0755)).To(Succeed())
+		_, err := file.NewFileStore(&v1beta1.FileStorageSpec{
+			Dir: dir,
+		})
+		Expect(err).To(MatchError(ContainSubstring("insecure permissions")))
+	})
+	It("should reject invalid keyring names", func() {
+		_, err := store.Get().KeyringStore(context.Background(), "..", &core.Reference{
+			Id: "test",
+		})
+		Expect(err).To(HaveOccurred())
+		_, err = store.Get().KeyringStore(context.Background(), "test", &core.Reference{
+			Id: "../test",
+		})
+		Expect(err).To(HaveOccurred())
+		_, err = store.Get().KeyringStore(context.Background(), "test", &core.Reference{
+			Id: "does-not-exist",
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"encoding/base64"
+	"sort"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+)
+
+type ListOptions struct {
+	// The maximum number of items to return. If 0, all items are returned.
+	PageSize int
+	// A token returned in a previous list response. If set, the list starts
+	// after the last item of the previous page.
+	PageToken string
+	// Items are sorted by ID, in ascending order by default.
+	SortOrder core.SortOrder
+	// If set, only objects which have all of the named capabilities are
+	// returned. Only applies to clusters and bootstrap tokens.
+	Capabilities []string
+}
+
+type ListOption func(*ListOptions)
+
+func (o *ListOptions) Apply(opts ...ListOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+func WithPageSize(size int) ListOption {
+	return func(o *ListOptions) {
+		o.PageSize = size
+	}
+}
+
+func WithPageToken(token string) ListOption {
+	return func(o *ListOptions) {
+		o.PageToken = token
+	}
+}
+
+func WithSortOrder(order core.SortOrder) ListOption {
+	return func(o *ListOptions) {
+		o.SortOrder = order
+	}
+}
+
+// WithCapabilityFilter only lists objects which have all of the named
+// capabilities. For bootstrap tokens, capabilities are matched by type.
+func WithCapabilityFilter(names ...string) ListOption {
+	return func(o *ListOptions) {
+		o.Capabilities = names
+	}
+}
+
+// Descending returns true if items should be listed in descending order.
+func (o ListOptions) Descending() bool {
+	return o.SortOrder == core.SortOrder_Descending
+}
+
+// Cursor decodes the page token, and returns the ID of the last item in the
+// previous page, or an empty string if no page token was given.
+func (o ListOptions) Cursor() (string, error) {
+	if o.PageToken == "" {
+		return "", nil
+	}
+	id, err := base64.RawURLEncoding.DecodeString(o.PageToken)
+	if err != nil || len(id) == 0 {
+		return "", ErrInvalidPageToken
+	}
+	return string(id), nil
+}
+
+// After returns true if an item with the given ID sorts after the cursor.
+func (o ListOptions) After(cursor, id string) bool {
+	if cursor == "" {
+		return true
+	}
+	if o.Descending() {
+		return id < cursor
+	}
+	return id > cursor
+}
+
+// MatchCluster returns true if the cluster has all capabilities named in the
+// capability filter.
+func (o ListOptions) MatchCluster(cluster *core.Cluster) bool {
+	names := make([]string, 0, len(cluster.GetCapabilities()))
+	for _, c := range cluster.GetCapabilities() {
+		names = append(names, c.GetName())
+	}
+	return containsAll(names, o.Capabilities)
+}
+
+// MatchToken returns true if the token has all capability types named in the
+// capability filter.
+func (o ListOptions) MatchToken(token *core.BootstrapToken) bool {
+	types := make([]string, 0, len(token.GetCapabilities()))
+	for _, c := range token.GetCapabilities() {
+		types = append(types, c.GetType())
+	}
+	return containsAll(types, o.Capabilities)
+}
+
+func containsAll(have []string, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if h == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Paginator collects the items of a single page. Stores should add matching
+// items in sort order, starting after the cursor, until Add returns false.
+type Paginator[T any] struct {
+	pageSize int
+	items    []T
+	lastID   string
+	hasMore  bool
+}
+
+func NewPaginator[T any](options ListOptions) *Paginator[T] {
+	return &Paginator[T]{
+		pageSize: options.PageSize,
+		items:    []T{},
+	}
+}
+
+// Add adds an item to the page. If the page is already full, the item is not
+// added and false is returned, indicating that there is at least one more
+// page and the store should stop listing.
+func (p *Paginator[T]) Add(id string, item T) bool {
+	if p.pageSize > 0 && len(p.items) >= p.pageSize {
+		p.hasMore = true
+		return false
+	}
+	p.items = append(p.items, item)
+	p.lastID = id
+	return true
+}
+
+func (p *Paginator[T]) Items() []T {
+	return p.items
+}
+
+// NextPageToken returns the token which can be used to request the next
+// page, or an empty string if this is the last page.
+func (p *Paginator[T]) NextPageToken() string {
+	if !p.hasMore {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(p.lastID))
+}
+
+// Paginate applies the list options to a complete set of items, for stores
+// which cannot list items in pages. Items which do not match any filters
+// must be removed by the caller beforehand.
+func Paginate[T any](items []T, id func(T) string, options ListOptions) ([]T, string, error) {
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if options.Descending() {
+			return id(sorted[i]) > id(sorted[j])
+		}
+		return id(sorted[i]) < id(sorted[j])
+	})
+	p := NewPaginator[T](options)
+	for _, item := range sorted {
+		if !options.After(cursor, id(item)) {
+			continue
+		}
+		if !p.Add(id(item), item) {
+			break
+		}
+	}
+	return p.Items(), p.NextPageToken(), nil
+}
@@ -0,0 +1,116 @@
+package storage_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("List Options", Label(test.Unit), func() {
+	ids := func(clusters []*core.Cluster) []string {
+		var ids []string
+		for _, c := range clusters {
+			ids = append(ids, c.Id)
+		}
+		return ids
+	}
+	var clusters []*core.Cluster
+	BeforeEach(func() {
+		clusters = nil
+		for _, i := range []int{3, 1, 4, 0, 2} {
+			clusters = append(clusters, cluster(fmt.Sprintf("c%d", i)))
+		}
+	})
+	listAll := func(opts ...storage.ListOption) [][]string {
+		var pages [][]string
+		token := ""
+		for {
+			options := storage.ListOptions{}
+			options.Apply(append(opts, storage.WithPageToken(token))...)
+			items, next, err := storage.Paginate(clusters, (*core.Cluster).GetId, options)
+			Expect(err).NotTo(HaveOccurred())
+			pages = append(pages, ids(items))
+			if next == "" {
+				return pages
+			}
+			token = next
+		}
+	}
+
+	It("should return all items sorted by ID if no page size is set", func() {
+		Expect(listAll()).To(Equal([][]string{
+			{"c0", "c1", "c2", "c3", "c4"},
+		}))
+	})
+	It("should return items in pages", func() {
+		Expect(listAll(storage.WithPageSize(2))).To(Equal([][]string{
+			{"c0", "c1"},
+			{"c2", "c3"},
+			{"c4"},
+		}))
+		Expect(listAll(storage.WithPageSize(5))).To(Equal([][]string{
+			{"c0", "c1", "c2", "c3", "c4"},
+		}))
+	})
+	It("should sort items in descending order", func() {
+		Expect(listAll(storage.WithPageSize(2), storage.WithSortOrder(core.SortOrder_Descending))).To(Equal([][]string{
+			{"c4", "c3"},
+			{"c2", "c1"},
+			{"c0"},
+		}))
+	})
+	It("should continue after the cursor if the last item was deleted", func() {
+		options := storage.ListOptions{}
+		options.Apply(storage.WithPageSize(2))
+		_, next, err := storage.Paginate(clusters, (*core.Cluster).GetId, options)
+		Expect(err).NotTo(HaveOccurred())
+
+		remaining := []*core.Cluster{}
+		for _, c := range clusters {
+			if c.Id != "c1" {
+				remaining = append(remaining, c)
+			}
+		}
+		options.Apply(storage.WithPageToken(next))
+		items, _, err := storage.Paginate(remaining, (*core.Cluster).GetId, options)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ids(items)).To(Equal([]string{"c2", "c3"}))
+	})
+	It("should reject invalid page tokens", func() {
+		options := storage.ListOptions{}
+		options.Apply(storage.WithPageToken("not a token!"))
+		_, _, err := storage.Paginate(clusters, (*core.Cluster).GetId, options)
+		Expect(err).To(MatchError(storage.ErrInvalidPageToken))
+	})
+	It("should match capabilities", func() {
+		c := cluster("c1")
+		c.Metadata.Capabilities = []*core.ClusterCapability{
+			{Name: "foo"},
+			{Name: "bar"},
+		}
+		t := &core.BootstrapToken{
+			Metadata: &core.BootstrapTokenMetadata{
+				Capabilities: []*core.TokenCapability{
+					{Type: "foo"},
+				},
+			},
+		}
+		match := func(names ...string) storage.ListOptions {
+			options := storage.ListOptions{}
+			options.Apply(storage.WithCapabilityFilter(names...))
+			return options
+		}
+		Expect(match().MatchCluster(c)).To(BeTrue())
+		Expect(match("foo").MatchCluster(c)).To(BeTrue())
+		Expect(match("foo", "bar").MatchCluster(c)).To(BeTrue())
+		Expect(match("foo", "baz").MatchCluster(c)).To(BeFalse())
+		Expect(match("foo").MatchCluster(cluster("c2"))).To(BeFalse())
+		Expect(match("foo").MatchToken(t)).To(BeTrue())
+		Expect(match("bar").MatchToken(t)).To(BeFalse())
+	})
+})
@@ -45,3 +45,14 @@ func NewRemoveCapabilityMutator[O core.MetadataAccessor[T], T core.Capability[T]
 		obj.SetCapabilities(capabilities)
 	}
 }
+
+// NewCompareAndSwapMutator returns a mutator which causes the update to fail
+// with ErrConflict if the object's current resource version does not match
+// the given version. If the version is empty, the update is unconditional.
+func NewCompareAndSwapMutator[T core.VersionedObject](resourceVersion string) MutatorFunc[T] {
+	return func(obj T) {
+		if resourceVersion != "" {
+			obj.SetResourceVersion(resourceVersion)
+		}
+	}
+}
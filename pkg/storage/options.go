@@ -1,16 +1,24 @@
 package storage
 
-import "github.com/rancher/opni-monitoring/pkg/core"
+import (
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/tokens"
+)
 
 type TokenCreateOptions struct {
 	Labels       map[string]string
 	Capabilities []*core.TokenCapability
+	Limits       *core.TokenLimits
+	Token        *tokens.Token
 }
 
 func NewTokenCreateOptions() TokenCreateOptions {
 	return TokenCreateOptions{
 		Labels:       map[string]string{},
 		Capabilities: []*core.TokenCapability{},
+		Token:        tokens.NewToken(),
 	}
 }
 
@@ -33,3 +41,104 @@ func WithCapabilities(capabilities []*core.TokenCapability) TokenCreateOption {
 		o.Capabilities = capabilities
 	}
 }
+
+// WithLimits restricts the number of times the token can be used, and the
+// addresses from which it can be used.
+func WithLimits(limits *core.TokenLimits) TokenCreateOption {
+	return func(o *TokenCreateOptions) {
+		o.Limits = limits
+	}
+}
+
+// WithToken creates the token using an existing ID and secret instead of
+// generating a new one. This is used when restoring tokens from a backup.
+func WithToken(token *tokens.Token) TokenCreateOption {
+	return func(o *TokenCreateOptions) {
+		o.Token = token
+	}
+}
+
+// KeyValueStoreOptions configures a single key-value store operation.
+// Options which do not apply to an operation are ignored.
+type KeyValueStoreOptions struct {
+	// If set, a Put or Delete only succeeds if the current revision of the key
+	// is equal to this revision. A revision of 0 requires that the key does
+	// not exist. If the revision does not match, ErrConflict is returned.
+	Revision *int64
+	// If non-zero, a Put will cause the key to expire after this duration.
+	TTL time.Duration
+	// If set, the revision of the key after a Put, or the current revision of
+	// the key for a Get, is written to this address.
+	RevisionOut *int64
+	// If set, the time remaining before the key expires for a Get is written
+	// to this address, or 0 if the key does not expire.
+	TTLOut *time.Duration
+}
+
+type KeyValueStoreOption func(*KeyValueStoreOptions)
+
+func (o *KeyValueStoreOptions) Apply(opts ...KeyValueStoreOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithRevision makes a Put or Delete conditional on the current revision of
+// the key. A revision of 0 requires that the key does not exist.
+func WithRevision(revision int64) KeyValueStoreOption {
+	return func(o *KeyValueStoreOptions) {
+		o.Revision = &revision
+	}
+}
+
+// WithTTL causes a key to expire after the given duration. The TTL only
+// applies to the value being put; a subsequent Put without a TTL will not
+// expire.
+func WithTTL(ttl time.Duration) KeyValueStoreOption {
+	return func(o *KeyValueStoreOptions) {
+		o.TTL = ttl
+	}
+}
+
+// WithRevisionOut stores the revision of the key after a Put, or the current
+// revision of the key for a Get, in the given address.
+func WithRevisionOut(out *int64) KeyValueStoreOption {
+	return func(o *KeyValueStoreOptions) {
+		o.RevisionOut = out
+	}
+}
+
+// WithTTLOut stores the time remaining before the key expires for a Get, or 0
+// if the key does not expire, in the given address. This can be used to
+// rewrite a key without changing when it expires.
+func WithTTLOut(out *time.Duration) KeyValueStoreOption {
+	return func(o *KeyValueStoreOptions) {
+		o.TTLOut = out
+	}
+}
+
+// CheckRevision returns ErrConflict if a revision was requested and does not
+// match the current revision of the key. The current revision of a key which
+// does not exist is 0.
+func (o KeyValueStoreOptions) CheckRevision(current int64) error {
+	if o.Revision != nil && *o.Revision != current {
+		return ErrConflict
+	}
+	return nil
+}
+
+// SetRevisionOut stores the revision in the address given by WithRevisionOut,
+// if any.
+func (o KeyValueStoreOptions) SetRevisionOut(revision int64) {
+	if o.RevisionOut != nil {
+		*o.RevisionOut = revision
+	}
+}
+
+// SetTTLOut stores the remaining TTL in the address given by WithTTLOut, if
+// any.
+func (o KeyValueStoreOptions) SetTTLOut(ttl time.Duration) {
+	if o.TTLOut != nil {
+		*o.TTLOut = ttl
+	}
+}
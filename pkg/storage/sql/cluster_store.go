@@ -0,0 +1,278 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/client-go/util/retry"
+)
+
+func (s *SQLStore) CreateCluster(ctx context.Context, cluster *core.Cluster) error {
+	cluster = proto.Clone(cluster).(*core.Cluster)
+	storage.InitVersionedObject(cluster)
+	data, err := protojson.Marshal(cluster)
+	if err != nil {
+		return fmt.Errorf("failed to marshal cluster: %w", err)
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	var prev *core.Cluster
+	err = s.withTx(ctx, func(tx *dbsql.Tx) error {
+		var err error
+		prev, _, err = s.getCluster(ctx, tx, cluster.Reference())
+		if err != nil && !errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		var version int64
+		err = s.queryRow(ctx, tx, `INSERT INTO clusters (id, data) VALUES (?, ?)
+			ON CONFLICT (id) DO UPDATE SET data = excluded.data, version = clusters.version + 1
+			RETURNING version`,
+			cluster.Id, string(data)).Scan(&version)
+		if err != nil {
+			return err
+		}
+		cluster.SetResourceVersion(strconv.FormatInt(version, 10))
+		return s.putClusterLabels(ctx, tx, cluster)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cluster: %w", err)
+	}
+	if prev == nil {
+		s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+			EventType: storage.WatchEventCreate,
+			Current:   proto.Clone(cluster).(*core.Cluster),
+		})
+	} else {
+		s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+			EventType: storage.WatchEventUpdate,
+			Current:   proto.Clone(cluster).(*core.Cluster),
+			Previous:  prev,
+		})
+	}
+	return nil
+}
+
+func (s *SQLStore) DeleteCluster(ctx context.Context, ref *core.Reference) error {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	var prev *core.Cluster
+	err := s.withTx(ctx, func(tx *dbsql.Tx) error {
+		var err error
+		prev, _, err = s.getCluster(ctx, tx, ref)
+		if err != nil {
+			return err
+		}
+		if _, err := s.exec(ctx, tx, `DELETE FROM clusters WHERE id = ?`, ref.Id); err != nil {
+			return err
+		}
+		_, err = s.exec(ctx, tx, `DELETE FROM cluster_labels WHERE cluster_id = ?`, ref.Id)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete cluster: %w", err)
+	}
+	s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+		EventType: storage.WatchEventDelete,
+		Previous:  prev,
+	})
+	return nil
+}
+
+func (s *SQLStore) ListClusters(
+	ctx context.Context,
+	matchLabels *core.LabelSelector,
+	matchOptions core.MatchOptions,
+	opts ...storage.ListOption,
+) (*core.ClusterList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	page := storage.NewPaginator[*core.Cluster](options)
+	if matchLabels.IsEmpty() && matchOptions&core.MatchOptions_EmptySelectorMatchesNone != 0 {
+		return &core.ClusterList{
+			Items: page.Items(),
+		}, nil
+	}
+	where, args := selectorClause(matchLabels)
+	pageWhere, pageArgs, order := pageClause("c.id", cursor, options, len(options.Capabilities) == 0)
+
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	rows, err := s.query(ctx, s.DB, `SELECT c.id, c.data, c.version FROM clusters c WHERE `+where+` AND `+pageWhere+order,
+		append(args, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var id, data string
+		var version int64
+		if err := rows.Scan(&id, &data, &version); err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+		cluster, err := unmarshalCluster(data, version)
+		if err != nil {
+			return nil, err
+		}
+		if !options.MatchCluster(cluster) {
+			continue
+		}
+		if !page.Add(id, cluster) {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list clusters: %w", err)
+	}
+	return &core.ClusterList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *SQLStore) GetCluster(ctx context.Context, ref *core.Reference) (*core.Cluster, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	cluster, _, err := s.getCluster(ctx, s.DB, ref)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get cluster: %w", err)
+	}
+	return cluster, nil
+}
+
+func (s *SQLStore) UpdateCluster(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.MutatorFunc[*core.Cluster],
+) (*core.Cluster, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	var retCluster, prevCluster *core.Cluster
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		return s.withTx(ctx, func(tx *dbsql.Tx) error {
+			cluster, version, err := s.getCluster(ctx, tx, ref)
+			if err != nil {
+				return err
+			}
+			prev := proto.Clone(cluster).(*core.Cluster)
+			if err := storage.ApplyMutator(cluster, mutator); err != nil {
+				return err
+			}
+			cluster.SetResourceVersion("")
+			data, err := protojson.Marshal(cluster)
+			if err != nil {
+				return fmt.Errorf("failed to marshal cluster: %w", err)
+			}
+			res, err := s.exec(ctx, tx, `UPDATE clusters SET data = ?, version = version + 1 WHERE id = ? AND version = ?`,
+				string(data), ref.Id, version)
+			if err != nil {
+				return err
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				return retryErr
+			}
+			cluster.SetResourceVersion(strconv.FormatInt(version+1, 10))
+			if err := s.putClusterLabels(ctx, tx, cluster); err != nil {
+				return err
+			}
+			retCluster, prevCluster = cluster, prev
+			return nil
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update cluster: %w", err)
+	}
+	s.clusterEvents.Publish(storage.WatchEvent[*core.Cluster]{
+		EventType: storage.WatchEventUpdate,
+		Current:   proto.Clone(retCluster).(*core.Cluster),
+		Previous:  prevCluster,
+	})
+	return retCluster, nil
+}
+
+func (s *SQLStore) WatchClusters(
+	ctx context.Context,
+	known []*core.Cluster,
+) (<-chan storage.WatchEvent[*core.Cluster], error) {
+	// Holding the lock ensures no writes occur between listing the current
+	// clusters and subscribing to new events.
+	s.clustersMu.Lock()
+	defer s.clustersMu.Unlock()
+	current, err := s.ListClusters(ctx, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	initial := storage.ComputeClusterEvents(known, current.Items)
+	return s.clusterEvents.Subscribe(ctx, initial...), nil
+}
+
+func (s *SQLStore) getCluster(ctx context.Context, q queryer, ref *core.Reference) (*core.Cluster, int64, error) {
+	var data string
+	var version int64
+	err := s.queryRow(ctx, q, `SELECT data, version FROM clusters WHERE id = ?`, ref.Id).Scan(&data, &version)
+	if err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, 0, storage.ErrNotFound
+		}
+		return nil, 0, err
+	}
+	cluster, err := unmarshalCluster(data, version)
+	if err != nil {
+		return nil, 0, err
+	}
+	return cluster, version, nil
+}
+
+// unmarshalCluster decodes a stored cluster. The resource version is not
+// stored with the cluster; it is taken from the version column.
+func unmarshalCluster(data string, version int64) (*core.Cluster, error) {
+	cluster := &core.Cluster{}
+	if err := protojson.Unmarshal([]byte(data), cluster); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal cluster: %w", err)
+	}
+	cluster.SetResourceVersion(strconv.FormatInt(version, 10))
+	return cluster, nil
+}
+
+// putClusterLabels replaces the contents of the cluster_labels table for the
+// given cluster with the cluster's current labels.
+func (s *SQLStore) putClusterLabels(ctx context.Context, tx *dbsql.Tx, cluster *core.Cluster) error {
+	if _, err := s.exec(ctx, tx, `DELETE FROM cluster_labels WHERE cluster_id = ?`, cluster.Id); err != nil {
+		return err
+	}
+	for k, v := range cluster.GetMetadata().GetLabels() {
+		_, err := s.exec(ctx, tx, `INSERT INTO cluster_labels (cluster_id, name, value) VALUES (?, ?, ?)`,
+			cluster.Id, k, v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package sql_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/storage/conformance"
+	"github.com/rancher/opni-monitoring/pkg/storage/sql"
+	"github.com/rancher/opni-monitoring/pkg/util"
+)
+
+func TestSQL(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "SQL Storage Suite")
+}
+
+var store = util.NewFuture[*sql.SQLStore]()
+var errCtrl = util.NewFuture[conformance.ErrorController]()
+
+var _ = BeforeSuite(func() {
+	conf := &v1beta1.SQLStorageSpec{
+		Driver: v1beta1.SQLDriverSQLite,
+		DSN:    filepath.Join(GinkgoT().TempDir(), "opni.db"),
+	}
+	s, err := sql.NewSQLStore(context.Background(), conf)
+	Expect(err).NotTo(HaveOccurred())
+	store.Set(s)
+
+	// Closing the database causes all subsequent queries to fail. Reopening
+	// it runs migrations again, which should be a no-op.
+	errCtrl.Set(conformance.NewErrorController(
+		func() {
+			s.DB.Close()
+		},
+		func() {
+			s2, err := sql.NewSQLStore(context.Background(), conf)
+			if err != nil {
+				panic(err)
+			}
+			s.DB = s2.DB
+		},
+	))
+	DeferCleanup(func() {
+		s.Close()
+	})
+})
+
+var _ = Describe("Token Store", Ordered, conformance.TokenStoreTestSuite(store, errCtrl))
+var _ = Describe("Cluster Store", Ordered, conformance.ClusterStoreTestSuite(store, errCtrl))
+var _ = Describe("RBAC Store", Ordered, conformance.RBACStoreTestSuite(store, errCtrl))
+var _ = Describe("Keyring Store", Ordered, conformance.KeyringStoreTestSuite(store, errCtrl))
+var _ = Describe("KV Store", Ordered, conformance.KeyValueStoreTestSuite(store, errCtrl))
@@ -0,0 +1,124 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/rancher/opni-monitoring/pkg/keyring"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"k8s.io/client-go/util/retry"
+)
+
+type sqlKeyringStore struct {
+	store *SQLStore
+	id    string
+}
+
+func (ks *sqlKeyringStore) Put(ctx context.Context, keyring keyring.Keyring) error {
+	k, err := keyring.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal keyring: %w", err)
+	}
+	k, err = ks.store.ValueTransformer.TransformToStorage(ctx, k)
+	if err != nil {
+		return fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	ctx, ca := context.WithTimeout(ctx, ks.store.CommandTimeout)
+	defer ca()
+	_, err = ks.store.exec(ctx, ks.store.DB, `INSERT INTO keyrings (id, data) VALUES (?, ?)
+		ON CONFLICT (id) DO UPDATE SET data = excluded.data`, ks.id, k)
+	if err != nil {
+		return fmt.Errorf("failed to put keyring: %w", err)
+	}
+	return nil
+}
+
+func (ks *sqlKeyringStore) Get(ctx context.Context) (keyring.Keyring, error) {
+	ctx, ca := context.WithTimeout(ctx, ks.store.CommandTimeout)
+	defer ca()
+	k, _, err := ks.get(ctx)
+	return k, err
+}
+
+// get returns the keyring along with its stored (transformed) data, which is
+// used to detect concurrent modifications.
+func (ks *sqlKeyringStore) get(ctx context.Context) (keyring.Keyring, []byte, error) {
+	var stored []byte
+	err := ks.store.queryRow(ctx, ks.store.DB, `SELECT data FROM keyrings WHERE id = ?`, ks.id).Scan(&stored)
+	if err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, nil, storage.ErrNotFound
+		}
+		return nil, nil, fmt.Errorf("failed to get keyring: %w", err)
+	}
+	data, err := ks.store.ValueTransformer.TransformFromStorage(ctx, stored)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to transform keyring: %w", err)
+	}
+	k, err := keyring.Unmarshal(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal keyring: %w", err)
+	}
+	return k, stored, nil
+}
+
+// Update only writes the new keyring if the stored data has not changed since
+// it was read. The keyrings table has no version column, but comparing the
+// data is sufficient: a concurrent write of identical data leaves the keyring
+// the mutator was applied to unchanged.
+func (ks *sqlKeyringStore) Update(ctx context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+	ctx, ca := context.WithTimeout(ctx, ks.store.CommandTimeout)
+	defer ca()
+	var retKeyring keyring.Keyring
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		kr, prev, err := ks.get(ctx)
+		if err != nil {
+			return err
+		}
+		kr, err = mutator(kr)
+		if err != nil {
+			return err
+		}
+		k, err := kr.Marshal()
+		if err != nil {
+			return fmt.Errorf("failed to marshal keyring: %w", err)
+		}
+		k, err = ks.store.ValueTransformer.TransformToStorage(ctx, k)
+		if err != nil {
+			return fmt.Errorf("failed to transform keyring: %w", err)
+		}
+		res, err := ks.store.exec(ctx, ks.store.DB, `UPDATE keyrings SET data = ? WHERE id = ? AND data = ?`,
+			k, ks.id, prev)
+		if err != nil {
+			return fmt.Errorf("failed to put keyring: %w", err)
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return fmt.Errorf("failed to put keyring: %w", err)
+		} else if n == 0 {
+			return retryErr
+		}
+		retKeyring = kr
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return retKeyring, nil
+}
+
+func (ks *sqlKeyringStore) Delete(ctx context.Context) error {
+	ctx, ca := context.WithTimeout(ctx, ks.store.CommandTimeout)
+	defer ca()
+	res, err := ks.store.exec(ctx, ks.store.DB, `DELETE FROM keyrings WHERE id = ?`, ks.id)
+	if err != nil {
+		return fmt.Errorf("failed to delete keyring: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete keyring: %w", err)
+	} else if n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
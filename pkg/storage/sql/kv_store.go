@@ -0,0 +1,269 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/storage"
+)
+
+// Keys with a TTL are not removed when they expire, but are ignored until they
+// are next written. Watchers will not receive delete events for expired keys.
+type genericKeyValueStore struct {
+	store     *SQLStore
+	namespace string
+}
+
+type kvRow struct {
+	value     []byte
+	revision  int64
+	expiresAt int64
+}
+
+func (r kvRow) expired(now time.Time) bool {
+	return r.expiresAt != 0 && now.UnixNano() >= r.expiresAt
+}
+
+// getKV returns the row for the key, including expired rows.
+func (s *genericKeyValueStore) getKV(ctx context.Context, q queryer, key string) (kvRow, error) {
+	var row kvRow
+	err := s.store.queryRow(ctx, q, `SELECT value, revision, expires_at FROM kv WHERE namespace = ? AND name = ?`,
+		s.namespace, key).Scan(&row.value, &row.revision, &row.expiresAt)
+	if err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return kvRow{}, storage.ErrNotFound
+		}
+		return kvRow{}, err
+	}
+	return row, nil
+}
+
+func (s *genericKeyValueStore) Put(ctx context.Context, key string, value []byte, opts ...storage.KeyValueStoreOption) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	data, err := s.store.ValueTransformer.TransformToStorage(ctx, value)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		data = []byte{}
+	}
+	ctx, ca := context.WithTimeout(ctx, s.store.CommandTimeout)
+	defer ca()
+
+	s.store.kvMu.Lock()
+	defer s.store.kvMu.Unlock()
+	var prev kvRow
+	var exists, live bool
+	var revision int64
+	err = s.store.withTx(ctx, func(tx *dbsql.Tx) error {
+		now := time.Now()
+		var err error
+		prev, err = s.getKV(ctx, tx, key)
+		switch {
+		case err == nil:
+			exists = true
+			live = !prev.expired(now)
+		case !errors.Is(err, storage.ErrNotFound):
+			return err
+		}
+		var current int64
+		if live {
+			current = prev.revision
+		}
+		if err := options.CheckRevision(current); err != nil {
+			return err
+		}
+		revision, err = s.store.nextKvRevision(ctx, tx)
+		if err != nil {
+			return err
+		}
+		var expiresAt int64
+		if options.TTL > 0 {
+			expiresAt = now.Add(options.TTL).UnixNano()
+		}
+		if !exists {
+			_, err = s.store.exec(ctx, tx, `INSERT INTO kv (namespace, name, value, revision, expires_at)
+				VALUES (?, ?, ?, ?, ?)`, s.namespace, key, data, revision, expiresAt)
+			return err
+		}
+		// Compare the revision in case the key was modified by another
+		// process since it was read.
+		res, err := s.store.exec(ctx, tx, `UPDATE kv SET value = ?, revision = ?, expires_at = ?
+			WHERE namespace = ? AND name = ? AND revision = ?`,
+			data, revision, expiresAt, s.namespace, key, prev.revision)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return storage.ErrConflict
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	options.SetRevisionOut(revision)
+
+	event := storage.KeyValueWatchEvent{
+		EventType: storage.WatchEventCreate,
+		Current: &storage.KeyRevision{
+			Key:      s.namespace + "/" + key,
+			Value:    value,
+			Revision: revision,
+		},
+	}
+	if live {
+		event.EventType = storage.WatchEventUpdate
+		event.Previous = s.keyRevision(ctx, key, prev)
+	}
+	s.store.kvEvents.Publish(event)
+	return nil
+}
+
+func (s *genericKeyValueStore) Get(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) ([]byte, error) {
+	if err := validateKey(key); err != nil {
+		return nil, err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	ctx, ca := context.WithTimeout(ctx, s.store.CommandTimeout)
+	defer ca()
+	row, err := s.getKV(ctx, s.store.DB, key)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if row.expired(now) {
+		return nil, storage.ErrNotFound
+	}
+	value, err := s.store.ValueTransformer.TransformFromStorage(ctx, row.value)
+	if err != nil {
+		return nil, err
+	}
+	options.SetRevisionOut(row.revision)
+	var ttl time.Duration
+	if row.expiresAt != 0 {
+		ttl = time.Duration(row.expiresAt - now.UnixNano())
+	}
+	options.SetTTLOut(ttl)
+	return value, nil
+}
+
+func (s *genericKeyValueStore) Delete(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) error {
+	if err := validateKey(key); err != nil {
+		return err
+	}
+	options := storage.KeyValueStoreOptions{}
+	options.Apply(opts...)
+	ctx, ca := context.WithTimeout(ctx, s.store.CommandTimeout)
+	defer ca()
+
+	s.store.kvMu.Lock()
+	defer s.store.kvMu.Unlock()
+	var prev kvRow
+	err := s.store.withTx(ctx, func(tx *dbsql.Tx) error {
+		var err error
+		prev, err = s.getKV(ctx, tx, key)
+		if err != nil {
+			return err
+		}
+		if prev.expired(time.Now()) {
+			return storage.ErrNotFound
+		}
+		if err := options.CheckRevision(prev.revision); err != nil {
+			return err
+		}
+		res, err := s.store.exec(ctx, tx, `DELETE FROM kv WHERE namespace = ? AND name = ? AND revision = ?`,
+			s.namespace, key, prev.revision)
+		if err != nil {
+			return err
+		}
+		if n, err := res.RowsAffected(); err != nil {
+			return err
+		} else if n == 0 {
+			return storage.ErrConflict
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.store.kvEvents.Publish(storage.KeyValueWatchEvent{
+		EventType: storage.WatchEventDelete,
+		Previous:  s.keyRevision(ctx, key, prev),
+	})
+	return nil
+}
+
+func (s *genericKeyValueStore) ListKeys(ctx context.Context, prefix string) ([]string, error) {
+	ctx, ca := context.WithTimeout(ctx, s.store.CommandTimeout)
+	defer ca()
+	rows, err := s.store.query(ctx, s.store.DB, `SELECT name FROM kv
+		WHERE namespace = ? AND substr(name, 1, length(CAST(? AS TEXT))) = ?
+		AND (expires_at = 0 OR expires_at > ?) ORDER BY name`,
+		s.namespace, prefix, prefix, time.Now().UnixNano())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	keys := []string{}
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	return keys, rows.Err()
+}
+
+func (s *genericKeyValueStore) Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error) {
+	// Holding the lock ensures the subscription is registered before any
+	// subsequent writes are published.
+	s.store.kvMu.Lock()
+	defer s.store.kvMu.Unlock()
+	return storage.SubscribeKeys(ctx, &s.store.kvEvents, s.namespace, prefix), nil
+}
+
+// keyRevision decodes a previous value for a watch event. If the value
+// cannot be decoded, it is omitted from the event.
+func (s *genericKeyValueStore) keyRevision(ctx context.Context, key string, row kvRow) *storage.KeyRevision {
+	value, err := s.store.ValueTransformer.TransformFromStorage(ctx, row.value)
+	if err != nil {
+		value = nil
+	}
+	return &storage.KeyRevision{
+		Key:      s.namespace + "/" + key,
+		Value:    value,
+		Revision: row.revision,
+	}
+}
+
+// nextKvRevision increments and returns the revision counter shared by all
+// key-value store namespaces.
+func (s *SQLStore) nextKvRevision(ctx context.Context, tx *dbsql.Tx) (int64, error) {
+	if _, err := s.exec(ctx, tx, `UPDATE kv_revision SET revision = revision + 1 WHERE id = 1`); err != nil {
+		return 0, err
+	}
+	var revision int64
+	if err := s.queryRow(ctx, tx, `SELECT revision FROM kv_revision WHERE id = 1`).Scan(&revision); err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
+func validateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("key cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"fmt"
+)
+
+// A migration is a list of statements which are applied in a single
+// transaction. Migrations are identified by their index in the list (starting
+// at 1), so existing migrations must never be modified or reordered; schema
+// changes must be added as new migrations at the end of the list.
+type migration []string
+
+var sqliteMigrations = []migration{
+	{
+		`CREATE TABLE tokens (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL,
+			expires_at BIGINT NOT NULL,
+			version BIGINT NOT NULL DEFAULT 0
+		)`,
+		`CREATE INDEX tokens_expires_at ON tokens (expires_at)`,
+		`CREATE TABLE clusters (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL,
+			version BIGINT NOT NULL DEFAULT 0
+		)`,
+		`CREATE TABLE cluster_labels (
+			cluster_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value TEXT NOT NULL,
+			PRIMARY KEY (cluster_id, name)
+		)`,
+		`CREATE INDEX cluster_labels_name_value ON cluster_labels (name, value)`,
+		`CREATE TABLE roles (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL
+		)`,
+		`CREATE TABLE role_bindings (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL
+		)`,
+		`CREATE TABLE keyrings (
+			id TEXT PRIMARY KEY,
+			data BLOB NOT NULL
+		)`,
+		`CREATE TABLE kv (
+			namespace TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value BLOB NOT NULL,
+			PRIMARY KEY (namespace, name)
+		)`,
+	},
+	{
+		`ALTER TABLE roles ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE role_bindings ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+	},
+	{
+		`ALTER TABLE kv ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+		`ALTER TABLE kv ADD COLUMN expires_at BIGINT NOT NULL DEFAULT 0`,
+		`CREATE TABLE kv_revision (
+			id INTEGER PRIMARY KEY,
+			revision BIGINT NOT NULL
+		)`,
+		`INSERT INTO kv_revision (id, revision) VALUES (1, 1)`,
+	},
+}
+
+var postgresMigrations = []migration{
+	{
+		`CREATE TABLE tokens (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL,
+			expires_at BIGINT NOT NULL,
+			version BIGINT NOT NULL DEFAULT 0
+		)`,
+		`CREATE INDEX tokens_expires_at ON tokens (expires_at)`,
+		`CREATE TABLE clusters (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL,
+			version BIGINT NOT NULL DEFAULT 0
+		)`,
+		`CREATE TABLE cluster_labels (
+			cluster_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value TEXT NOT NULL,
+			PRIMARY KEY (cluster_id, name)
+		)`,
+		`CREATE INDEX cluster_labels_name_value ON cluster_labels (name, value)`,
+		`CREATE TABLE roles (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL
+		)`,
+		`CREATE TABLE role_bindings (
+			id TEXT PRIMARY KEY,
+			data TEXT NOT NULL
+		)`,
+		`CREATE TABLE keyrings (
+			id TEXT PRIMARY KEY,
+			data BYTEA NOT NULL
+		)`,
+		`CREATE TABLE kv (
+			namespace TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value BYTEA NOT NULL,
+			PRIMARY KEY (namespace, name)
+		)`,
+	},
+	{
+		`ALTER TABLE roles ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE role_bindings ADD COLUMN version BIGINT NOT NULL DEFAULT 0`,
+	},
+	{
+		`ALTER TABLE kv ADD COLUMN revision BIGINT NOT NULL DEFAULT 1`,
+		`ALTER TABLE kv ADD COLUMN expires_at BIGINT NOT NULL DEFAULT 0`,
+		`CREATE TABLE kv_revision (
+			id INTEGER PRIMARY KEY,
+			revision BIGINT NOT NULL
+		)`,
+		`INSERT INTO kv_revision (id, revision) VALUES (1, 1)`,
+	},
+}
+
+// Key of the Postgres advisory lock held while migrations are applied. It is
+// arbitrary, but must not change, so that gateways of different versions
+// exclude each other.
+const migrationLockID = 0x6f706e69_6d696772
+
+// migrate applies any migrations which have not yet been recorded in the
+// schema_migrations table. All migrations are applied in a single
+// transaction, which holds a lock (if supported by the database) so that
+// gateways starting at the same time do not apply the same migrations.
+func (s *SQLStore) migrate(ctx context.Context) error {
+	var applied []int
+	err := s.withTx(ctx, func(tx *dbsql.Tx) error {
+		if s.dialect.migrationLock != "" {
+			if _, err := tx.ExecContext(ctx, s.dialect.migrationLock); err != nil {
+				return fmt.Errorf("failed to acquire migration lock: %w", err)
+			}
+		}
+		_, err := tx.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY
+		)`)
+		if err != nil {
+			return fmt.Errorf("failed to create schema_migrations table: %w", err)
+		}
+		var current int
+		err = s.queryRow(ctx, tx, `SELECT COALESCE(MAX(version), 0) FROM schema_migrations`).Scan(&current)
+		if err != nil {
+			return fmt.Errorf("failed to query schema version: %w", err)
+		}
+		if current > len(s.dialect.migrations) {
+			return fmt.Errorf("database schema version %d is newer than the latest known version %d",
+				current, len(s.dialect.migrations))
+		}
+		for i := current; i < len(s.dialect.migrations); i++ {
+			version := i + 1
+			for _, stmt := range s.dialect.migrations[i] {
+				if _, err := tx.ExecContext(ctx, stmt); err != nil {
+					return fmt.Errorf("failed to apply migration %d: %w", version, err)
+				}
+			}
+			if _, err := s.exec(ctx, tx, `INSERT INTO schema_migrations (version) VALUES (?)`, version); err != nil {
+				return fmt.Errorf("failed to apply migration %d: %w", version, err)
+			}
+			applied = append(applied, version)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, version := range applied {
+		s.Logger.With(
+			"version", version,
+		).Info("applied schema migration")
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/client-go/util/retry"
+)
+
+func (s *SQLStore) CreateRole(ctx context.Context, role *core.Role) error {
+	role = proto.Clone(role).(*core.Role)
+	storage.InitVersionedObject(role)
+	data, err := protojson.Marshal(role)
+	if err != nil {
+		return fmt.Errorf("failed to marshal role: %w", err)
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	_, err = s.exec(ctx, s.DB, `INSERT INTO roles (id, data) VALUES (?, ?)
+		ON CONFLICT (id) DO UPDATE SET data = excluded.data, version = roles.version + 1`, role.Id, string(data))
+	if err != nil {
+		return fmt.Errorf("failed to create role: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) DeleteRole(ctx context.Context, ref *core.Reference) error {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	if err := s.deleteByID(ctx, "roles", ref); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) GetRole(ctx context.Context, ref *core.Reference) (*core.Role, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	role, err := s.getRole(ctx, s.DB, ref)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+	return role, nil
+}
+
+func (s *SQLStore) UpdateRole(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.RoleMutator,
+) (*core.Role, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	var retRole *core.Role
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		return s.withTx(ctx, func(tx *dbsql.Tx) error {
+			role, err := s.getRole(ctx, tx, ref)
+			if err != nil {
+				return err
+			}
+			if err := storage.ApplyMutator(role, mutator); err != nil {
+				return err
+			}
+			version := role.GetResourceVersion()
+			role.SetResourceVersion("")
+			data, err := protojson.Marshal(role)
+			if err != nil {
+				return fmt.Errorf("failed to marshal role: %w", err)
+			}
+			newVersion, err := s.updateVersioned(ctx, tx, "roles", ref, data, version)
+			if err != nil {
+				return err
+			}
+			role.SetResourceVersion(newVersion)
+			retRole = role
+			return nil
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update role: %w", err)
+	}
+	return retRole, nil
+}
+
+func (s *SQLStore) CreateRoleBinding(ctx context.Context, roleBinding *core.RoleBinding) error {
+	roleBinding = proto.Clone(roleBinding).(*core.RoleBinding)
+	storage.InitVersionedObject(roleBinding)
+	data, err := protojson.Marshal(roleBinding)
+	if err != nil {
+		return fmt.Errorf("failed to marshal role binding: %w", err)
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	_, err = s.exec(ctx, s.DB, `INSERT INTO role_bindings (id, data) VALUES (?, ?)
+		ON CONFLICT (id) DO UPDATE SET data = excluded.data, version = role_bindings.version + 1`, roleBinding.Id, string(data))
+	if err != nil {
+		return fmt.Errorf("failed to create role binding: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) DeleteRoleBinding(ctx context.Context, ref *core.Reference) error {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	if err := s.deleteByID(ctx, "role_bindings", ref); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete role binding: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) GetRoleBinding(ctx context.Context, ref *core.Reference) (*core.RoleBinding, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	roleBinding, err := s.getRoleBinding(ctx, s.DB, ref)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get role binding: %w", err)
+	}
+	if err := storage.ApplyRoleBindingTaints(ctx, s, roleBinding); err != nil {
+		return nil, err
+	}
+	return roleBinding, nil
+}
+
+func (s *SQLStore) UpdateRoleBinding(
+	ctx context.Context,
+	ref *core.Reference,
+	mutator storage.RoleBindingMutator,
+) (*core.RoleBinding, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	var retRoleBinding *core.RoleBinding
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		return s.withTx(ctx, func(tx *dbsql.Tx) error {
+			roleBinding, err := s.getRoleBinding(ctx, tx, ref)
+			if err != nil {
+				return err
+			}
+			if err := storage.ApplyMutator(roleBinding, mutator); err != nil {
+				return err
+			}
+			version := roleBinding.GetResourceVersion()
+			roleBinding.SetResourceVersion("")
+			roleBinding.Taints = nil
+			data, err := protojson.Marshal(roleBinding)
+			if err != nil {
+				return fmt.Errorf("failed to marshal role binding: %w", err)
+			}
+			newVersion, err := s.updateVersioned(ctx, tx, "role_bindings", ref, data, version)
+			if err != nil {
+				return err
+			}
+			roleBinding.SetResourceVersion(newVersion)
+			retRoleBinding = roleBinding
+			return nil
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrConflict) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update role binding: %w", err)
+	}
+	if err := storage.ApplyRoleBindingTaints(ctx, s, retRoleBinding); err != nil {
+		return nil, err
+	}
+	return retRoleBinding, nil
+}
+
+func (s *SQLStore) ListRoles(ctx context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	where, args, order := pageClause("id", cursor, options, true)
+	rows, err := s.query(ctx, s.DB, `SELECT id, data, version FROM roles WHERE `+where+order, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	defer rows.Close()
+	page := storage.NewPaginator[*core.Role](options)
+	for rows.Next() {
+		var id, data string
+		var version int64
+		if err := rows.Scan(&id, &data, &version); err != nil {
+			return nil, fmt.Errorf("failed to list roles: %w", err)
+		}
+		role := &core.Role{}
+		if err := protojson.Unmarshal([]byte(data), role); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal role: %w", err)
+		}
+		role.SetResourceVersion(strconv.FormatInt(version, 10))
+		if !page.Add(id, role) {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return &core.RoleList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *SQLStore) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	where, args, order := pageClause("id", cursor, options, true)
+	rows, err := s.query(ctx, s.DB, `SELECT id, data, version FROM role_bindings WHERE `+where+order, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	page := storage.NewPaginator[*core.RoleBinding](options)
+	for rows.Next() {
+		var id, data string
+		var version int64
+		if err := rows.Scan(&id, &data, &version); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to list role bindings: %w", err)
+		}
+		roleBinding := &core.RoleBinding{}
+		if err := protojson.Unmarshal([]byte(data), roleBinding); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to decode role binding: %w", err)
+		}
+		roleBinding.SetResourceVersion(strconv.FormatInt(version, 10))
+		if !page.Add(id, roleBinding) {
+			break
+		}
+	}
+	// The rows must be closed before applying taints, which issues additional
+	// queries and may need to reuse the connection.
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list role bindings: %w", err)
+	}
+	for _, roleBinding := range page.Items() {
+		if err := storage.ApplyRoleBindingTaints(ctx, s, roleBinding); err != nil {
+			return nil, err
+		}
+	}
+	return &core.RoleBindingList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *SQLStore) deleteByID(ctx context.Context, table string, ref *core.Reference) error {
+	res, err := s.exec(ctx, s.DB, `DELETE FROM `+table+` WHERE id = ?`, ref.Id)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *SQLStore) getRole(ctx context.Context, q queryer, ref *core.Reference) (*core.Role, error) {
+	var data string
+	var version int64
+	err := s.queryRow(ctx, q, `SELECT data, version FROM roles WHERE id = ?`, ref.Id).Scan(&data, &version)
+	if err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	role := &core.Role{}
+	if err := protojson.Unmarshal([]byte(data), role); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal role: %w", err)
+	}
+	role.SetResourceVersion(strconv.FormatInt(version, 10))
+	return role, nil
+}
+
+func (s *SQLStore) getRoleBinding(ctx context.Context, q queryer, ref *core.Reference) (*core.RoleBinding, error) {
+	var data string
+	var version int64
+	err := s.queryRow(ctx, q, `SELECT data, version FROM role_bindings WHERE id = ?`, ref.Id).Scan(&data, &version)
+	if err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+	roleBinding := &core.RoleBinding{}
+	if err := protojson.Unmarshal([]byte(data), roleBinding); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal role binding: %w", err)
+	}
+	roleBinding.SetResourceVersion(strconv.FormatInt(version, 10))
+	return roleBinding, nil
+}
+
+// updateVersioned replaces the data of a row in the given table if its version
+// matches the expected version, and returns the new version. If the row has
+// been modified concurrently, retryErr is returned.
+func (s *SQLStore) updateVersioned(
+	ctx context.Context,
+	tx *dbsql.Tx,
+	table string,
+	ref *core.Reference,
+	data []byte,
+	version string,
+) (string, error) {
+	v, err := strconv.ParseInt(version, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid resource version %q: %w", version, err)
+	}
+	res, err := s.exec(ctx, tx, `UPDATE `+table+` SET data = ?, version = version + 1 WHERE id = ? AND version = ?`,
+		string(data), ref.Id, v)
+	if err != nil {
+		return "", err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return "", err
+	} else if n == 0 {
+		return "", retryErr
+	}
+	return strconv.FormatInt(v+1, 10), nil
+}
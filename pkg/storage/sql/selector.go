@@ -0,0 +1,87 @@
+package sql
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+)
+
+// selectorClause translates a label selector into a SQL boolean expression
+// which can be used to filter rows in the clusters table (aliased as 'c'),
+// along with the corresponding query arguments. The semantics match those of
+// storage.ClusterSelector.
+func selectorClause(selector *core.LabelSelector) (string, []any) {
+	var clauses []string
+	var args []any
+
+	hasLabel := `SELECT 1 FROM cluster_labels l WHERE l.cluster_id = c.id AND l.name = ?`
+
+	for key, value := range selector.GetMatchLabels() {
+		clauses = append(clauses, `EXISTS (`+hasLabel+` AND l.value = ?)`)
+		args = append(args, key, value)
+	}
+	for _, req := range selector.GetMatchExpressions() {
+		switch core.LabelSelectorOperator(req.Operator) {
+		case core.LabelSelectorOpIn:
+			if len(req.Values) == 0 {
+				clauses = append(clauses, `1 = 0`)
+				continue
+			}
+			clauses = append(clauses, `EXISTS (`+hasLabel+` AND l.value IN (`+placeholders(len(req.Values))+`))`)
+			args = append(args, req.Key)
+			for _, v := range req.Values {
+				args = append(args, v)
+			}
+		case core.LabelSelectorOpNotIn:
+			if len(req.Values) == 0 {
+				clauses = append(clauses, `EXISTS (`+hasLabel+`)`)
+				args = append(args, req.Key)
+				continue
+			}
+			clauses = append(clauses, `EXISTS (`+hasLabel+` AND l.value NOT IN (`+placeholders(len(req.Values))+`))`)
+			args = append(args, req.Key)
+			for _, v := range req.Values {
+				args = append(args, v)
+			}
+		case core.LabelSelectorOpExists:
+			clauses = append(clauses, `EXISTS (`+hasLabel+`)`)
+			args = append(args, req.Key)
+		case core.LabelSelectorOpDoesNotExist:
+			clauses = append(clauses, `NOT EXISTS (`+hasLabel+`)`)
+			args = append(args, req.Key)
+		}
+	}
+	if len(clauses) == 0 {
+		return `1 = 1`, nil
+	}
+	return strings.Join(clauses, ` AND `), args
+}
+
+// pageClause returns a SQL boolean expression which selects rows after the
+// cursor, along with the corresponding query arguments, and an ORDER BY
+// clause which sorts rows by the given id column. If limit is true and a page
+// size is set, the ORDER BY clause also limits the number of rows to one more
+// than the page size, so that the caller can determine whether there is a
+// next page. Callers which filter rows after they are read should not set a
+// limit, and instead stop reading rows once the page is full.
+func pageClause(column string, cursor string, options storage.ListOptions, limit bool) (string, []any, string) {
+	where, args := `1 = 1`, []any(nil)
+	order := ` ORDER BY ` + column + ` ASC`
+	if options.Descending() {
+		order = ` ORDER BY ` + column + ` DESC`
+	}
+	if cursor != "" {
+		args = append(args, cursor)
+		if options.Descending() {
+			where = column + ` < ?`
+		} else {
+			where = column + ` > ?`
+		}
+	}
+	if limit && options.PageSize > 0 {
+		order += ` LIMIT ` + strconv.Itoa(options.PageSize+1)
+	}
+	return where, args, order
+}
@@ -0,0 +1,243 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	_ "github.com/jackc/pgx/v4/stdlib"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/logger"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/util/wait"
+	_ "modernc.org/sqlite"
+)
+
+var (
+	retryErr       = errors.New("the object has been modified, retrying")
+	defaultBackoff = wait.Backoff{
+		Steps:    20,
+		Duration: 10 * time.Millisecond,
+		Cap:      1 * time.Second,
+		Factor:   1.5,
+		Jitter:   0.1,
+	}
+)
+
+func isRetryErr(err error) bool {
+	return errors.Is(err, retryErr)
+}
+
+// SQLStore implements storage.Backend using a relational database. SQLite
+// and PostgreSQL are supported.
+type SQLStore struct {
+	SQLStoreOptions
+	Logger  *zap.SugaredLogger
+	DB      *dbsql.DB
+	dialect dialect
+
+	// Cluster watches are implemented in-process, so they will only observe
+	// changes made through this store. clustersMu serializes cluster writes
+	// with publishing their events, so that watchers observe changes in the
+	// order they were committed.
+	clustersMu    sync.Mutex
+	clusterEvents storage.EventBroadcaster[*core.Cluster]
+
+	// Key-value store watches are also implemented in-process, and kvMu
+	// serves the same purpose for key-value store writes.
+	kvMu     sync.Mutex
+	kvEvents storage.EventBroadcaster[*storage.KeyRevision]
+}
+
+var _ storage.Backend = (*SQLStore)(nil)
+
+type SQLStoreOptions struct {
+	CommandTimeout   time.Duration
+	ValueTransformer storage.ValueTransformer
+}
+
+type SQLStoreOption func(*SQLStoreOptions)
+
+func (o *SQLStoreOptions) Apply(opts ...SQLStoreOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+func WithCommandTimeout(timeout time.Duration) SQLStoreOption {
+	return func(o *SQLStoreOptions) {
+		o.CommandTimeout = timeout
+	}
+}
+
+// WithValueTransformer sets the transformer applied to keyring and key-value
+// store data, such as an encryption layer.
+func WithValueTransformer(vt storage.ValueTransformer) SQLStoreOption {
+	return func(o *SQLStoreOptions) {
+		o.ValueTransformer = vt
+	}
+}
+
+// NewSQLStore connects to the database described by conf and applies any
+// pending schema migrations before returning.
+func NewSQLStore(ctx context.Context, conf *v1beta1.SQLStorageSpec, opts ...SQLStoreOption) (*SQLStore, error) {
+	options := SQLStoreOptions{
+		CommandTimeout:   5 * time.Second,
+		ValueTransformer: storage.IdentityTransformer{},
+	}
+	options.Apply(opts...)
+	lg := logger.New().Named("sql")
+
+	d, err := dialectFor(conf.Driver)
+	if err != nil {
+		return nil, err
+	}
+	if conf.DSN == "" {
+		return nil, errors.New("database dsn is not set")
+	}
+	db, err := d.open(conf.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+	store := &SQLStore{
+		SQLStoreOptions: options,
+		Logger:          lg,
+		DB:              db,
+		dialect:         d,
+	}
+	if err := store.migrate(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	lg.With(
+		"driver", conf.Driver,
+	).Info("connected to database")
+	return store, nil
+}
+
+func (s *SQLStore) Close() error {
+	return s.DB.Close()
+}
+
+func (s *SQLStore) KeyringStore(ctx context.Context, prefix string, ref *core.Reference) (storage.KeyringStore, error) {
+	return &sqlKeyringStore{
+		store: s,
+		id:    path.Join(prefix, ref.Id),
+	}, nil
+}
+
+func (s *SQLStore) KeyValueStore(prefix string) (storage.KeyValueStore, error) {
+	return &genericKeyValueStore{
+		store:     s,
+		namespace: prefix,
+	}, nil
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (dbsql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*dbsql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *dbsql.Row
+}
+
+// exec, query, and queryRow rewrite '?' placeholders in the query to the
+// form expected by the database driver.
+func (s *SQLStore) exec(ctx context.Context, q queryer, query string, args ...any) (dbsql.Result, error) {
+	return q.ExecContext(ctx, s.dialect.rebind(query), args...)
+}
+
+func (s *SQLStore) query(ctx context.Context, q queryer, query string, args ...any) (*dbsql.Rows, error) {
+	return q.QueryContext(ctx, s.dialect.rebind(query), args...)
+}
+
+func (s *SQLStore) queryRow(ctx context.Context, q queryer, query string, args ...any) *dbsql.Row {
+	return q.QueryRowContext(ctx, s.dialect.rebind(query), args...)
+}
+
+// withTx runs fn in a transaction, which is committed if fn returns nil and
+// rolled back otherwise.
+func (s *SQLStore) withTx(ctx context.Context, fn func(tx *dbsql.Tx) error) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+type dialect struct {
+	driverName string
+	migrations []migration
+	// Postgres uses numbered placeholders ($1, $2, ...) instead of '?'
+	numberedPlaceholders bool
+	// SQLite only allows a single writer at a time. Limiting the pool to a
+	// single connection avoids "database is locked" errors under contention.
+	maxOpenConns int
+	// If set, executed at the start of the migration transaction to prevent
+	// other processes from applying migrations concurrently.
+	migrationLock string
+}
+
+func dialectFor(driver v1beta1.SQLDriver) (dialect, error) {
+	switch driver {
+	case v1beta1.SQLDriverSQLite:
+		return dialect{
+			driverName:   "sqlite",
+			migrations:   sqliteMigrations,
+			maxOpenConns: 1,
+		}, nil
+	case v1beta1.SQLDriverPostgres:
+		return dialect{
+			driverName:           "pgx",
+			migrations:           postgresMigrations,
+			numberedPlaceholders: true,
+			migrationLock:        fmt.Sprintf("SELECT pg_advisory_xact_lock(%d)", migrationLockID),
+		}, nil
+	default:
+		return dialect{}, fmt.Errorf("unknown sql driver: %q", driver)
+	}
+}
+
+func (d dialect) open(dsn string) (*dbsql.DB, error) {
+	db, err := dbsql.Open(d.driverName, dsn)
+	if err != nil {
+		return nil, err
+	}
+	if d.maxOpenConns > 0 {
+		db.SetMaxOpenConns(d.maxOpenConns)
+	}
+	return db, nil
+}
+
+func (d dialect) rebind(query string) string {
+	if !d.numberedPlaceholders {
+		return query
+	}
+	var sb strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			sb.WriteByte('$')
+			sb.WriteString(strconv.Itoa(n))
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,196 @@
+package sql
+
+import (
+	"context"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/client-go/util/retry"
+)
+
+func (s *SQLStore) CreateToken(ctx context.Context, ttl time.Duration, opts ...storage.TokenCreateOption) (*core.BootstrapToken, error) {
+	options := storage.NewTokenCreateOptions()
+	options.Apply(opts...)
+
+	token := options.Token.ToBootstrapToken()
+	token.Metadata = &core.BootstrapTokenMetadata{
+		UsageCount:   0,
+		Labels:       options.Labels,
+		Capabilities: options.Capabilities,
+		Limits:       options.Limits,
+	}
+	data, err := protojson.Marshal(token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal token: %w", err)
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	_, err = s.exec(ctx, s.DB, `INSERT INTO tokens (id, data, expires_at) VALUES (?, ?, ?)`,
+		token.TokenID, string(data), time.Now().Add(ttl).UnixNano())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	token.Metadata.Ttl = int64(ttl.Seconds())
+	return token, nil
+}
+
+func (s *SQLStore) DeleteToken(ctx context.Context, ref *core.Reference) error {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	res, err := s.exec(ctx, s.DB, `DELETE FROM tokens WHERE id = ? AND expires_at > ?`,
+		ref.Id, time.Now().UnixNano())
+	if err != nil {
+		return fmt.Errorf("failed to delete token: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to delete token: %w", err)
+	} else if n == 0 {
+		return storage.ErrNotFound
+	}
+	return nil
+}
+
+func (s *SQLStore) GetToken(ctx context.Context, ref *core.Reference) (*core.BootstrapToken, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	token, _, err := s.getToken(ctx, s.DB, ref)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get token: %w", err)
+	}
+	return token, nil
+}
+
+func (s *SQLStore) ListTokens(ctx context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
+	options := storage.ListOptions{}
+	options.Apply(opts...)
+	cursor, err := options.Cursor()
+	if err != nil {
+		return nil, err
+	}
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	now := time.Now().UnixNano()
+	res, err := s.exec(ctx, s.DB, `DELETE FROM tokens WHERE expires_at <= ?`, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to garbage-collect expired tokens: %w", err)
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		s.Logger.With(
+			"count", n,
+		).Debug("garbage-collected expired tokens")
+	}
+	pageWhere, pageArgs, order := pageClause("id", cursor, options, len(options.Capabilities) == 0)
+	rows, err := s.query(ctx, s.DB, `SELECT id, data, expires_at FROM tokens WHERE expires_at > ? AND `+pageWhere+order,
+		append([]any{now}, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	defer rows.Close()
+	page := storage.NewPaginator[*core.BootstrapToken](options)
+	for rows.Next() {
+		var id, data string
+		var expiresAt int64
+		if err := rows.Scan(&id, &data, &expiresAt); err != nil {
+			return nil, fmt.Errorf("failed to list tokens: %w", err)
+		}
+		token, err := decodeToken(data, expiresAt, now)
+		if err != nil {
+			return nil, err
+		}
+		if !options.MatchToken(token) {
+			continue
+		}
+		if !page.Add(id, token) {
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to list tokens: %w", err)
+	}
+	return &core.BootstrapTokenList{
+		Items:         page.Items(),
+		NextPageToken: page.NextPageToken(),
+	}, nil
+}
+
+func (s *SQLStore) UpdateToken(ctx context.Context, ref *core.Reference, mutator storage.MutatorFunc[*core.BootstrapToken]) (*core.BootstrapToken, error) {
+	ctx, ca := context.WithTimeout(ctx, s.CommandTimeout)
+	defer ca()
+	var retToken *core.BootstrapToken
+	err := retry.OnError(defaultBackoff, isRetryErr, func() error {
+		return s.withTx(ctx, func(tx *dbsql.Tx) error {
+			token, version, err := s.getToken(ctx, tx, ref)
+			if err != nil {
+				return err
+			}
+			ttl := token.GetMetadata().GetTtl()
+			mutator(token)
+			data, err := protojson.Marshal(token)
+			if err != nil {
+				return fmt.Errorf("failed to marshal token: %w", err)
+			}
+			res, err := s.exec(ctx, tx, `UPDATE tokens SET data = ?, version = version + 1 WHERE id = ? AND version = ?`,
+				string(data), ref.Id, version)
+			if err != nil {
+				return err
+			}
+			if n, err := res.RowsAffected(); err != nil {
+				return err
+			} else if n == 0 {
+				return retryErr
+			}
+			// the ttl is derived from the expiration time and cannot be changed
+			// by the mutator
+			token.Metadata.Ttl = ttl
+			retToken = token
+			return nil
+		})
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update token: %w", err)
+	}
+	return retToken, nil
+}
+
+func (s *SQLStore) getToken(ctx context.Context, q queryer, ref *core.Reference) (*core.BootstrapToken, int64, error) {
+	now := time.Now().UnixNano()
+	var data string
+	var expiresAt, version int64
+	err := s.queryRow(ctx, q, `SELECT data, expires_at, version FROM tokens WHERE id = ? AND expires_at > ?`,
+		ref.Id, now).Scan(&data, &expiresAt, &version)
+	if err != nil {
+		if errors.Is(err, dbsql.ErrNoRows) {
+			return nil, 0, storage.ErrNotFound
+		}
+		return nil, 0, err
+	}
+	token, err := decodeToken(data, expiresAt, now)
+	if err != nil {
+		return nil, 0, err
+	}
+	return token, version, nil
+}
+
+func decodeToken(data string, expiresAt, now int64) (*core.BootstrapToken, error) {
+	token := &core.BootstrapToken{}
+	if err := protojson.Unmarshal([]byte(data), token); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal token: %w", err)
+	}
+	if token.Metadata == nil {
+		token.Metadata = &core.BootstrapTokenMetadata{}
+	}
+	ttl := time.Duration(expiresAt - now)
+	token.Metadata.Ttl = int64(ttl.Round(time.Second).Seconds())
+	return token, nil
+}
@@ -20,13 +20,22 @@ type MutatorFunc[T any] func(T)
 
 type TokenMutator = MutatorFunc[*core.BootstrapToken]
 type ClusterMutator = MutatorFunc[*core.Cluster]
+type RoleMutator = MutatorFunc[*core.Role]
+type RoleBindingMutator = MutatorFunc[*core.RoleBinding]
+
+// KeyringMutator computes a new keyring from the current one. Keyrings are
+// immutable, so the mutator returns a modified copy. If it returns an error,
+// the stored keyring is left unchanged.
+type KeyringMutator func(keyring.Keyring) (keyring.Keyring, error)
+
+type ClusterWatchEvent = WatchEvent[*core.Cluster]
 
 type TokenStore interface {
 	CreateToken(ctx context.Context, ttl time.Duration, opts ...TokenCreateOption) (*core.BootstrapToken, error)
 	DeleteToken(ctx context.Context, ref *core.Reference) error
 	GetToken(ctx context.Context, ref *core.Reference) (*core.BootstrapToken, error)
 	UpdateToken(ctx context.Context, ref *core.Reference, mutator TokenMutator) (*core.BootstrapToken, error)
-	ListTokens(ctx context.Context) ([]*core.BootstrapToken, error)
+	ListTokens(ctx context.Context, opts ...ListOption) (*core.BootstrapTokenList, error)
 }
 
 type ClusterStore interface {
@@ -34,32 +43,62 @@ type ClusterStore interface {
 	DeleteCluster(ctx context.Context, ref *core.Reference) error
 	GetCluster(ctx context.Context, ref *core.Reference) (*core.Cluster, error)
 	UpdateCluster(ctx context.Context, ref *core.Reference, mutator ClusterMutator) (*core.Cluster, error)
-	ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error)
+	ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions, opts ...ListOption) (*core.ClusterList, error)
+	// WatchClusters returns a channel of events describing changes to clusters
+	// in the store. Events are first sent for any differences between the
+	// known clusters and the current contents of the store, followed by
+	// changes as they occur. The channel is closed when the context is
+	// canceled.
+	WatchClusters(ctx context.Context, known []*core.Cluster) (<-chan ClusterWatchEvent, error)
 }
 
 type RBACStore interface {
 	CreateRole(context.Context, *core.Role) error
 	DeleteRole(context.Context, *core.Reference) error
 	GetRole(context.Context, *core.Reference) (*core.Role, error)
+	UpdateRole(ctx context.Context, ref *core.Reference, mutator RoleMutator) (*core.Role, error)
 	CreateRoleBinding(context.Context, *core.RoleBinding) error
 	DeleteRoleBinding(context.Context, *core.Reference) error
 	GetRoleBinding(context.Context, *core.Reference) (*core.RoleBinding, error)
-	ListRoles(context.Context) (*core.RoleList, error)
-	ListRoleBindings(context.Context) (*core.RoleBindingList, error)
+	UpdateRoleBinding(ctx context.Context, ref *core.Reference, mutator RoleBindingMutator) (*core.RoleBinding, error)
+	ListRoles(ctx context.Context, opts ...ListOption) (*core.RoleList, error)
+	ListRoleBindings(ctx context.Context, opts ...ListOption) (*core.RoleBindingList, error)
 }
 
 type KeyringStore interface {
 	Put(ctx context.Context, keyring keyring.Keyring) error
 	Get(ctx context.Context) (keyring.Keyring, error)
+	// Update atomically replaces the stored keyring with the result of
+	// applying the mutator to it, and returns the new keyring. The mutator
+	// may be called more than once if the keyring is modified concurrently.
+	// Returns ErrNotFound if the keyring does not exist.
+	Update(ctx context.Context, mutator KeyringMutator) (keyring.Keyring, error)
+	Delete(ctx context.Context) error
 }
 
 type KeyValueStore interface {
-	Put(ctx context.Context, key string, value []byte) error
-	Get(ctx context.Context, key string) ([]byte, error)
-	Delete(ctx context.Context, key string) error
+	Put(ctx context.Context, key string, value []byte, opts ...KeyValueStoreOption) error
+	Get(ctx context.Context, key string, opts ...KeyValueStoreOption) ([]byte, error)
+	Delete(ctx context.Context, key string, opts ...KeyValueStoreOption) error
 	ListKeys(ctx context.Context, prefix string) ([]string, error)
+	// Watch returns a channel of events describing changes to keys with the
+	// given prefix, starting after the watch is established. The channel is
+	// closed when the context is canceled. Stores which expire keys lazily
+	// may not send delete events for expired keys.
+	Watch(ctx context.Context, prefix string) (<-chan KeyValueWatchEvent, error)
 }
 
+// KeyRevision is the value of a key in a key-value store at a specific
+// revision. Revisions increase every time a key is modified, but are not
+// necessarily contiguous.
+type KeyRevision struct {
+	Key      string
+	Value    []byte
+	Revision int64
+}
+
+type KeyValueWatchEvent = WatchEvent[*KeyRevision]
+
 type KeyringStoreBroker interface {
 	KeyringStore(ctx context.Context, namespace string, ref *core.Reference) (KeyringStore, error)
 }
@@ -70,7 +109,7 @@ type KeyValueStoreBroker interface {
 
 // A store that can be used to compute subject access rules
 type SubjectAccessCapableStore interface {
-	ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error)
+	ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions, opts ...ListOption) (*core.ClusterList, error)
 	GetRole(ctx context.Context, ref *core.Reference) (*core.Role, error)
-	ListRoleBindings(ctx context.Context) (*core.RoleBindingList, error)
+	ListRoleBindings(ctx context.Context, opts ...ListOption) (*core.RoleBindingList, error)
 }
@@ -0,0 +1,24 @@
+package storage
+
+import "context"
+
+// ValueTransformer transforms keyring and key-value store data as it is
+// written to and read from a storage backend. It is used to implement
+// encryption at rest.
+type ValueTransformer interface {
+	TransformToStorage(ctx context.Context, data []byte) ([]byte, error)
+	TransformFromStorage(ctx context.Context, data []byte) ([]byte, error)
+}
+
+// IdentityTransformer is a ValueTransformer that does not modify data.
+type IdentityTransformer struct{}
+
+var _ ValueTransformer = IdentityTransformer{}
+
+func (IdentityTransformer) TransformToStorage(_ context.Context, data []byte) ([]byte, error) {
+	return data, nil
+}
+
+func (IdentityTransformer) TransformFromStorage(_ context.Context, data []byte) ([]byte, error) {
+	return data, nil
+}
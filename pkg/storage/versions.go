@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// InitVersionedObject sets the timestamps of an object which is about to be
+// created. Timestamps which are already set are kept, so that objects can be
+// copied between stores without losing their history. The resource version
+// is cleared, since it is assigned by the store.
+func InitVersionedObject(obj core.VersionedObject) {
+	now := timestamppb.New(time.Now())
+	if obj.GetCreationTimestamp() == nil {
+		obj.SetCreationTimestamp(now)
+	}
+	if obj.GetLastModified() == nil {
+		obj.SetLastModified(obj.GetCreationTimestamp())
+	}
+	obj.SetResourceVersion("")
+}
+
+// ApplyMutator applies the mutator to an object which has been read from a
+// store, in preparation for writing it back. The object's resource version
+// must be set to its current version in the store.
+//
+// If the mutator sets the resource version, it is compared against the
+// current version, and ErrConflict is returned if they do not match. This
+// allows callers to update an object only if it has not been modified since
+// they last read it (see NewCompareAndSwapMutator). If the resource version
+// is left empty, the update is applied unconditionally.
+//
+// The creation timestamp cannot be modified, and the last modified timestamp
+// is set to the current time.
+func ApplyMutator[T core.VersionedObject](obj T, mutator MutatorFunc[T]) error {
+	version := obj.GetResourceVersion()
+	created := obj.GetCreationTimestamp()
+	mutator(obj)
+	if rv := obj.GetResourceVersion(); rv != "" && rv != version {
+		return ErrConflict
+	}
+	obj.SetResourceVersion(version)
+	obj.SetCreationTimestamp(created)
+	obj.SetLastModified(timestamppb.New(time.Now()))
+	return nil
+}
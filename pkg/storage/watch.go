@@ -0,0 +1,188 @@
+package storage
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+	"google.golang.org/protobuf/proto"
+)
+
+type WatchEventType string
+
+const (
+	WatchEventCreate WatchEventType = "create"
+	WatchEventUpdate WatchEventType = "update"
+	WatchEventDelete WatchEventType = "delete"
+)
+
+// WatchEvent describes a change to an object in a store. For create events,
+// only Current is set. For delete events, only Previous is set. For update
+// events, both are set.
+type WatchEvent[T any] struct {
+	EventType WatchEventType
+	Current   T
+	Previous  T
+}
+
+// ComputeClusterEvents returns a list of events which, when applied in order
+// to the set of known clusters, will result in the set of current clusters.
+// Stores use this to bring a watcher up to date before streaming changes.
+func ComputeClusterEvents(known, current []*core.Cluster) []WatchEvent[*core.Cluster] {
+	knownById := make(map[string]*core.Cluster, len(known))
+	for _, c := range known {
+		knownById[c.Id] = c
+	}
+	var events []WatchEvent[*core.Cluster]
+	for _, c := range current {
+		prev, ok := knownById[c.Id]
+		if !ok {
+			events = append(events, WatchEvent[*core.Cluster]{
+				EventType: WatchEventCreate,
+				Current:   c,
+			})
+			continue
+		}
+		delete(knownById, c.Id)
+		if !proto.Equal(prev, c) {
+			events = append(events, WatchEvent[*core.Cluster]{
+				EventType: WatchEventUpdate,
+				Current:   c,
+				Previous:  prev,
+			})
+		}
+	}
+	for _, c := range known {
+		if _, ok := knownById[c.Id]; ok {
+			events = append(events, WatchEvent[*core.Cluster]{
+				EventType: WatchEventDelete,
+				Previous:  c,
+			})
+		}
+	}
+	return events
+}
+
+// EventBroadcaster distributes watch events to subscribers within a single
+// process. It can be used by stores which do not have native support for
+// watches. Publish never blocks, so that it can be called while holding a
+// store's write lock. A subscriber which falls behind and fills its buffer is
+// removed and its channel closed, after which it must list the current state
+// and subscribe again.
+type EventBroadcaster[T any] struct {
+	mu          sync.Mutex
+	subscribers map[*subscriber[T]]struct{}
+}
+
+type subscriber[T any] struct {
+	ch chan WatchEvent[T]
+	// Closed when the subscriber is evicted
+	evicted chan struct{}
+}
+
+// SubscriberBufferSize is the number of published events which are buffered
+// for each subscriber before it is evicted.
+const SubscriberBufferSize = 64
+
+// Subscribe registers a new subscriber. Any initial events will be sent on
+// the returned channel before published events. The subscriber will be
+// removed and the returned channel closed when the context is canceled, or
+// when the subscriber falls behind.
+func (b *EventBroadcaster[T]) Subscribe(ctx context.Context, initial ...WatchEvent[T]) <-chan WatchEvent[T] {
+	sub := &subscriber[T]{
+		ch:      make(chan WatchEvent[T], len(initial)+SubscriberBufferSize),
+		evicted: make(chan struct{}),
+	}
+	for _, event := range initial {
+		sub.ch <- event
+	}
+	b.mu.Lock()
+	if b.subscribers == nil {
+		b.subscribers = map[*subscriber[T]]struct{}{}
+	}
+	b.subscribers[sub] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			b.mu.Lock()
+			b.remove(sub)
+			b.mu.Unlock()
+		case <-sub.evicted:
+		}
+	}()
+	return sub.ch
+}
+
+// remove must be called with b.mu held.
+func (b *EventBroadcaster[T]) remove(sub *subscriber[T]) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.ch)
+}
+
+// Publish sends an event to all subscribers without blocking. Subscribers
+// whose buffer is full are evicted.
+func (b *EventBroadcaster[T]) Publish(event WatchEvent[T]) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for sub := range b.subscribers {
+		select {
+		case sub.ch <- event:
+		default:
+			b.remove(sub)
+			close(sub.evicted)
+		}
+	}
+}
+
+// SubscribeKeys subscribes to a broadcaster of key-value store events for
+// keys of the form "<namespace>/<key>". Only events for keys in the namespace
+// which have the given prefix are sent on the returned channel, with the
+// namespace removed from the key.
+func SubscribeKeys(
+	ctx context.Context,
+	b *EventBroadcaster[*KeyRevision],
+	namespace string,
+	prefix string,
+) <-chan KeyValueWatchEvent {
+	namespace += "/"
+	events := b.Subscribe(ctx)
+	eventC := make(chan KeyValueWatchEvent, 64)
+	go func() {
+		defer close(eventC)
+		for event := range events {
+			var key string
+			if event.Current != nil {
+				key = event.Current.Key
+			} else if event.Previous != nil {
+				key = event.Previous.Key
+			}
+			if !strings.HasPrefix(key, namespace+prefix) {
+				continue
+			}
+			event.Current = trimKey(event.Current, namespace)
+			event.Previous = trimKey(event.Previous, namespace)
+			select {
+			case eventC <- event:
+			case <-ctx.Done():
+			}
+		}
+	}()
+	return eventC
+}
+
+func trimKey(kr *KeyRevision, namespace string) *KeyRevision {
+	if kr == nil {
+		return nil
+	}
+	return &KeyRevision{
+		Key:      strings.TrimPrefix(kr.Key, namespace),
+		Value:    kr.Value,
+		Revision: kr.Revision,
+	}
+}
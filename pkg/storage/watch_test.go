@@ -0,0 +1,57 @@
+package storage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rancher/opni-monitoring/pkg/storage"
+	"github.com/rancher/opni-monitoring/pkg/test"
+)
+
+var _ = Describe("Event Broadcaster", Label(test.Unit), func() {
+	event := func(value string) storage.WatchEvent[string] {
+		return storage.WatchEvent[string]{
+			EventType: storage.WatchEventCreate,
+			Current:   value,
+		}
+	}
+	It("should send initial and published events in order", func() {
+		b := &storage.EventBroadcaster[string]{}
+		ctx, ca := context.WithCancel(context.Background())
+		events := b.Subscribe(ctx, event("a"))
+		b.Publish(event("b"))
+		Expect(<-events).To(Equal(event("a")))
+		Expect(<-events).To(Equal(event("b")))
+		ca()
+		Eventually(events).Should(BeClosed())
+	})
+	It("should evict subscribers which fall behind without blocking", func() {
+		b := &storage.EventBroadcaster[string]{}
+		ctx, ca := context.WithCancel(context.Background())
+		defer ca()
+		slow := b.Subscribe(ctx)
+		fast := b.Subscribe(ctx)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < storage.SubscriberBufferSize+1; i++ {
+				b.Publish(event("x"))
+				Expect(<-fast).To(Equal(event("x")))
+			}
+		}()
+		Eventually(done, time.Second).Should(BeClosed())
+
+		for i := 0; i < storage.SubscriberBufferSize; i++ {
+			Expect(<-slow).To(Equal(event("x")))
+		}
+		Expect(slow).To(BeClosed())
+
+		// The remaining subscriber is unaffected
+		b.Publish(event("y"))
+		Expect(<-fast).To(Equal(event("y")))
+	})
+})
@@ -68,6 +68,7 @@ var Log = logger.New(logger.WithLogLevel(zap.DebugLevel)).Named("test")
 type servicePorts struct {
 	Etcd            int
 	Gateway         int
+	GatewayGRPC     int
 	ManagementGRPC  int
 	ManagementHTTP  int
 	ManagementWeb   int
@@ -173,7 +174,7 @@ func (e *Environment) Start(opts ...EnvironmentOption) error {
 			return fmt.Errorf("failed to install test auth middleware: %w", err)
 		}
 	}
-	ports, err := freeport.GetFreePorts(8)
+	ports, err := freeport.GetFreePorts(9)
 	if err != nil {
 		panic(err)
 	}
@@ -186,6 +187,7 @@ func (e *Environment) Start(opts ...EnvironmentOption) error {
 		CortexGRPC:      ports[5],
 		CortexHTTP:      ports[6],
 		TestEnvironment: ports[7],
+		GatewayGRPC:     ports[8],
 	}
 	if portNum, ok := os.LookupEnv("OPNI_MANAGEMENT_GRPC_PORT"); ok {
 		e.ports.ManagementGRPC, err = strconv.Atoi(portNum)
@@ -569,8 +571,9 @@ func (e *Environment) newGatewayConfig() *v1beta1.GatewayConfig {
 					"../../../../../bin",
 				},
 			},
-			ListenAddress: fmt.Sprintf("localhost:%d", e.ports.Gateway),
-			EnableMonitor: true,
+			ListenAddress:     fmt.Sprintf("localhost:%d", e.ports.Gateway),
+			GRPCListenAddress: fmt.Sprintf("localhost:%d", e.ports.GatewayGRPC),
+			EnableMonitor:     true,
 			Management: v1beta1.ManagementSpec{
 				GRPCListenAddress: fmt.Sprintf("tcp://127.0.0.1:%d", e.ports.ManagementGRPC),
 				HTTPListenAddress: fmt.Sprintf("127.0.0.1:%d", e.ports.ManagementHTTP),
@@ -681,9 +684,11 @@ func (e *Environment) startGateway() {
 	)
 	m := management.NewServer(e.ctx, &e.gatewayConfig.Spec.Management, g,
 		management.WithCapabilitiesDataSource(g),
+		management.WithAgentControlDataSource(g),
 		management.WithSystemPlugins(systemPlugins),
 		management.WithLifecycler(lifecycler),
 		management.WithAPIExtensions(mgmtExtensionPlugins),
+		management.WithAuditLog(g.AuditLog()),
 	)
 	go func() {
 		if err := g.ListenAndServe(); err != nil {
@@ -761,9 +766,10 @@ func (e *Environment) StartAgent(id string, token *core.BootstrapToken, pins []s
 
 	agentConfig := &v1beta1.AgentConfig{
 		Spec: v1beta1.AgentConfigSpec{
-			ListenAddress:    fmt.Sprintf("localhost:%d", port),
-			GatewayAddress:   fmt.Sprintf("https://localhost:%d", e.ports.Gateway),
-			IdentityProvider: id,
+			ListenAddress:      fmt.Sprintf("localhost:%d", port),
+			GatewayAddress:     fmt.Sprintf("https://localhost:%d", e.ports.Gateway),
+			GatewayGRPCAddress: fmt.Sprintf("localhost:%d", e.ports.GatewayGRPC),
+			IdentityProvider:   id,
 			Storage: v1beta1.StorageSpec{
 				Type: v1beta1.StorageTypeEtcd,
 				Etcd: &v1beta1.EtcdStorageSpec{
@@ -793,10 +799,11 @@ func (e *Environment) StartAgent(id string, token *core.BootstrapToken, pins []s
 		mu.Lock()
 		a, err = agent.New(e.ctx, agentConfig,
 			agent.WithBootstrapper(&bootstrap.ClientConfig{
-				Capability: wellknown.CapabilityMetrics,
-				Token:      bt,
-				Pins:       publicKeyPins,
-				Endpoint:   fmt.Sprintf("http://localhost:%d", e.ports.Gateway),
+				Capability:   wellknown.CapabilityMetrics,
+				Token:        bt,
+				Pins:         publicKeyPins,
+				Endpoint:     fmt.Sprintf("http://localhost:%d", e.ports.Gateway),
+				GRPCEndpoint: fmt.Sprintf("localhost:%d", e.ports.GatewayGRPC),
 			}))
 		if err != nil {
 			errC <- err
@@ -5,6 +5,7 @@
 package mock_capability
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -49,17 +50,17 @@ func (mr *MockBackendMockRecorder) CanInstall() *gomock.Call {
 }
 
 // Install mocks base method.
-func (m *MockBackend) Install(cluster *core.Reference) error {
+func (m *MockBackend) Install(ctx context.Context, cluster *core.Reference) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Install", cluster)
+	ret := m.ctrl.Call(m, "Install", ctx, cluster)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Install indicates an expected call of Install.
-func (mr *MockBackendMockRecorder) Install(cluster interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) Install(ctx, cluster interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockBackend)(nil).Install), cluster)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Install", reflect.TypeOf((*MockBackend)(nil).Install), ctx, cluster)
 }
 
 // InstallerTemplate mocks base method.
@@ -75,3 +76,17 @@ func (mr *MockBackendMockRecorder) InstallerTemplate() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallerTemplate", reflect.TypeOf((*MockBackend)(nil).InstallerTemplate))
 }
+
+// Uninstall mocks base method.
+func (m *MockBackend) Uninstall(cluster *core.Reference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Uninstall", cluster)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Uninstall indicates an expected call of Uninstall.
+func (mr *MockBackendMockRecorder) Uninstall(cluster interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Uninstall", reflect.TypeOf((*MockBackend)(nil).Uninstall), cluster)
+}
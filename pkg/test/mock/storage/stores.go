@@ -247,63 +247,83 @@ func (mr *MockBackendMockRecorder) KeyringStore(ctx, namespace, ref interface{})
 }
 
 // ListClusters mocks base method.
-func (m *MockBackend) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error) {
+func (m *MockBackend) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions, opts ...storage.ListOption) (*core.ClusterList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClusters", ctx, matchLabels, matchOptions)
+	varargs := []interface{}{ctx, matchLabels, matchOptions}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusters", varargs...)
 	ret0, _ := ret[0].(*core.ClusterList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListClusters indicates an expected call of ListClusters.
-func (mr *MockBackendMockRecorder) ListClusters(ctx, matchLabels, matchOptions interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) ListClusters(ctx, matchLabels, matchOptions interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockBackend)(nil).ListClusters), ctx, matchLabels, matchOptions)
+	varargs := append([]interface{}{ctx, matchLabels, matchOptions}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockBackend)(nil).ListClusters), varargs...)
 }
 
 // ListRoleBindings mocks base method.
-func (m *MockBackend) ListRoleBindings(arg0 context.Context) (*core.RoleBindingList, error) {
+func (m *MockBackend) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleBindings", arg0)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoleBindings", varargs...)
 	ret0, _ := ret[0].(*core.RoleBindingList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRoleBindings indicates an expected call of ListRoleBindings.
-func (mr *MockBackendMockRecorder) ListRoleBindings(arg0 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) ListRoleBindings(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockBackend)(nil).ListRoleBindings), arg0)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockBackend)(nil).ListRoleBindings), varargs...)
 }
 
 // ListRoles mocks base method.
-func (m *MockBackend) ListRoles(arg0 context.Context) (*core.RoleList, error) {
+func (m *MockBackend) ListRoles(ctx context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoles", varargs...)
 	ret0, _ := ret[0].(*core.RoleList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRoles indicates an expected call of ListRoles.
-func (mr *MockBackendMockRecorder) ListRoles(arg0 interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) ListRoles(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockBackend)(nil).ListRoles), arg0)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockBackend)(nil).ListRoles), varargs...)
 }
 
 // ListTokens mocks base method.
-func (m *MockBackend) ListTokens(ctx context.Context) ([]*core.BootstrapToken, error) {
+func (m *MockBackend) ListTokens(ctx context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTokens", ctx)
-	ret0, _ := ret[0].([]*core.BootstrapToken)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTokens", varargs...)
+	ret0, _ := ret[0].(*core.BootstrapTokenList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTokens indicates an expected call of ListTokens.
-func (mr *MockBackendMockRecorder) ListTokens(ctx interface{}) *gomock.Call {
+func (mr *MockBackendMockRecorder) ListTokens(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockBackend)(nil).ListTokens), ctx)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockBackend)(nil).ListTokens), varargs...)
 }
 
 // UpdateCluster mocks base method.
@@ -321,6 +341,36 @@ func (mr *MockBackendMockRecorder) UpdateCluster(ctx, ref, mutator interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockBackend)(nil).UpdateCluster), ctx, ref, mutator)
 }
 
+// UpdateRole mocks base method.
+func (m *MockBackend) UpdateRole(ctx context.Context, ref *core.Reference, mutator storage.RoleMutator) (*core.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, ref, mutator)
+	ret0, _ := ret[0].(*core.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockBackendMockRecorder) UpdateRole(ctx, ref, mutator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockBackend)(nil).UpdateRole), ctx, ref, mutator)
+}
+
+// UpdateRoleBinding mocks base method.
+func (m *MockBackend) UpdateRoleBinding(ctx context.Context, ref *core.Reference, mutator storage.RoleBindingMutator) (*core.RoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleBinding", ctx, ref, mutator)
+	ret0, _ := ret[0].(*core.RoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoleBinding indicates an expected call of UpdateRoleBinding.
+func (mr *MockBackendMockRecorder) UpdateRoleBinding(ctx, ref, mutator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleBinding", reflect.TypeOf((*MockBackend)(nil).UpdateRoleBinding), ctx, ref, mutator)
+}
+
 // UpdateToken mocks base method.
 func (m *MockBackend) UpdateToken(ctx context.Context, ref *core.Reference, mutator storage.TokenMutator) (*core.BootstrapToken, error) {
 	m.ctrl.T.Helper()
@@ -336,6 +386,21 @@ func (mr *MockBackendMockRecorder) UpdateToken(ctx, ref, mutator interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateToken", reflect.TypeOf((*MockBackend)(nil).UpdateToken), ctx, ref, mutator)
 }
 
+// WatchClusters mocks base method.
+func (m *MockBackend) WatchClusters(ctx context.Context, known []*core.Cluster) (<-chan storage.ClusterWatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchClusters", ctx, known)
+	ret0, _ := ret[0].(<-chan storage.ClusterWatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchClusters indicates an expected call of WatchClusters.
+func (mr *MockBackendMockRecorder) WatchClusters(ctx, known interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchClusters", reflect.TypeOf((*MockBackend)(nil).WatchClusters), ctx, known)
+}
+
 // MockTokenStore is a mock of TokenStore interface.
 type MockTokenStore struct {
 	ctrl     *gomock.Controller
@@ -409,18 +474,23 @@ func (mr *MockTokenStoreMockRecorder) GetToken(ctx, ref interface{}) *gomock.Cal
 }
 
 // ListTokens mocks base method.
-func (m *MockTokenStore) ListTokens(ctx context.Context) ([]*core.BootstrapToken, error) {
+func (m *MockTokenStore) ListTokens(ctx context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListTokens", ctx)
-	ret0, _ := ret[0].([]*core.BootstrapToken)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTokens", varargs...)
+	ret0, _ := ret[0].(*core.BootstrapTokenList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListTokens indicates an expected call of ListTokens.
-func (mr *MockTokenStoreMockRecorder) ListTokens(ctx interface{}) *gomock.Call {
+func (mr *MockTokenStoreMockRecorder) ListTokens(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockTokenStore)(nil).ListTokens), ctx)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTokens", reflect.TypeOf((*MockTokenStore)(nil).ListTokens), varargs...)
 }
 
 // UpdateToken mocks base method.
@@ -505,18 +575,23 @@ func (mr *MockClusterStoreMockRecorder) GetCluster(ctx, ref interface{}) *gomock
 }
 
 // ListClusters mocks base method.
-func (m *MockClusterStore) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error) {
+func (m *MockClusterStore) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions, opts ...storage.ListOption) (*core.ClusterList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClusters", ctx, matchLabels, matchOptions)
+	varargs := []interface{}{ctx, matchLabels, matchOptions}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusters", varargs...)
 	ret0, _ := ret[0].(*core.ClusterList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListClusters indicates an expected call of ListClusters.
-func (mr *MockClusterStoreMockRecorder) ListClusters(ctx, matchLabels, matchOptions interface{}) *gomock.Call {
+func (mr *MockClusterStoreMockRecorder) ListClusters(ctx, matchLabels, matchOptions interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterStore)(nil).ListClusters), ctx, matchLabels, matchOptions)
+	varargs := append([]interface{}{ctx, matchLabels, matchOptions}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterStore)(nil).ListClusters), varargs...)
 }
 
 // UpdateCluster mocks base method.
@@ -534,6 +609,21 @@ func (mr *MockClusterStoreMockRecorder) UpdateCluster(ctx, ref, mutator interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockClusterStore)(nil).UpdateCluster), ctx, ref, mutator)
 }
 
+// WatchClusters mocks base method.
+func (m *MockClusterStore) WatchClusters(ctx context.Context, known []*core.Cluster) (<-chan storage.ClusterWatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchClusters", ctx, known)
+	ret0, _ := ret[0].(<-chan storage.ClusterWatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchClusters indicates an expected call of WatchClusters.
+func (mr *MockClusterStoreMockRecorder) WatchClusters(ctx, known interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchClusters", reflect.TypeOf((*MockClusterStore)(nil).WatchClusters), ctx, known)
+}
+
 // MockRBACStore is a mock of RBACStore interface.
 type MockRBACStore struct {
 	ctrl     *gomock.Controller
@@ -644,33 +734,73 @@ func (mr *MockRBACStoreMockRecorder) GetRoleBinding(arg0, arg1 interface{}) *gom
 }
 
 // ListRoleBindings mocks base method.
-func (m *MockRBACStore) ListRoleBindings(arg0 context.Context) (*core.RoleBindingList, error) {
+func (m *MockRBACStore) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleBindings", arg0)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoleBindings", varargs...)
 	ret0, _ := ret[0].(*core.RoleBindingList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRoleBindings indicates an expected call of ListRoleBindings.
-func (mr *MockRBACStoreMockRecorder) ListRoleBindings(arg0 interface{}) *gomock.Call {
+func (mr *MockRBACStoreMockRecorder) ListRoleBindings(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockRBACStore)(nil).ListRoleBindings), arg0)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockRBACStore)(nil).ListRoleBindings), varargs...)
 }
 
 // ListRoles mocks base method.
-func (m *MockRBACStore) ListRoles(arg0 context.Context) (*core.RoleList, error) {
+func (m *MockRBACStore) ListRoles(ctx context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoles", arg0)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoles", varargs...)
 	ret0, _ := ret[0].(*core.RoleList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRoles indicates an expected call of ListRoles.
-func (mr *MockRBACStoreMockRecorder) ListRoles(arg0 interface{}) *gomock.Call {
+func (mr *MockRBACStoreMockRecorder) ListRoles(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRBACStore)(nil).ListRoles), varargs...)
+}
+
+// UpdateRole mocks base method.
+func (m *MockRBACStore) UpdateRole(ctx context.Context, ref *core.Reference, mutator storage.RoleMutator) (*core.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, ref, mutator)
+	ret0, _ := ret[0].(*core.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole.
+func (mr *MockRBACStoreMockRecorder) UpdateRole(ctx, ref, mutator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockRBACStore)(nil).UpdateRole), ctx, ref, mutator)
+}
+
+// UpdateRoleBinding mocks base method.
+func (m *MockRBACStore) UpdateRoleBinding(ctx context.Context, ref *core.Reference, mutator storage.RoleBindingMutator) (*core.RoleBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleBinding", ctx, ref, mutator)
+	ret0, _ := ret[0].(*core.RoleBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoleBinding indicates an expected call of UpdateRoleBinding.
+func (mr *MockRBACStoreMockRecorder) UpdateRoleBinding(ctx, ref, mutator interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRBACStore)(nil).ListRoles), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleBinding", reflect.TypeOf((*MockRBACStore)(nil).UpdateRoleBinding), ctx, ref, mutator)
 }
 
 // MockKeyringStore is a mock of KeyringStore interface.
@@ -696,6 +826,20 @@ func (m *MockKeyringStore) EXPECT() *MockKeyringStoreMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockKeyringStore) Delete(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKeyringStoreMockRecorder) Delete(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeyringStore)(nil).Delete), ctx)
+}
+
 // Get mocks base method.
 func (m *MockKeyringStore) Get(ctx context.Context) (keyring.Keyring, error) {
 	m.ctrl.T.Helper()
@@ -725,6 +869,21 @@ func (mr *MockKeyringStoreMockRecorder) Put(ctx, keyring interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockKeyringStore)(nil).Put), ctx, keyring)
 }
 
+// Update mocks base method.
+func (m *MockKeyringStore) Update(ctx context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, mutator)
+	ret0, _ := ret[0].(keyring.Keyring)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockKeyringStoreMockRecorder) Update(ctx, mutator interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeyringStore)(nil).Update), ctx, mutator)
+}
+
 // MockKeyValueStore is a mock of KeyValueStore interface.
 type MockKeyValueStore struct {
 	ctrl     *gomock.Controller
@@ -749,32 +908,42 @@ func (m *MockKeyValueStore) EXPECT() *MockKeyValueStoreMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockKeyValueStore) Delete(ctx context.Context, key string) error {
+func (m *MockKeyValueStore) Delete(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockKeyValueStoreMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
+func (mr *MockKeyValueStoreMockRecorder) Delete(ctx, key interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeyValueStore)(nil).Delete), ctx, key)
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeyValueStore)(nil).Delete), varargs...)
 }
 
 // Get mocks base method.
-func (m *MockKeyValueStore) Get(ctx context.Context, key string) ([]byte, error) {
+func (m *MockKeyValueStore) Get(ctx context.Context, key string, opts ...storage.KeyValueStoreOption) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, key)
+	varargs := []interface{}{ctx, key}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Get", varargs...)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockKeyValueStoreMockRecorder) Get(ctx, key interface{}) *gomock.Call {
+func (mr *MockKeyValueStoreMockRecorder) Get(ctx, key interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeyValueStore)(nil).Get), ctx, key)
+	varargs := append([]interface{}{ctx, key}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeyValueStore)(nil).Get), varargs...)
 }
 
 // ListKeys mocks base method.
@@ -793,17 +962,37 @@ func (mr *MockKeyValueStoreMockRecorder) ListKeys(ctx, prefix interface{}) *gomo
 }
 
 // Put mocks base method.
-func (m *MockKeyValueStore) Put(ctx context.Context, key string, value []byte) error {
+func (m *MockKeyValueStore) Put(ctx context.Context, key string, value []byte, opts ...storage.KeyValueStoreOption) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, key, value)
+	varargs := []interface{}{ctx, key, value}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Put", varargs...)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Put indicates an expected call of Put.
-func (mr *MockKeyValueStoreMockRecorder) Put(ctx, key, value interface{}) *gomock.Call {
+func (mr *MockKeyValueStoreMockRecorder) Put(ctx, key, value interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockKeyValueStore)(nil).Put), ctx, key, value)
+	varargs := append([]interface{}{ctx, key, value}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockKeyValueStore)(nil).Put), varargs...)
+}
+
+// Watch mocks base method.
+func (m *MockKeyValueStore) Watch(ctx context.Context, prefix string) (<-chan storage.KeyValueWatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Watch", ctx, prefix)
+	ret0, _ := ret[0].(<-chan storage.KeyValueWatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch.
+func (mr *MockKeyValueStoreMockRecorder) Watch(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockKeyValueStore)(nil).Watch), ctx, prefix)
 }
 
 // MockKeyringStoreBroker is a mock of KeyringStoreBroker interface.
@@ -921,31 +1110,41 @@ func (mr *MockSubjectAccessCapableStoreMockRecorder) GetRole(ctx, ref interface{
 }
 
 // ListClusters mocks base method.
-func (m *MockSubjectAccessCapableStore) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error) {
+func (m *MockSubjectAccessCapableStore) ListClusters(ctx context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions, opts ...storage.ListOption) (*core.ClusterList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListClusters", ctx, matchLabels, matchOptions)
+	varargs := []interface{}{ctx, matchLabels, matchOptions}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusters", varargs...)
 	ret0, _ := ret[0].(*core.ClusterList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListClusters indicates an expected call of ListClusters.
-func (mr *MockSubjectAccessCapableStoreMockRecorder) ListClusters(ctx, matchLabels, matchOptions interface{}) *gomock.Call {
+func (mr *MockSubjectAccessCapableStoreMockRecorder) ListClusters(ctx, matchLabels, matchOptions interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockSubjectAccessCapableStore)(nil).ListClusters), ctx, matchLabels, matchOptions)
+	varargs := append([]interface{}{ctx, matchLabels, matchOptions}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockSubjectAccessCapableStore)(nil).ListClusters), varargs...)
 }
 
 // ListRoleBindings mocks base method.
-func (m *MockSubjectAccessCapableStore) ListRoleBindings(ctx context.Context) (*core.RoleBindingList, error) {
+func (m *MockSubjectAccessCapableStore) ListRoleBindings(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoleBindings", ctx)
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoleBindings", varargs...)
 	ret0, _ := ret[0].(*core.RoleBindingList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListRoleBindings indicates an expected call of ListRoleBindings.
-func (mr *MockSubjectAccessCapableStoreMockRecorder) ListRoleBindings(ctx interface{}) *gomock.Call {
+func (mr *MockSubjectAccessCapableStoreMockRecorder) ListRoleBindings(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockSubjectAccessCapableStore)(nil).ListRoleBindings), ctx)
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoleBindings", reflect.TypeOf((*MockSubjectAccessCapableStore)(nil).ListRoleBindings), varargs...)
 }
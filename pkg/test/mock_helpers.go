@@ -3,6 +3,8 @@ package test
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -18,7 +20,6 @@ import (
 	mock_ident "github.com/rancher/opni-monitoring/pkg/test/mock/ident"
 	mock_rules "github.com/rancher/opni-monitoring/pkg/test/mock/rules"
 	mock_storage "github.com/rancher/opni-monitoring/pkg/test/mock/storage"
-	"github.com/rancher/opni-monitoring/pkg/tokens"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,6 +33,12 @@ type CapabilityInfo struct {
 	Name              string
 	CanInstall        bool
 	InstallerTemplate string
+	// Optional function called when the capability is installed on a
+	// cluster. If nil, installing always succeeds.
+	OnInstall func(ctx context.Context, cluster *core.Reference) error
+	// Optional function called when the capability is uninstalled from a
+	// cluster. If nil, uninstalling always succeeds.
+	OnUninstall func(cluster *core.Reference) error
 }
 
 func (ci *CapabilityInfo) canInstall() error {
@@ -41,6 +48,20 @@ func (ci *CapabilityInfo) canInstall() error {
 	return nil
 }
 
+func (ci *CapabilityInfo) install(ctx context.Context, cluster *core.Reference) error {
+	if ci.OnInstall == nil {
+		return nil
+	}
+	return ci.OnInstall(ctx, cluster)
+}
+
+func (ci *CapabilityInfo) uninstall(cluster *core.Reference) error {
+	if ci.OnUninstall == nil {
+		return nil
+	}
+	return ci.OnUninstall(cluster)
+}
+
 func NewTestCapabilityBackend(
 	ctrl *gomock.Controller,
 	capBackend *CapabilityInfo,
@@ -51,8 +72,12 @@ func NewTestCapabilityBackend(
 		DoAndReturn(capBackend.canInstall).
 		AnyTimes()
 	backend.EXPECT().
-		Install(gomock.Any()).
-		Return(nil).
+		Install(gomock.Any(), gomock.Any()).
+		DoAndReturn(capBackend.install).
+		AnyTimes()
+	backend.EXPECT().
+		Uninstall(gomock.Any()).
+		DoAndReturn(capBackend.uninstall).
 		AnyTimes()
 	backend.EXPECT().
 		InstallerTemplate().
@@ -80,13 +105,15 @@ func NewTestCapabilityBackendClient(
 		AnyTimes()
 	client.EXPECT().
 		Install(gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(context.Context, *capability.InstallRequest, ...grpc.CallOption) (*emptypb.Empty, error) {
-			return nil, nil
+		DoAndReturn(func(ctx context.Context, req *capability.InstallRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+			return nil, capBackend.install(ctx, req.Cluster)
 		}).
 		AnyTimes()
 	client.EXPECT().
 		Uninstall(gomock.Any(), gomock.Any(), gomock.Any()).
-		Return(nil, nil).
+		DoAndReturn(func(_ context.Context, req *capability.UninstallRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+			return nil, capBackend.uninstall(req.Cluster)
+		}).
 		AnyTimes()
 	client.EXPECT().
 		InstallerTemplate(gomock.Any(), gomock.Any(), gomock.Any()).
@@ -106,13 +133,28 @@ func NewTestClusterStore(ctrl *gomock.Controller) storage.ClusterStore {
 
 	clusters := map[string]*core.Cluster{}
 	mu := sync.Mutex{}
+	events := storage.EventBroadcaster[*core.Cluster]{}
+	version := 0
 
 	mockClusterStore.EXPECT().
 		CreateCluster(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, cluster *core.Cluster) error {
 			mu.Lock()
 			defer mu.Unlock()
+			cluster = proto.Clone(cluster).(*core.Cluster)
+			storage.InitVersionedObject(cluster)
+			version++
+			cluster.SetResourceVersion(strconv.Itoa(version))
+			event := storage.WatchEvent[*core.Cluster]{
+				EventType: storage.WatchEventCreate,
+				Current:   cluster,
+			}
+			if prev, ok := clusters[cluster.Id]; ok {
+				event.EventType = storage.WatchEventUpdate
+				event.Previous = prev
+			}
 			clusters[cluster.Id] = cluster
+			events.Publish(event)
 			return nil
 		}).
 		AnyTimes()
@@ -121,29 +163,43 @@ func NewTestClusterStore(ctrl *gomock.Controller) storage.ClusterStore {
 		DoAndReturn(func(_ context.Context, ref *core.Reference) error {
 			mu.Lock()
 			defer mu.Unlock()
-			if _, ok := clusters[ref.Id]; !ok {
+			prev, ok := clusters[ref.Id]
+			if !ok {
 				return storage.ErrNotFound
 			}
 			delete(clusters, ref.Id)
+			events.Publish(storage.WatchEvent[*core.Cluster]{
+				EventType: storage.WatchEventDelete,
+				Previous:  prev,
+			})
 			return nil
 		}).
 		AnyTimes()
 	mockClusterStore.EXPECT().
-		ListClusters(gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions) (*core.ClusterList, error) {
+		ListClusters(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, matchLabels *core.LabelSelector, matchOptions core.MatchOptions, opts ...storage.ListOption) (*core.ClusterList, error) {
 			mu.Lock()
 			defer mu.Unlock()
-			clusterList := &core.ClusterList{}
+			options := storage.ListOptions{}
+			options.Apply(opts...)
+			items := []*core.Cluster{}
 			selectorPredicate := storage.ClusterSelector{
 				LabelSelector: matchLabels,
 				MatchOptions:  matchOptions,
 			}.Predicate()
 			for _, cluster := range clusters {
-				if selectorPredicate(cluster) {
-					clusterList.Items = append(clusterList.Items, cluster)
+				if selectorPredicate(cluster) && options.MatchCluster(cluster) {
+					items = append(items, cluster)
 				}
 			}
-			return clusterList, nil
+			items, next, err := storage.Paginate(items, (*core.Cluster).GetId, options)
+			if err != nil {
+				return nil, err
+			}
+			return &core.ClusterList{
+				Items:         items,
+				NextPageToken: next,
+			}, nil
 		}).
 		AnyTimes()
 	mockClusterStore.EXPECT().
@@ -167,14 +223,36 @@ func NewTestClusterStore(ctrl *gomock.Controller) storage.ClusterStore {
 			}
 			cluster := clusters[ref.Id]
 			cloned := proto.Clone(cluster).(*core.Cluster)
-			mutator(cloned)
+			if err := storage.ApplyMutator(cloned, mutator); err != nil {
+				return nil, err
+			}
 			if _, ok := clusters[ref.Id]; !ok {
 				return nil, storage.ErrNotFound
 			}
+			version++
+			cloned.SetResourceVersion(strconv.Itoa(version))
 			clusters[ref.Id] = cloned
+			events.Publish(storage.WatchEvent[*core.Cluster]{
+				EventType: storage.WatchEventUpdate,
+				Current:   cloned,
+				Previous:  cluster,
+			})
 			return cloned, nil
 		}).
 		AnyTimes()
+	mockClusterStore.EXPECT().
+		WatchClusters(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, known []*core.Cluster) (<-chan storage.WatchEvent[*core.Cluster], error) {
+			mu.Lock()
+			defer mu.Unlock()
+			current := make([]*core.Cluster, 0, len(clusters))
+			for _, cluster := range clusters {
+				current = append(current, cluster)
+			}
+			initial := storage.ComputeClusterEvents(known, current)
+			return events.Subscribe(ctx, initial...), nil
+		}).
+		AnyTimes()
 	return mockClusterStore
 }
 
@@ -215,9 +293,12 @@ func NewTestKeyringStoreBroker(ctrl *gomock.Controller, handler ...KeyringStoreH
 func NewTestKeyringStore(ctrl *gomock.Controller, prefix string, ref *core.Reference) storage.KeyringStore {
 	mockKeyringStore := mock_storage.NewMockKeyringStore(ctrl)
 	keyrings := map[string]keyring.Keyring{}
+	mu := sync.Mutex{}
 	mockKeyringStore.EXPECT().
 		Put(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, keyring keyring.Keyring) error {
+			mu.Lock()
+			defer mu.Unlock()
 			keyrings[prefix+ref.Id] = keyring
 			return nil
 		}).
@@ -225,6 +306,8 @@ func NewTestKeyringStore(ctrl *gomock.Controller, prefix string, ref *core.Refer
 	mockKeyringStore.EXPECT().
 		Get(gomock.Any()).
 		DoAndReturn(func(_ context.Context) (keyring.Keyring, error) {
+			mu.Lock()
+			defer mu.Unlock()
 			keyring, ok := keyrings[prefix+ref.Id]
 			if !ok {
 				return nil, storage.ErrNotFound
@@ -232,6 +315,35 @@ func NewTestKeyringStore(ctrl *gomock.Controller, prefix string, ref *core.Refer
 			return keyring, nil
 		}).
 		AnyTimes()
+	mockKeyringStore.EXPECT().
+		Update(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, mutator storage.KeyringMutator) (keyring.Keyring, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			kr, ok := keyrings[prefix+ref.Id]
+			if !ok {
+				return nil, storage.ErrNotFound
+			}
+			kr, err := mutator(kr)
+			if err != nil {
+				return nil, err
+			}
+			keyrings[prefix+ref.Id] = kr
+			return kr, nil
+		}).
+		AnyTimes()
+	mockKeyringStore.EXPECT().
+		Delete(gomock.Any()).
+		DoAndReturn(func(_ context.Context) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := keyrings[prefix+ref.Id]; !ok {
+				return storage.ErrNotFound
+			}
+			delete(keyrings, prefix+ref.Id)
+			return nil
+		}).
+		AnyTimes()
 	return mockKeyringStore
 }
 
@@ -257,15 +369,15 @@ func NewTestKeyValueStore(ctrl *gomock.Controller) storage.KeyValueStore {
 	mockKvStore := mock_storage.NewMockKeyValueStore(ctrl)
 	kvs := map[string][]byte{}
 	mockKvStore.EXPECT().
-		Put(gomock.Any(), gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, key string, value []byte) error {
+		Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, value []byte, _ ...storage.KeyValueStoreOption) error {
 			kvs[key] = value
 			return nil
 		}).
 		AnyTimes()
 	mockKvStore.EXPECT().
-		Get(gomock.Any(), gomock.Any()).
-		DoAndReturn(func(_ context.Context, key string) ([]byte, error) {
+		Get(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, _ ...storage.KeyValueStoreOption) ([]byte, error) {
 			v, ok := kvs[key]
 			if !ok {
 				return nil, storage.ErrNotFound
@@ -273,6 +385,28 @@ func NewTestKeyValueStore(ctrl *gomock.Controller) storage.KeyValueStore {
 			return v, nil
 		}).
 		AnyTimes()
+	mockKvStore.EXPECT().
+		Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, key string, _ ...storage.KeyValueStoreOption) error {
+			if _, ok := kvs[key]; !ok {
+				return storage.ErrNotFound
+			}
+			delete(kvs, key)
+			return nil
+		}).
+		AnyTimes()
+	mockKvStore.EXPECT().
+		ListKeys(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, prefix string) ([]string, error) {
+			keys := []string{}
+			for k := range kvs {
+				if strings.HasPrefix(k, prefix) {
+					keys = append(keys, k)
+				}
+			}
+			return keys, nil
+		}).
+		AnyTimes()
 	return mockKvStore
 }
 
@@ -282,12 +416,17 @@ func NewTestRBACStore(ctrl *gomock.Controller) storage.RBACStore {
 	roles := map[string]*core.Role{}
 	rbs := map[string]*core.RoleBinding{}
 	mu := sync.Mutex{}
+	version := 0
 
 	mockRBACStore.EXPECT().
 		CreateRole(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(_ context.Context, role *core.Role) error {
 			mu.Lock()
 			defer mu.Unlock()
+			role = proto.Clone(role).(*core.Role)
+			storage.InitVersionedObject(role)
+			version++
+			role.SetResourceVersion(strconv.Itoa(version))
 			roles[role.Id] = role
 			return nil
 		}).
@@ -316,15 +455,42 @@ func NewTestRBACStore(ctrl *gomock.Controller) storage.RBACStore {
 		}).
 		AnyTimes()
 	mockRBACStore.EXPECT().
-		ListRoles(gomock.Any()).
-		DoAndReturn(func(_ context.Context) (*core.RoleList, error) {
+		UpdateRole(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ref *core.Reference, mutator storage.RoleMutator) (*core.Role, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := roles[ref.Id]; !ok {
+				return nil, storage.ErrNotFound
+			}
+			cloned := proto.Clone(roles[ref.Id]).(*core.Role)
+			if err := storage.ApplyMutator(cloned, mutator); err != nil {
+				return nil, err
+			}
+			version++
+			cloned.SetResourceVersion(strconv.Itoa(version))
+			roles[ref.Id] = cloned
+			return cloned, nil
+		}).
+		AnyTimes()
+	mockRBACStore.EXPECT().
+		ListRoles(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, opts ...storage.ListOption) (*core.RoleList, error) {
 			mu.Lock()
 			defer mu.Unlock()
-			roleList := &core.RoleList{}
+			options := storage.ListOptions{}
+			options.Apply(opts...)
+			items := []*core.Role{}
 			for _, role := range roles {
-				roleList.Items = append(roleList.Items, role)
+				items = append(items, role)
+			}
+			items, next, err := storage.Paginate(items, (*core.Role).GetId, options)
+			if err != nil {
+				return nil, err
 			}
-			return roleList, nil
+			return &core.RoleList{
+				Items:         items,
+				NextPageToken: next,
+			}, nil
 		}).
 		AnyTimes()
 	mockRBACStore.EXPECT().
@@ -332,6 +498,10 @@ func NewTestRBACStore(ctrl *gomock.Controller) storage.RBACStore {
 		DoAndReturn(func(_ context.Context, rb *core.RoleBinding) error {
 			mu.Lock()
 			defer mu.Unlock()
+			rb = proto.Clone(rb).(*core.RoleBinding)
+			storage.InitVersionedObject(rb)
+			version++
+			rb.SetResourceVersion(strconv.Itoa(version))
 			rbs[rb.Id] = rb
 			return nil
 		}).
@@ -363,15 +533,47 @@ func NewTestRBACStore(ctrl *gomock.Controller) storage.RBACStore {
 		}).
 		AnyTimes()
 	mockRBACStore.EXPECT().
-		ListRoleBindings(gomock.Any()).
-		DoAndReturn(func(ctx context.Context) (*core.RoleBindingList, error) {
+		UpdateRoleBinding(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, ref *core.Reference, mutator storage.RoleBindingMutator) (*core.RoleBinding, error) {
+			mu.Lock()
+			if _, ok := rbs[ref.Id]; !ok {
+				mu.Unlock()
+				return nil, storage.ErrNotFound
+			}
+			cloned := proto.Clone(rbs[ref.Id]).(*core.RoleBinding)
+			if err := storage.ApplyMutator(cloned, mutator); err != nil {
+				mu.Unlock()
+				return nil, err
+			}
+			cloned.Taints = nil
+			version++
+			cloned.SetResourceVersion(strconv.Itoa(version))
+			rbs[ref.Id] = cloned
+			cloned = proto.Clone(cloned).(*core.RoleBinding)
+			mu.Unlock()
+			storage.ApplyRoleBindingTaints(ctx, mockRBACStore, cloned)
+			return cloned, nil
+		}).
+		AnyTimes()
+	mockRBACStore.EXPECT().
+		ListRoleBindings(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, opts ...storage.ListOption) (*core.RoleBindingList, error) {
+			options := storage.ListOptions{}
+			options.Apply(opts...)
 			mu.Lock()
-			rbList := &core.RoleBindingList{}
+			items := []*core.RoleBinding{}
 			for _, rb := range rbs {
-				cloned := proto.Clone(rb).(*core.RoleBinding)
-				rbList.Items = append(rbList.Items, cloned)
+				items = append(items, proto.Clone(rb).(*core.RoleBinding))
 			}
 			mu.Unlock()
+			items, next, err := storage.Paginate(items, (*core.RoleBinding).GetId, options)
+			if err != nil {
+				return nil, err
+			}
+			rbList := &core.RoleBindingList{
+				Items:         items,
+				NextPageToken: next,
+			}
 			for _, rb := range rbList.Items {
 				storage.ApplyRoleBindingTaints(ctx, mockRBACStore, rb)
 			}
@@ -407,7 +609,7 @@ func NewTestTokenStore(ctx context.Context, ctrl *gomock.Controller) storage.Tok
 			defer mu.Unlock()
 			options := storage.NewTokenCreateOptions()
 			options.Apply(opts...)
-			t := tokens.NewToken().ToBootstrapToken()
+			t := options.Token.ToBootstrapToken()
 			lease := leaseStore.New(t.TokenID, ttl)
 			t.Metadata = &core.BootstrapTokenMetadata{
 				LeaseID:      int64(lease.ID),
@@ -415,6 +617,7 @@ func NewTestTokenStore(ctx context.Context, ctrl *gomock.Controller) storage.Tok
 				UsageCount:   0,
 				Labels:       options.Labels,
 				Capabilities: options.Capabilities,
+				Limits:       options.Limits,
 			}
 			tks[t.TokenID] = t
 			return t, nil
@@ -444,15 +647,26 @@ func NewTestTokenStore(ctx context.Context, ctrl *gomock.Controller) storage.Tok
 		}).
 		AnyTimes()
 	mockTokenStore.EXPECT().
-		ListTokens(gomock.Any()).
-		DoAndReturn(func(_ context.Context) ([]*core.BootstrapToken, error) {
+		ListTokens(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, opts ...storage.ListOption) (*core.BootstrapTokenList, error) {
 			mu.Lock()
 			defer mu.Unlock()
+			options := storage.ListOptions{}
+			options.Apply(opts...)
 			tokens := make([]*core.BootstrapToken, 0, len(tks))
 			for _, t := range tks {
-				tokens = append(tokens, t)
+				if options.MatchToken(t) {
+					tokens = append(tokens, t)
+				}
+			}
+			tokens, next, err := storage.Paginate(tokens, (*core.BootstrapToken).GetTokenID, options)
+			if err != nil {
+				return nil, err
 			}
-			return tokens, nil
+			return &core.BootstrapTokenList{
+				Items:         tokens,
+				NextPageToken: next,
+			}, nil
 		}).
 		AnyTimes()
 	mockTokenStore.EXPECT().
@@ -40,61 +40,106 @@ func ParsePEMEncodedCert(data []byte) (*x509.Certificate, error) {
 }
 
 func LoadServingCertBundle(certsSpec v1beta1.CertsSpec) (*tls.Certificate, *x509.CertPool, error) {
-	var caCertData, servingCertData, servingKeyData []byte
+	rootCA, err := loadRootCA(certsSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	servingCert, err := loadServingCert(rootCA,
+		certsSpec.ServingCert, certsSpec.ServingCertData,
+		certsSpec.ServingKey, certsSpec.ServingKeyData)
+	if err != nil {
+		return nil, nil, err
+	}
+	caPool := x509.NewCertPool()
+	caPool.AddCert(rootCA)
+	return servingCert, caPool, nil
+}
+
+// LoadNextServingCert loads the serving certificate which will replace the
+// current serving certificate, or returns nil if one is not configured.
+func LoadNextServingCert(certsSpec v1beta1.CertsSpec) (*tls.Certificate, error) {
+	if certsSpec.NextServingCert == nil && certsSpec.NextServingCertData == nil &&
+		certsSpec.NextServingKey == nil && certsSpec.NextServingKeyData == nil {
+		return nil, nil
+	}
+	rootCA, err := loadRootCA(certsSpec)
+	if err != nil {
+		return nil, err
+	}
+	servingCert, err := loadServingCert(rootCA,
+		certsSpec.NextServingCert, certsSpec.NextServingCertData,
+		certsSpec.NextServingKey, certsSpec.NextServingKeyData)
+	if err != nil {
+		return nil, fmt.Errorf("next serving cert: %w", err)
+	}
+	return servingCert, nil
+}
+
+func loadRootCA(certsSpec v1beta1.CertsSpec) (*x509.Certificate, error) {
+	var caCertData []byte
 	switch {
 	case certsSpec.CACert != nil:
 		data, err := os.ReadFile(*certsSpec.CACert)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load CA cert: %w", err)
+			return nil, fmt.Errorf("failed to load CA cert: %w", err)
 		}
 		caCertData = data
 	case certsSpec.CACertData != nil:
 		caCertData = []byte(*certsSpec.CACertData)
 	default:
-		return nil, nil, errors.New("no CA cert configured")
+		return nil, errors.New("no CA cert configured")
+	}
+	rootCA, err := ParsePEMEncodedCert(caCertData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA cert: %w", err)
 	}
+	return rootCA, nil
+}
+
+// loadServingCert loads a serving certificate and key from either a file or
+// inline data. If the chain does not end with the given root CA, the CA is
+// appended to it.
+func loadServingCert(
+	rootCA *x509.Certificate,
+	certPath, certData, keyPath, keyData *string,
+) (*tls.Certificate, error) {
+	var servingCertData, servingKeyData []byte
 	switch {
-	case certsSpec.ServingCert != nil:
-		data, err := os.ReadFile(*certsSpec.ServingCert)
+	case certPath != nil:
+		data, err := os.ReadFile(*certPath)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load serving cert: %w", err)
+			return nil, fmt.Errorf("failed to load serving cert: %w", err)
 		}
 		servingCertData = data
-	case certsSpec.ServingCertData != nil:
-		servingCertData = []byte(*certsSpec.ServingCertData)
+	case certData != nil:
+		servingCertData = []byte(*certData)
 	default:
-		return nil, nil, errors.New("no serving cert configured")
+		return nil, errors.New("no serving cert configured")
 	}
 	switch {
-	case certsSpec.ServingKey != nil:
-		data, err := os.ReadFile(*certsSpec.ServingKey)
+	case keyPath != nil:
+		data, err := os.ReadFile(*keyPath)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to load serving key: %w", err)
+			return nil, fmt.Errorf("failed to load serving key: %w", err)
 		}
 		servingKeyData = data
-	case certsSpec.ServingKeyData != nil:
-		servingKeyData = []byte(*certsSpec.ServingKeyData)
+	case keyData != nil:
+		servingKeyData = []byte(*keyData)
 	default:
-		return nil, nil, errors.New("no serving key configured")
+		return nil, errors.New("no serving key configured")
 	}
 
-	rootCA, err := ParsePEMEncodedCert(caCertData)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse CA cert: %w", err)
-	}
 	servingCert, err := tls.X509KeyPair(servingCertData, servingKeyData)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
 	}
 	servingRootData := servingCert.Certificate[len(servingCert.Certificate)-1]
 	servingRoot, err := x509.ParseCertificate(servingRootData)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to parse serving root certificate: %w", err)
+		return nil, fmt.Errorf("failed to parse serving root certificate: %w", err)
 	}
 	if !rootCA.Equal(servingRoot) {
 		servingCert.Certificate = append(servingCert.Certificate, rootCA.Raw)
 	}
-	caPool := x509.NewCertPool()
-	caPool.AddCert(rootCA)
-	return &servingCert, caPool, nil
+	return &servingCert, nil
 }
@@ -126,4 +126,27 @@ MC4CAQAwBQYDK2VwBCIEIM6i0VYYKNegxVFfCMXXbIBjjhDhfC30JPtkAImgL1Xw
 		})
 		Expect(err).To(HaveOccurred())
 	})
+	It("should load the next serving cert if one is configured", func() {
+		cert, err := util.LoadNextServingCert(v1beta1.CertsSpec{
+			CACertData:      util.Pointer(string(test.TestData("root_ca.crt"))),
+			ServingCertData: util.Pointer(string(test.TestData("localhost.crt"))),
+			ServingKeyData:  util.Pointer(string(test.TestData("localhost.key"))),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert).To(BeNil())
+
+		cert, err = util.LoadNextServingCert(v1beta1.CertsSpec{
+			CACertData:          util.Pointer(string(test.TestData("root_ca.crt"))),
+			NextServingCertData: util.Pointer(string(test.TestData("localhost.crt"))),
+			NextServingKeyData:  util.Pointer(string(test.TestData("localhost.key"))),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cert).NotTo(BeNil())
+
+		_, err = util.LoadNextServingCert(v1beta1.CertsSpec{
+			CACertData:          util.Pointer(string(test.TestData("root_ca.crt"))),
+			NextServingCertData: util.Pointer(string(test.TestData("localhost.crt"))),
+		})
+		Expect(err).To(HaveOccurred())
+	})
 })
@@ -0,0 +1,259 @@
+package wal
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	DefaultMaxSize = 256 << 20
+
+	recordSuffix = ".rec"
+	tempPrefix   = ".tmp-"
+)
+
+var ErrRecordTooLarge = errors.New("record exceeds the maximum size of the WAL")
+
+// WAL is a persistent FIFO queue of records, stored in a local directory.
+// Each record is stored in its own file, named after its sequence number, so
+// that records are read back in the order in which they were appended,
+// including after a restart. The total size of the stored records is bounded;
+// once the limit is reached, the oldest records are dropped.
+type WAL struct {
+	WALOptions
+	dir string
+
+	mu      sync.Mutex
+	entries []entry
+	size    int64
+	nextSeq uint64
+	notify  chan struct{}
+}
+
+type entry struct {
+	seq  uint64
+	size int64
+}
+
+type Record struct {
+	Seq  uint64
+	Data []byte
+}
+
+type WALOptions struct {
+	maxSize int64
+}
+
+type WALOption func(*WALOptions)
+
+func (o *WALOptions) Apply(opts ...WALOption) {
+	for _, op := range opts {
+		op(o)
+	}
+}
+
+// WithMaxSize sets the maximum total size of the stored records, in bytes.
+// Defaults to DefaultMaxSize.
+func WithMaxSize(size int64) WALOption {
+	return func(o *WALOptions) {
+		if size > 0 {
+			o.maxSize = size
+		}
+	}
+}
+
+// Open opens the WAL stored in the given directory, creating the directory if
+// it does not exist. Records which were stored by a previous process are kept.
+func Open(dir string, opts ...WALOption) (*WAL, error) {
+	options := WALOptions{
+		maxSize: DefaultMaxSize,
+	}
+	options.Apply(opts...)
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create WAL directory: %w", err)
+	}
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read WAL directory: %w", err)
+	}
+	w := &WAL{
+		WALOptions: options,
+		dir:        dir,
+		notify:     make(chan struct{}, 1),
+	}
+	for _, de := range dirEntries {
+		name := de.Name()
+		if strings.HasPrefix(name, tempPrefix) {
+			// Left over from an interrupted append
+			os.Remove(filepath.Join(dir, name))
+			continue
+		}
+		if de.IsDir() || !strings.HasSuffix(name, recordSuffix) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, recordSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		info, err := de.Info()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read WAL directory: %w", err)
+		}
+		w.entries = append(w.entries, entry{
+			seq:  seq,
+			size: info.Size(),
+		})
+		w.size += info.Size()
+	}
+	sort.Slice(w.entries, func(i, j int) bool {
+		return w.entries[i].seq < w.entries[j].seq
+	})
+	if len(w.entries) > 0 {
+		w.nextSeq = w.entries[len(w.entries)-1].seq + 1
+	}
+	return w, nil
+}
+
+func (w *WAL) path(seq uint64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%020d%s", seq, recordSuffix))
+}
+
+// Append durably stores a record at the end of the queue. If there is not
+// enough space for the record, the oldest records are removed and returned.
+func (w *WAL) Append(data []byte) ([]Record, error) {
+	size := int64(len(data))
+	if size > w.maxSize {
+		return nil, ErrRecordTooLarge
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var dropped []Record
+	for w.size+size > w.maxSize && len(w.entries) > 0 {
+		rec, err := w.read(w.entries[0])
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return dropped, err
+		}
+		if err := w.remove(w.entries[0].seq); err != nil {
+			return dropped, err
+		}
+		if rec != nil {
+			dropped = append(dropped, *rec)
+		}
+	}
+
+	seq := w.nextSeq
+	f, err := os.CreateTemp(w.dir, tempPrefix+"*")
+	if err != nil {
+		return dropped, fmt.Errorf("failed to append record: %w", err)
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), w.path(seq))
+	}
+	if err != nil {
+		return dropped, fmt.Errorf("failed to append record: %w", err)
+	}
+	w.nextSeq++
+	w.entries = append(w.entries, entry{
+		seq:  seq,
+		size: size,
+	})
+	w.size += size
+
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+	return dropped, nil
+}
+
+// Front returns the oldest record without removing it. The returned bool is
+// false if the WAL is empty.
+func (w *WAL) Front() (Record, bool, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for len(w.entries) > 0 {
+		rec, err := w.read(w.entries[0])
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				// The file was removed externally
+				w.size -= w.entries[0].size
+				w.entries = w.entries[1:]
+				continue
+			}
+			return Record{}, false, err
+		}
+		return *rec, true, nil
+	}
+	return Record{}, false, nil
+}
+
+// Remove removes the record with the given sequence number. Removing a record
+// which has already been removed is not an error.
+func (w *WAL) Remove(seq uint64) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.remove(seq)
+}
+
+func (w *WAL) remove(seq uint64) error {
+	i := sort.Search(len(w.entries), func(i int) bool {
+		return w.entries[i].seq >= seq
+	})
+	if i == len(w.entries) || w.entries[i].seq != seq {
+		return nil
+	}
+	if err := os.Remove(w.path(seq)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to remove record: %w", err)
+	}
+	w.size -= w.entries[i].size
+	w.entries = append(w.entries[:i], w.entries[i+1:]...)
+	return nil
+}
+
+func (w *WAL) read(e entry) (*Record, error) {
+	data, err := os.ReadFile(w.path(e.seq))
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		Seq:  e.seq,
+		Data: data,
+	}, nil
+}
+
+// Len returns the number of stored records.
+func (w *WAL) Len() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return len(w.entries)
+}
+
+// Size returns the total size of the stored records, in bytes.
+func (w *WAL) Size() int64 {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.size
+}
+
+// Notify returns a channel which receives a value after records are appended.
+// Values are coalesced, so a single value may be received for several appends.
+func (w *WAL) Notify() <-chan struct{} {
+	return w.notify
+}
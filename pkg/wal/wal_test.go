@@ -0,0 +1,129 @@
+package wal_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/rancher/opni-monitoring/pkg/test"
+	"github.com/rancher/opni-monitoring/pkg/wal"
+)
+
+var _ = Describe("WAL", Label(test.Unit), func() {
+	var dir string
+	BeforeEach(func() {
+		dir = filepath.Join(GinkgoT().TempDir(), "wal")
+	})
+
+	front := func(w *wal.WAL) wal.Record {
+		rec, ok, err := w.Front()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeTrue())
+		return rec
+	}
+
+	It("should create the directory", func() {
+		w, err := wal.Open(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dir).To(BeADirectory())
+		Expect(w.Len()).To(BeZero())
+		_, ok, err := w.Front()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ok).To(BeFalse())
+	})
+	It("should return records in the order they were appended", func() {
+		w, err := wal.Open(dir)
+		Expect(err).NotTo(HaveOccurred())
+		for _, data := range []string{"foo", "bar", "baz"} {
+			dropped, err := w.Append([]byte(data))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(dropped).To(BeEmpty())
+		}
+		Expect(w.Len()).To(Equal(3))
+		Expect(w.Size()).To(BeEquivalentTo(9))
+
+		for _, data := range []string{"foo", "bar", "baz"} {
+			rec := front(w)
+			Expect(string(rec.Data)).To(Equal(data))
+			Expect(w.Remove(rec.Seq)).To(Succeed())
+			Expect(w.Remove(rec.Seq)).To(Succeed())
+		}
+		Expect(w.Len()).To(BeZero())
+		Expect(w.Size()).To(BeZero())
+	})
+	It("should notify when records are appended", func() {
+		w, err := wal.Open(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Notify()).NotTo(Receive())
+		_, err = w.Append([]byte("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Append([]byte("bar"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Notify()).To(Receive())
+		Expect(w.Notify()).NotTo(Receive())
+	})
+	It("should keep records across restarts", func() {
+		w, err := wal.Open(dir)
+		Expect(err).NotTo(HaveOccurred())
+		for _, data := range []string{"foo", "bar"} {
+			_, err := w.Append([]byte(data))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(w.Remove(front(w).Seq)).To(Succeed())
+		// simulate an interrupted append
+		Expect(os.WriteFile(filepath.Join(dir, ".tmp-1234"), []byte("x"), 0600)).To(Succeed())
+
+		w, err = wal.Open(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(w.Len()).To(Equal(1))
+		Expect(w.Size()).To(BeEquivalentTo(3))
+		Expect(filepath.Join(dir, ".tmp-1234")).NotTo(BeAnExistingFile())
+
+		_, err = w.Append([]byte("baz"))
+		Expect(err).NotTo(HaveOccurred())
+		for _, data := range []string{"bar", "baz"} {
+			rec := front(w)
+			Expect(string(rec.Data)).To(Equal(data))
+			Expect(w.Remove(rec.Seq)).To(Succeed())
+		}
+	})
+	It("should drop the oldest records when full", func() {
+		w, err := wal.Open(dir, wal.WithMaxSize(8))
+		Expect(err).NotTo(HaveOccurred())
+		for _, data := range []string{"foo", "bar"} {
+			_, err := w.Append([]byte(data))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		dropped, err := w.Append([]byte("bazqux"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(dropped).To(HaveLen(2))
+		Expect(string(dropped[0].Data)).To(Equal("foo"))
+		Expect(string(dropped[1].Data)).To(Equal("bar"))
+		Expect(w.Len()).To(Equal(1))
+		Expect(w.Size()).To(BeEquivalentTo(6))
+		Expect(string(front(w).Data)).To(Equal("bazqux"))
+	})
+	It("should reject records larger than the maximum size", func() {
+		w, err := wal.Open(dir, wal.WithMaxSize(4))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Append([]byte("foo"))
+		Expect(err).NotTo(HaveOccurred())
+		_, err = w.Append([]byte("foobar"))
+		Expect(errors.Is(err, wal.ErrRecordTooLarge)).To(BeTrue())
+		Expect(w.Len()).To(Equal(1))
+	})
+	It("should skip records removed externally", func() {
+		w, err := wal.Open(dir)
+		Expect(err).NotTo(HaveOccurred())
+		for _, data := range []string{"foo", "bar"} {
+			_, err := w.Append([]byte(data))
+			Expect(err).NotTo(HaveOccurred())
+		}
+		Expect(os.Remove(filepath.Join(dir, "00000000000000000000.rec"))).To(Succeed())
+		Expect(string(front(w).Data)).To(Equal("bar"))
+		Expect(w.Len()).To(Equal(1))
+	})
+})
@@ -41,7 +41,9 @@ func (p *Plugin) ConfigureRoutes(app *fiber.App) {
 		).Error("failed to get auth middleware")
 		os.Exit(1)
 	}
-	clusterMiddleware, err := cluster.New(storageBackend, orgIDCodec.Key())
+	options := append(cluster.ConfigOptions(config.Spec.ClusterAuth),
+		cluster.WithClusterStore(storageBackend))
+	clusterMiddleware, err := cluster.New(storageBackend, orgIDCodec.Key(), options...)
 	if err != nil {
 		p.logger.With(
 			"err", err,
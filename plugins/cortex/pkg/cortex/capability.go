@@ -1,12 +1,22 @@
 package cortex
 
-import "github.com/rancher/opni-monitoring/pkg/core"
+import (
+	"context"
+
+	"github.com/rancher/opni-monitoring/pkg/core"
+)
 
 func (p *Plugin) CanInstall() error {
 	return nil
 }
 
-func (p *Plugin) Install(cluster *core.Reference) error {
+func (p *Plugin) Install(ctx context.Context, cluster *core.Reference) error {
+	return nil
+}
+
+// Metrics data for the cluster is left in cortex, where it will be removed
+// according to the configured retention period.
+func (p *Plugin) Uninstall(cluster *core.Reference) error {
 	return nil
 }
 
@@ -80,7 +80,11 @@ func (p *ExamplePlugin) CanInstall() error {
 	return nil
 }
 
-func (p *ExamplePlugin) Install(cluster *core.Reference) error {
+func (p *ExamplePlugin) Install(ctx context.Context, cluster *core.Reference) error {
+	return nil
+}
+
+func (p *ExamplePlugin) Uninstall(cluster *core.Reference) error {
 	return nil
 }
 
@@ -27,7 +27,9 @@ type OpensearchDetailsResponse struct {
 
 func (p *Plugin) ConfigureRoutes(app *fiber.App) {
 	storageBackend := p.storageBackend.Get()
-	clusterMiddleware, err := cluster.New(storageBackend, ClusterIDHeader)
+	options := append(cluster.ConfigOptions(p.clusterAuth.Get()),
+		cluster.WithClusterStore(storageBackend))
+	clusterMiddleware, err := cluster.New(storageBackend, ClusterIDHeader, options...)
 	if err != nil {
 		p.logger.With(
 			"err", err,
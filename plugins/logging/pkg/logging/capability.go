@@ -1,6 +1,7 @@
 package logging
 
 import (
+	"context"
 	"crypto/rand"
 	"fmt"
 	"math/big"
@@ -43,13 +44,13 @@ func (p *Plugin) CanInstall() error {
 	return nil
 }
 
-func (p *Plugin) Install(cluster *core.Reference) error {
+func (p *Plugin) Install(ctx context.Context, cluster *core.Reference) error {
 	labels := map[string]string{
 		resources.OpniClusterID: cluster.Id,
 	}
 	loggingClusterList := &opniv1beta2.LoggingClusterList{}
 	if err := p.k8sClient.List(
-		p.ctx,
+		ctx,
 		loggingClusterList,
 		client.InNamespace(p.storageNamespace),
 		client.MatchingLabels{resources.OpniClusterID: cluster.Id},
@@ -83,7 +84,7 @@ func (p *Plugin) Install(cluster *core.Reference) error {
 		},
 	}
 
-	if err := p.k8sClient.Create(p.ctx, userSecret); err != nil {
+	if err := p.k8sClient.Create(ctx, userSecret); err != nil {
 		return ErrStoreUserCredentialsFailed(err)
 	}
 
@@ -101,13 +102,34 @@ func (p *Plugin) Install(cluster *core.Reference) error {
 		},
 	}
 
-	if err := p.k8sClient.Create(p.ctx, loggingCluster); err != nil {
+	if err := p.k8sClient.Create(ctx, loggingCluster); err != nil {
 		return ErrStoreClusterFailed(err)
 	}
 
 	return nil
 }
 
+func (p *Plugin) Uninstall(cluster *core.Reference) error {
+	labels := client.MatchingLabels{resources.OpniClusterID: cluster.Id}
+	if err := p.k8sClient.DeleteAllOf(
+		p.ctx,
+		&opniv1beta2.LoggingCluster{},
+		client.InNamespace(p.storageNamespace),
+		labels,
+	); err != nil {
+		return ErrDeleteClusterFailed(err)
+	}
+	if err := p.k8sClient.DeleteAllOf(
+		p.ctx,
+		&corev1.Secret{},
+		client.InNamespace(p.storageNamespace),
+		labels,
+	); err != nil {
+		return ErrDeleteUserCredentialsFailed(err)
+	}
+	return nil
+}
+
 func (p *Plugin) InstallerTemplate() string {
 	return fmt.Sprintf(`opnictl bootstrap logging {{ arg "input" "Opensearch Cluster Name" "+required" "+default:%s" }} `, p.opensearchCluster.Name) +
 		`{{ arg "select" "Kubernetes Provider" "" "rke" "rke2" "k3s" "aks" "eks" "gke" "+omitEmpty" "+format:--provider={{ value }}" }} ` +
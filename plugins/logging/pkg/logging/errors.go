@@ -37,3 +37,11 @@ func ErrStoreUserCredentialsFailed(err error) error {
 func ErrStoreClusterFailed(err error) error {
 	return fmt.Errorf("failed to store logging cluster %w", err)
 }
+
+func ErrDeleteClusterFailed(err error) error {
+	return fmt.Errorf("failed to delete logging cluster %w", err)
+}
+
+func ErrDeleteUserCredentialsFailed(err error) error {
+	return fmt.Errorf("failed to delete user credentials %w", err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/rancher/opni-monitoring/pkg/capabilities/wellknown"
+	"github.com/rancher/opni-monitoring/pkg/config/v1beta1"
 	"github.com/rancher/opni-monitoring/pkg/logger"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	gatewayext "github.com/rancher/opni-monitoring/pkg/plugins/apis/apiextensions/gateway"
@@ -36,6 +37,7 @@ type Plugin struct {
 	logger         hclog.Logger
 	storageBackend *util.Future[storage.Backend]
 	mgmtApi        *util.Future[management.ManagementClient]
+	clusterAuth    *util.Future[v1beta1.ClusterAuthSpec]
 }
 
 type PluginOptions struct {
@@ -89,6 +91,7 @@ func NewPlugin(ctx context.Context, opts ...PluginOption) *Plugin {
 		logger:         lg,
 		storageBackend: util.NewFuture[storage.Backend](),
 		mgmtApi:        util.NewFuture[management.ManagementClient](),
+		clusterAuth:    util.NewFuture[v1beta1.ClusterAuthSpec](),
 	}
 }
 
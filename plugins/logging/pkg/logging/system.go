@@ -27,6 +27,7 @@ func (p *Plugin) UseManagementAPI(client management.ManagementClient) {
 		if err != nil {
 			panic(err)
 		}
+		p.clusterAuth.Set(config.Spec.ClusterAuth)
 		p.storageBackend.Set(backend)
 	})
 	<-p.ctx.Done()
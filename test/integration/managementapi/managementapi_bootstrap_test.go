@@ -80,7 +80,7 @@ var _ = Describe("Management API Boostrap Token Management Tests", Ordered, Labe
 	})
 
 	It("can list all bootstrap tokens", func() {
-		tokenList, err := client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+		tokenList, err := client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 		Expect(err).NotTo(HaveOccurred())
 
 		tokenInfo := tokenList.Items
@@ -97,7 +97,7 @@ var _ = Describe("Management API Boostrap Token Management Tests", Ordered, Labe
 		_, err := client.RevokeBootstrapToken(context.Background(), token.Reference())
 		Expect(err).NotTo(HaveOccurred())
 
-		tokenList, err := client.ListBootstrapTokens(context.Background(), &emptypb.Empty{})
+		tokenList, err := client.ListBootstrapTokens(context.Background(), &management.ListBootstrapTokensRequest{})
 		Expect(err).NotTo(HaveOccurred())
 		tokenInfo := tokenList.Items
 		Expect(tokenInfo).To(BeEmpty())
@@ -153,10 +153,11 @@ var _ = Describe("Management API Cluster Management Tests", Ordered, Label(test.
 		})
 		Expect(errG2).NotTo(HaveOccurred())
 
-		_, errD := client.DeleteCluster(context.Background(), &core.Reference{
+		stream, errD := client.DeleteCluster(context.Background(), &core.Reference{
 			Id: "test-cluster-id",
 		})
 		Expect(errD).NotTo(HaveOccurred())
+		Expect(management.WaitForClusterDeletion(stream, nil)).To(Succeed())
 
 		_, err := client.GetCluster(context.Background(), &core.Reference{
 			Id: "test-cluster-id",
@@ -251,7 +252,7 @@ var _ = Describe("Management API Cluster Management Tests", Ordered, Label(test.
 		Expect(clusterList.GetItems()).To(HaveLen(0))
 	})
 
-	When("editing a cluster without providing label information", func() {
+	When("editing a cluster with labels to remove", func() {
 		It("can remove labels from a cluster", func() {
 			token, err := client.CreateBootstrapToken(context.Background(), &management.CreateBootstrapTokenRequest{
 				Ttl: durationpb.New(time.Minute),
@@ -283,6 +284,7 @@ var _ = Describe("Management API Cluster Management Tests", Ordered, Label(test.
 				Cluster: &core.Reference{
 					Id: clusterName,
 				},
+				RemoveLabels: []string{"i"},
 			})
 			Expect(err).NotTo(HaveOccurred())
 
@@ -295,15 +297,19 @@ var _ = Describe("Management API Cluster Management Tests", Ordered, Label(test.
 	})
 
 	It("cannot delete individual clusters without providing a valid ID", func() {
-		_, err := client.DeleteCluster(context.Background(), &core.Reference{
+		stream, err := client.DeleteCluster(context.Background(), &core.Reference{
 			Id: uuid.NewString(),
 		})
+		Expect(err).NotTo(HaveOccurred())
+		err = management.WaitForClusterDeletion(stream, nil)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("not found"))
 	})
 
 	It("cannot delete individual clusters without providing an ID", func() {
-		_, err := client.DeleteCluster(context.Background(), &core.Reference{})
+		stream, err := client.DeleteCluster(context.Background(), &core.Reference{})
+		Expect(err).NotTo(HaveOccurred())
+		err = management.WaitForClusterDeletion(stream, nil)
 		Expect(err).To(HaveOccurred())
 		Expect(err.Error()).To(ContainSubstring("missing required field: id"))
 	})
@@ -10,7 +10,6 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/test"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 //#region Test Setup
@@ -62,7 +61,7 @@ var _ = Describe("Management API Rolebinding Management Tests", Ordered, Label(t
 	})
 
 	It("can list all rolebindings", func() {
-		rolebinding, err := client.ListRoleBindings(context.Background(), &emptypb.Empty{})
+		rolebinding, err := client.ListRoleBindings(context.Background(), &management.ListRoleBindingsRequest{})
 		Expect(err).NotTo(HaveOccurred())
 
 		rolebindingList := rolebinding.Items
@@ -10,7 +10,6 @@ import (
 	"github.com/rancher/opni-monitoring/pkg/core"
 	"github.com/rancher/opni-monitoring/pkg/management"
 	"github.com/rancher/opni-monitoring/pkg/test"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 //#region Test Setup
@@ -63,7 +62,7 @@ var _ = Describe("Management API Roles Management Tests", Ordered, Label(test.In
 	})
 
 	It("can list all roles", func() {
-		role, err := client.ListRoles(context.Background(), &emptypb.Empty{})
+		role, err := client.ListRoles(context.Background(), &management.ListRolesRequest{})
 		Expect(err).NotTo(HaveOccurred())
 
 		roleList := role.Items